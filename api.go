@@ -0,0 +1,448 @@
+package valix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// CodeRequestPathParamInvalidType is the violation code when a path param value is an incorrect type
+	CodeRequestPathParamInvalidType = 40012
+	fmtMsgPathParamType             = "Path param must be of type %[1]s"
+	// CodeRequestHeaderMultiNotAllowed is the violation code when a header is specified more than once but may not be
+	CodeRequestHeaderMultiNotAllowed = 40013
+	msgHeaderMultiNotAllowed         = "Header may not be specified more than once"
+	// CodeRequestHeaderInvalidType is the violation code when a header value is an incorrect type
+	CodeRequestHeaderInvalidType = 40014
+	fmtMsgHeaderType             = "Header must be of type %[1]s"
+)
+
+// ErrNoOperation is the error returned by API.RequestValidateErr when no operation is found for the request
+var ErrNoOperation = errors.New("no operation found for request")
+
+const (
+	msgApiPrefix                = "api - "
+	msgApiPathTemplateStart     = msgApiPrefix + "path template '%s' must start with '/'"
+	msgApiPathTemplateSegment   = msgApiPrefix + "path template '%s' has invalid segment '%s'"
+	msgApiPathTemplateDuplicate = msgApiPrefix + "path template '%s' has duplicate param name '%s'"
+	msgApiMethodMissing         = msgApiPrefix + "operation for path template '%s' has no method"
+	msgApiOperationExists       = msgApiPrefix + "operation %s '%s' already exists"
+)
+
+var pathParamKind = &paramKind{
+	fmtMsgType:      fmtMsgPathParamType,
+	codeInvalidType: CodeRequestPathParamInvalidType,
+}
+
+var headerParamKind = &paramKind{
+	msgMultiNotAllowed:  msgHeaderMultiNotAllowed,
+	codeMultiNotAllowed: CodeRequestHeaderMultiNotAllowed,
+	fmtMsgType:          fmtMsgHeaderType,
+	codeInvalidType:     CodeRequestHeaderInvalidType,
+}
+
+// API is a table of operations (method + path template) - each with validators for the request path params,
+// query params, headers and body and validators for the responses
+//
+// Requests are matched to operations using a trie of the path template segments (literal segments take
+// precedence over path param segments) - so no router dependency is needed
+//
+// The API is also intended to be the single source for generating OpenAPI documents
+type API struct {
+	// Title is the title of the API
+	Title string
+	// Version is the version of the API
+	Version string
+	// Description is the description of the API
+	Description string
+	// ValidateResponses determines whether Handler also validates the responses (see Operation.Responses)
+	ValidateResponses bool
+	// ResponseMode is the mode used when validating responses (see ResponseValidator.Mode)
+	ResponseMode ResponseValidationMode
+	// OnResponseViolations, if set, is called whenever a response fails validation
+	OnResponseViolations func(req *http.Request, status int, violations []*Violation)
+	operations           []*Operation
+	root                 *apiRouteNode
+}
+
+// Operation is a single operation (method + path template) of an API
+type Operation struct {
+	// Method is the HTTP method (e.g. "GET", "POST")
+	Method string
+	// Path is the path template - where path params are denoted by braces, e.g. "/foos/{fooId}/bars"
+	Path string
+	// OperationId is the OpenAPI operation id
+	OperationId string
+	// Summary is the OpenAPI summary of the operation
+	Summary string
+	// Description is the OpenAPI description of the operation
+	Description string
+	// Tags is the OpenAPI tags of the operation
+	Tags []string
+	// Deprecated is whether the operation is deprecated
+	Deprecated bool
+	// PathParams is the validator for the path params (the properties of which should match the path template params)
+	PathParams *Validator
+	// Query is the validator for the query params
+	Query *Validator
+	// Headers is the validator for the headers - only the headers named as properties are validated (header
+	// names are matched case-insensitively)
+	Headers *Validator
+	// Body is the validator for the request body (if nil, the request body is not validated)
+	Body *Validator
+	// Responses is the map of HTTP status code (key) and the Validator (value) for responses (see ResponseValidator.Validators)
+	Responses      map[int]*Validator
+	pathParamNames []string
+}
+
+// OperationRequest is the result of validating a request against an API
+type OperationRequest struct {
+	// Operation is the matched operation
+	Operation *Operation
+	// PathParams is the (converted) path params
+	PathParams map[string]interface{}
+	// Query is the (converted) query params - nil if the operation has no Operation.Query validator
+	Query map[string]interface{}
+	// Headers is the (converted) headers - nil if the operation has no Operation.Headers validator
+	Headers map[string]interface{}
+	// Body is the decoded request body - nil if the operation has no Operation.Body validator
+	Body interface{}
+}
+
+type apiRouteNode struct {
+	literals   map[string]*apiRouteNode
+	param      *apiRouteNode
+	operations map[string]*Operation
+}
+
+type apiContextKey struct{}
+
+// AddOperation adds an operation to the API
+//
+// An error is returned if the path template is invalid or an operation with the same method and path already exists
+func (a *API) AddOperation(op *Operation) error {
+	segments, names, err := parsePathTemplate(op.Path)
+	if err != nil {
+		return err
+	}
+	method := strings.ToUpper(op.Method)
+	if method == "" {
+		return fmt.Errorf(msgApiMethodMissing, op.Path)
+	}
+	if a.root == nil {
+		a.root = &apiRouteNode{}
+	}
+	node := a.root
+	for _, segment := range segments {
+		node = node.child(segment)
+	}
+	if node.operations == nil {
+		node.operations = map[string]*Operation{}
+	}
+	if _, exists := node.operations[method]; exists {
+		return fmt.Errorf(msgApiOperationExists, method, op.Path)
+	}
+	op.pathParamNames = names
+	node.operations[method] = op
+	a.operations = append(a.operations, op)
+	return nil
+}
+
+// MustAddOperation is the same as AddOperation - except that it panics on error
+func (a *API) MustAddOperation(op *Operation) *API {
+	if err := a.AddOperation(op); err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Operations returns the operations of the API (in the order in which they were added)
+func (a *API) Operations() []*Operation {
+	result := make([]*Operation, len(a.operations))
+	copy(result, a.operations)
+	return result
+}
+
+// Lookup finds the operation for the method and (escaped) request path
+//
+// The returned pathFound indicates whether the path matched any operation (regardless of method) - which
+// can be used to distinguish between 404 (Not Found) and 405 (Method Not Allowed)
+func (a *API) Lookup(method string, path string) (op *Operation, pathParams map[string]string, pathFound bool) {
+	if a.root == nil || !strings.HasPrefix(path, "/") {
+		return nil, nil, false
+	}
+	op, values, pathFound := a.root.find(strings.ToUpper(method), splitPath(path), nil)
+	if op == nil {
+		return nil, nil, pathFound
+	}
+	pathParams = make(map[string]string, len(values))
+	for i, name := range op.pathParamNames {
+		if uv, err := url.PathUnescape(values[i]); err == nil {
+			pathParams[name] = uv
+		} else {
+			pathParams[name] = values[i]
+		}
+	}
+	return op, pathParams, true
+}
+
+// RequestValidate finds the operation for the request and validates the request path params, query params,
+// headers and body against the operation's validators
+//
+// If no operation is found for the request, a nil OperationRequest is returned (and false with no violations)
+func (a *API) RequestValidate(req *http.Request, initialConditions ...string) (bool, []*Violation, *OperationRequest) {
+	return a.RequestValidateWith(req, WithConditions(initialConditions...))
+}
+
+// RequestValidateWith is the same as API.RequestValidate - except that it accepts validate options (see ValidateOption)
+func (a *API) RequestValidateWith(req *http.Request, options ...ValidateOption) (bool, []*Violation, *OperationRequest) {
+	op, pathParams, _ := a.Lookup(req.Method, req.URL.EscapedPath())
+	if op == nil {
+		return false, nil, nil
+	}
+	return op.requestValidate(req, pathParams, options...)
+}
+
+// RequestValidateErr is the same as API.RequestValidate - except that it returns an error (a *ValidationError)
+// if validation fails
+//
+// If no operation is found for the request, ErrNoOperation is returned
+func (a *API) RequestValidateErr(req *http.Request, initialConditions ...string) (*OperationRequest, error) {
+	return a.RequestValidateErrWith(req, WithConditions(initialConditions...))
+}
+
+// RequestValidateErrWith is the same as API.RequestValidateErr - except that it accepts validate options
+// (see ValidateOption)
+func (a *API) RequestValidateErrWith(req *http.Request, options ...ValidateOption) (*OperationRequest, error) {
+	ok, violations, result := a.RequestValidateWith(req, options...)
+	if result == nil {
+		return nil, ErrNoOperation
+	}
+	return result, newValidationError(ok, violations)
+}
+
+// Handler is middleware that validates requests against the API
+//
+// If no operation is found, a 404 (Not Found) or 405 (Method Not Allowed) is written.  If the request fails
+// validation, the violations are written as a problem details document (see WriteProblem).  Otherwise, the next
+// handler is called - with the OperationRequest available from the request context (see OperationRequestFromContext)
+//
+// If API.ValidateResponses is set, the responses are also validated against the operation's Operation.Responses
+func (a *API) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		op, pathParams, pathFound := a.Lookup(req.Method, req.URL.EscapedPath())
+		if op == nil {
+			if pathFound {
+				http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			} else {
+				http.NotFound(w, req)
+			}
+			return
+		}
+		ok, violations, opReq := op.requestValidate(req, pathParams)
+		if !ok {
+			_ = getDefaultProblemRenderer().write(w, req, op.i18nProvider().ContextFromRequest(req), violations)
+			return
+		}
+		useReq := req.WithContext(context.WithValue(req.Context(), apiContextKey{}, opReq))
+		if a.ValidateResponses && len(op.Responses) > 0 {
+			rv := &ResponseValidator{
+				Validators:   op.Responses,
+				Mode:         a.ResponseMode,
+				OnViolations: a.OnResponseViolations,
+			}
+			rvw := rv.Wrap(w, useReq)
+			next.ServeHTTP(rvw, useReq)
+			_ = rvw.Finish()
+		} else {
+			next.ServeHTTP(w, useReq)
+		}
+	})
+}
+
+// OperationRequestFromContext returns the OperationRequest (as set by API.Handler) from the context
+func OperationRequestFromContext(ctx context.Context) *OperationRequest {
+	if opReq, ok := ctx.Value(apiContextKey{}).(*OperationRequest); ok {
+		return opReq
+	}
+	return nil
+}
+
+func (op *Operation) requestValidate(req *http.Request, pathParams map[string]string, options ...ValidateOption) (bool, []*Violation, *OperationRequest) {
+	settings := newValidateSettings(options)
+	result := &OperationRequest{Operation: op}
+	violations := make([]*Violation, 0)
+	var cvs []*Violation
+	result.PathParams, cvs = pathParamsToObject(op.PathParams, pathParams, settings.i18nContext(op.PathParams.i18nProvider(), req))
+	violations = append(violations, cvs...)
+	if op.PathParams != nil && len(cvs) == 0 {
+		violations = append(violations, validateRequestObject(op.PathParams, req, result.PathParams, settings)...)
+	}
+	if op.Query != nil {
+		result.Query, cvs = op.Query.queryParamsToObject(req, settings.i18nContext(op.Query.i18nProvider(), req))
+		violations = append(violations, cvs...)
+		if len(cvs) == 0 {
+			violations = append(violations, validateRequestObject(op.Query, req, result.Query, settings)...)
+		}
+	}
+	if op.Headers != nil {
+		result.Headers, cvs = op.Headers.headersToObject(req, settings.i18nContext(op.Headers.i18nProvider(), req))
+		violations = append(violations, cvs...)
+		if len(cvs) == 0 {
+			violations = append(violations, validateRequestObject(op.Headers, req, result.Headers, settings)...)
+		}
+	}
+	if op.Body != nil {
+		ok, bodyViolations, obj := op.Body.RequestValidateWith(req, options...)
+		result.Body = obj
+		if !ok {
+			violations = append(violations, bodyViolations...)
+		}
+	}
+	return !hasErrorViolations(violations), violations, result
+}
+
+// i18nProvider returns the I18n provider for the operation - the I18n of the first of the body, path params, query
+// or headers validators that has one set, otherwise DefaultI18nProvider
+func (op *Operation) i18nProvider() I18n {
+	for _, v := range []*Validator{op.Body, op.PathParams, op.Query, op.Headers} {
+		if v != nil && v.I18n != nil {
+			return v.I18n
+		}
+	}
+	return obtainI18nProvider()
+}
+
+func validateRequestObject(v *Validator, req *http.Request, obj map[string]interface{}, settings *validateSettings) []*Violation {
+	vcx := newValidatorContext(obj, v, v.StopOnFirst, settings.i18nContext(v.i18nProvider(), req))
+	vcx.setConditionsFromRequest(req)
+	vcx.applySettings(settings)
+	v.validateObjectOrArray(vcx, obj, true)
+	return vcx.violations
+}
+
+func pathParamsToObject(v *Validator, pathParams map[string]string, i18ctx I18nContext) (map[string]interface{}, []*Violation) {
+	result := make(map[string]interface{}, len(pathParams))
+	violations := make([]*Violation, 0)
+	for k, pv := range pathParams {
+		t := JsonString
+		if v != nil {
+			if pty, ok := v.Properties[k]; ok && pty.Type != JsonAny && pty.Type != JsonArray {
+				t = pty.Type
+			}
+		}
+		if r, violation := convertParamValue(pv, k, t, pathParamKind, i18ctx); violation == nil {
+			result[k] = r
+		} else {
+			violations = append(violations, violation)
+		}
+	}
+	return result, violations
+}
+
+func (v *Validator) headersToObject(req *http.Request, i18ctx I18nContext) (map[string]interface{}, []*Violation) {
+	result := map[string]interface{}{}
+	violations := make([]*Violation, 0)
+	for k, pty := range v.Properties {
+		if vs := req.Header.Values(k); len(vs) > 0 {
+			if pty.Type == JsonArray {
+				split := make([]string, 0, len(vs))
+				for _, hv := range vs {
+					for _, sv := range strings.Split(hv, ",") {
+						split = append(split, strings.Trim(sv, " "))
+					}
+				}
+				vs = split
+			}
+			if useV, cvs := convertParamValues(vs, k, pty, headerParamKind, i18ctx); len(cvs) == 0 {
+				result[k] = useV
+			} else {
+				violations = append(violations, cvs...)
+			}
+		}
+	}
+	return result, violations
+}
+
+func (n *apiRouteNode) child(segment string) *apiRouteNode {
+	if isPathParamSegment(segment) {
+		if n.param == nil {
+			n.param = &apiRouteNode{}
+		}
+		return n.param
+	}
+	if n.literals == nil {
+		n.literals = map[string]*apiRouteNode{}
+	}
+	result, ok := n.literals[segment]
+	if !ok {
+		result = &apiRouteNode{}
+		n.literals[segment] = result
+	}
+	return result
+}
+
+// find finds the operation for the method and path segments - literal segments take precedence over params, but
+// if a literal match has no operation for the method the param branch is tried
+//
+// pathFound indicates whether any node (regardless of method) matched the path
+func (n *apiRouteNode) find(method string, segments []string, values []string) (op *Operation, rvs []string, pathFound bool) {
+	if len(segments) == 0 {
+		if len(n.operations) == 0 {
+			return nil, nil, false
+		}
+		return n.operations[method], values, true
+	}
+	if next, ok := n.literals[segments[0]]; ok {
+		if op, rvs, pathFound = next.find(method, segments[1:], values); op != nil {
+			return op, rvs, true
+		}
+	}
+	if n.param != nil && segments[0] != "" {
+		pop, prvs, pFound := n.param.find(method, segments[1:], append(values[:len(values):len(values)], segments[0]))
+		if pop != nil {
+			return pop, prvs, true
+		}
+		pathFound = pathFound || pFound
+	}
+	return nil, nil, pathFound
+}
+
+func parsePathTemplate(path string) ([]string, []string, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, nil, fmt.Errorf(msgApiPathTemplateStart, path)
+	}
+	segments := splitPath(path)
+	names := make([]string, 0)
+	seen := map[string]bool{}
+	for _, segment := range segments {
+		if isPathParamSegment(segment) {
+			name := segment[1 : len(segment)-1]
+			if seen[name] {
+				return nil, nil, fmt.Errorf(msgApiPathTemplateDuplicate, path, name)
+			}
+			seen[name] = true
+			names = append(names, name)
+		} else if segment == "" || strings.ContainsAny(segment, "{}") {
+			return nil, nil, fmt.Errorf(msgApiPathTemplateSegment, path, segment)
+		}
+	}
+	return segments, names, nil
+}
+
+func isPathParamSegment(segment string) bool {
+	return len(segment) > 2 && strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") &&
+		!strings.ContainsAny(segment[1:len(segment)-1], "{}")
+}
+
+func splitPath(path string) []string {
+	trimmed := strings.TrimSuffix(strings.TrimPrefix(path, "/"), "/")
+	if trimmed == "" {
+		return []string{}
+	}
+	return strings.Split(trimmed, "/")
+}
@@ -0,0 +1,320 @@
+package valix
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testApi(t *testing.T) *API {
+	api := &API{Title: "Test API", Version: "1.0.0"}
+	err := api.AddOperation(&Operation{
+		Method:      "GET",
+		Path:        "/foos",
+		OperationId: "listFoos",
+		Query: &Validator{
+			IgnoreUnknownProperties: true,
+			Properties: Properties{
+				"limit": {Type: JsonInteger, Constraints: Constraints{&Positive{}}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	err = api.AddOperation(&Operation{
+		Method: "POST",
+		Path:   "/foos",
+		Headers: &Validator{
+			Properties: Properties{
+				"X-Request-Id": {Type: JsonString, Mandatory: true},
+			},
+		},
+		Body: &Validator{
+			Properties: Properties{
+				"name": {Type: JsonString, Mandatory: true},
+			},
+		},
+	})
+	require.NoError(t, err)
+	err = api.AddOperation(&Operation{
+		Method: "GET",
+		Path:   "/foos/{fooId}",
+		PathParams: &Validator{
+			Properties: Properties{
+				"fooId": {Type: JsonInteger, Constraints: Constraints{&Positive{}}},
+			},
+		},
+		Responses: map[int]*Validator{
+			http.StatusOK: {
+				Properties: Properties{
+					"id": {Type: JsonInteger, Mandatory: true},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	err = api.AddOperation(&Operation{
+		Method: "GET",
+		Path:   "/foos/special",
+	})
+	require.NoError(t, err)
+	err = api.AddOperation(&Operation{
+		Method: "GET",
+		Path:   "/foos/{id}/bars/{barId}",
+	})
+	require.NoError(t, err)
+	return api
+}
+
+func TestAPI_AddOperationErrors(t *testing.T) {
+	api := &API{}
+	err := api.AddOperation(&Operation{Method: "GET", Path: "foos"})
+	require.Error(t, err)
+	require.Equal(t, "api - path template 'foos' must start with '/'", err.Error())
+	err = api.AddOperation(&Operation{Method: "GET", Path: "/foos//bars"})
+	require.Error(t, err)
+	err = api.AddOperation(&Operation{Method: "GET", Path: "/foos/{id"})
+	require.Error(t, err)
+	require.Equal(t, "api - path template '/foos/{id' has invalid segment '{id'", err.Error())
+	err = api.AddOperation(&Operation{Method: "GET", Path: "/foos/{id}/bars/{id}"})
+	require.Error(t, err)
+	require.Equal(t, "api - path template '/foos/{id}/bars/{id}' has duplicate param name 'id'", err.Error())
+	err = api.AddOperation(&Operation{Path: "/foos"})
+	require.Error(t, err)
+	err = api.AddOperation(&Operation{Method: "get", Path: "/foos/{id}"})
+	require.NoError(t, err)
+	err = api.AddOperation(&Operation{Method: "GET", Path: "/foos/{fooId}"})
+	require.Error(t, err)
+	require.Equal(t, "api - operation GET '/foos/{fooId}' already exists", err.Error())
+	require.Equal(t, 1, len(api.Operations()))
+
+	require.Panics(t, func() {
+		api.MustAddOperation(&Operation{Method: "GET", Path: "/foos/{id}"})
+	})
+}
+
+func TestAPI_Lookup(t *testing.T) {
+	api := testApi(t)
+	op, params, found := api.Lookup("GET", "/foos")
+	require.True(t, found)
+	require.Equal(t, "listFoos", op.OperationId)
+	require.Equal(t, 0, len(params))
+
+	op, _, found = api.Lookup("GET", "/foos/")
+	require.True(t, found)
+	require.Equal(t, "listFoos", op.OperationId)
+
+	op, params, found = api.Lookup("get", "/foos/123")
+	require.True(t, found)
+	require.Equal(t, "/foos/{fooId}", op.Path)
+	require.Equal(t, "123", params["fooId"])
+
+	op, params, found = api.Lookup("GET", "/foos/special")
+	require.True(t, found)
+	require.Equal(t, "/foos/special", op.Path)
+	require.Equal(t, 0, len(params))
+
+	op, params, found = api.Lookup("GET", "/foos/special/bars/a%20b")
+	require.True(t, found)
+	require.Equal(t, "/foos/{id}/bars/{barId}", op.Path)
+	require.Equal(t, "special", params["id"])
+	require.Equal(t, "a b", params["barId"])
+
+	op, _, found = api.Lookup("DELETE", "/foos/123")
+	require.True(t, found)
+	require.Nil(t, op)
+
+	op, _, found = api.Lookup("GET", "/bars")
+	require.False(t, found)
+	require.Nil(t, op)
+	op, _, found = api.Lookup("GET", "/foos/123/bars")
+	require.False(t, found)
+	require.Nil(t, op)
+	op, _, found = api.Lookup("GET", "/")
+	require.False(t, found)
+	require.Nil(t, op)
+
+	op, _, found = (&API{}).Lookup("GET", "/foos")
+	require.False(t, found)
+	require.Nil(t, op)
+}
+
+func TestAPI_LookupLiteralAndParamMethods(t *testing.T) {
+	api := &API{}
+	api.MustAddOperation(&Operation{Method: "GET", Path: "/foos/bar"})
+	api.MustAddOperation(&Operation{Method: "POST", Path: "/foos/{id}"})
+	api.MustAddOperation(&Operation{Method: "PUT", Path: "/foos/bar/baz"})
+	api.MustAddOperation(&Operation{Method: "DELETE", Path: "/foos/{id}/baz"})
+	testCases := []struct {
+		method      string
+		path        string
+		expectPath  string
+		expectId    string
+		expectFound bool
+	}{
+		{"GET", "/foos/bar", "/foos/bar", "", true},
+		{"POST", "/foos/bar", "/foos/{id}", "bar", true},
+		{"POST", "/foos/123", "/foos/{id}", "123", true},
+		{"GET", "/foos/123", "", "", true},
+		{"PATCH", "/foos/bar", "", "", true},
+		{"PUT", "/foos/bar/baz", "/foos/bar/baz", "", true},
+		{"DELETE", "/foos/bar/baz", "/foos/{id}/baz", "bar", true},
+		{"DELETE", "/foos/123/baz", "/foos/{id}/baz", "123", true},
+		{"PUT", "/foos/123/baz", "", "", true},
+		{"GET", "/foos/bar/qux", "", "", false},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("[%d]%s %s", i+1, tc.method, tc.path), func(t *testing.T) {
+			op, params, found := api.Lookup(tc.method, tc.path)
+			require.Equal(t, tc.expectFound, found)
+			if tc.expectPath == "" {
+				require.Nil(t, op)
+			} else {
+				require.NotNil(t, op)
+				require.Equal(t, tc.expectPath, op.Path)
+				require.Equal(t, tc.expectId, params["id"])
+			}
+		})
+	}
+}
+
+func TestAPI_RequestValidate(t *testing.T) {
+	api := testApi(t)
+	req, _ := http.NewRequest("GET", "http://example.com/foos?limit=10&other=x", nil)
+	ok, violations, opReq := api.RequestValidate(req)
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+	require.Equal(t, "listFoos", opReq.Operation.OperationId)
+	require.Equal(t, json.Number("10"), opReq.Query["limit"])
+
+	req, _ = http.NewRequest("GET", "http://example.com/foos?limit=-1", nil)
+	ok, violations, _ = api.RequestValidate(req)
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "limit", violations[0].Property)
+
+	req, _ = http.NewRequest("GET", "http://example.com/foos/123", nil)
+	ok, _, opReq = api.RequestValidate(req)
+	require.True(t, ok)
+	require.Equal(t, json.Number("123"), opReq.PathParams["fooId"])
+
+	req, _ = http.NewRequest("GET", "http://example.com/foos/-1", nil)
+	ok, violations, _ = api.RequestValidate(req)
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "fooId", violations[0].Property)
+
+	req, _ = http.NewRequest("POST", "http://example.com/foos", strings.NewReader(`{"name": "foo"}`))
+	req.Header.Set("x-request-id", "abc")
+	ok, _, opReq = api.RequestValidate(req)
+	require.True(t, ok)
+	require.Equal(t, "abc", opReq.Headers["X-Request-Id"])
+	require.Equal(t, "foo", opReq.Body.(map[string]interface{})["name"])
+
+	req, _ = http.NewRequest("POST", "http://example.com/foos", strings.NewReader(`{}`))
+	ok, violations, _ = api.RequestValidate(req)
+	require.False(t, ok)
+	require.Equal(t, 2, len(violations))
+
+	req, _ = http.NewRequest("PUT", "http://example.com/foos", nil)
+	ok, violations, opReq = api.RequestValidate(req)
+	require.False(t, ok)
+	require.Nil(t, opReq)
+	require.Nil(t, violations)
+}
+
+func TestAPI_HeadersToObject(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"X-Count": {Type: JsonInteger},
+			"X-Flag":  {Type: JsonBoolean},
+			"X-Tags":  {Type: JsonArray},
+		},
+	}
+	req, _ := http.NewRequest("GET", "http://example.com/foos", nil)
+	req.Header.Set("X-Count", "1")
+	req.Header.Set("X-Flag", "true")
+	req.Header.Add("X-Tags", "a, b")
+	req.Header.Add("X-Tags", "c")
+	obj, violations := v.headersToObject(req, nil)
+	require.Equal(t, 0, len(violations))
+	require.Equal(t, json.Number("1"), obj["X-Count"])
+	require.Equal(t, true, obj["X-Flag"])
+	require.Equal(t, []interface{}{"a", "b", "c"}, obj["X-Tags"])
+
+	req.Header.Set("X-Flag", "not a bool")
+	req.Header.Add("X-Count", "2")
+	_, violations = v.headersToObject(req, nil)
+	require.Equal(t, 2, len(violations))
+	SortViolationsByPathAndProperty(violations)
+	require.Equal(t, msgHeaderMultiNotAllowed, violations[0].Message)
+	require.Equal(t, CodeRequestHeaderMultiNotAllowed, violations[0].Codes[0])
+	require.Equal(t, "Header must be of type boolean", violations[1].Message)
+	require.Equal(t, CodeRequestHeaderInvalidType, violations[1].Codes[0])
+	require.True(t, violations[1].BadRequest)
+}
+
+func TestAPI_PathParamsToObject(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"flag": {Type: JsonBoolean},
+		},
+	}
+	_, violations := pathParamsToObject(v, map[string]string{"flag": "nope"}, nil)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "Path param must be of type boolean", violations[0].Message)
+	require.Equal(t, CodeRequestPathParamInvalidType, violations[0].Codes[0])
+
+	obj, violations := pathParamsToObject(nil, map[string]string{"flag": "nope"}, nil)
+	require.Equal(t, 0, len(violations))
+	require.Equal(t, "nope", obj["flag"])
+}
+
+func TestAPI_Handler(t *testing.T) {
+	api := testApi(t)
+	var captured *OperationRequest
+	h := api.Handler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		captured = OperationRequestFromContext(req.Context())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"foo"}`))
+	}))
+
+	req, _ := http.NewRequest("GET", "http://example.com/foos/123", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, captured)
+	require.Equal(t, "/foos/{fooId}", captured.Operation.Path)
+
+	captured = nil
+	req, _ = http.NewRequest("GET", "http://example.com/foos/-1", nil)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.Equal(t, ProblemContentType, w.Header().Get("Content-Type"))
+	require.Nil(t, captured)
+
+	req, _ = http.NewRequest("DELETE", "http://example.com/foos/123", nil)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	req, _ = http.NewRequest("GET", "http://example.com/bars", nil)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	// with response validation...
+	api.ValidateResponses = true
+	api.ResponseMode = ResponseValidationFail
+	req, _ = http.NewRequest("GET", "http://example.com/foos/123", nil)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+
+	require.Nil(t, OperationRequestFromContext(req.Context()))
+}
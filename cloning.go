@@ -11,6 +11,10 @@ func (v *Validator) Clone() *Validator {
 		StopOnFirst:             v.StopOnFirst,
 		UseNumber:               v.UseNumber,
 		OrderedPropertyChecks:   v.OrderedPropertyChecks,
+		IncludeRejectedValues:   v.IncludeRejectedValues,
+		IncludeSourcePositions:  v.IncludeSourcePositions,
+		PathFormat:              v.PathFormat,
+		I18n:                    v.I18n,
 		WhenConditions:          v.WhenConditions.Clone(),
 		ConditionalVariants:     v.ConditionalVariants.Clone(),
 		OasInfo:                 cloneOasInfo(v.OasInfo),
@@ -39,6 +43,7 @@ func (pv *PropertyValidator) Clone() *PropertyValidator {
 		RequiredWithMessage: pv.RequiredWithMessage,
 		UnwantedWith:        pv.UnwantedWith.Clone(),
 		UnwantedWithMessage: pv.UnwantedWithMessage,
+		Label:               pv.Label,
 		OasInfo:             cloneOasInfo(pv.OasInfo),
 	}
 }
@@ -121,10 +126,65 @@ func cloneOasInfo(src *OasInfo) *OasInfo {
 
 func (oas *OasInfo) Clone() *OasInfo {
 	return &OasInfo{
-		Description: oas.Description,
-		Title:       oas.Title,
-		Format:      oas.Format,
-		Example:     oas.Example,
-		Deprecated:  oas.Deprecated,
+		Description:  oas.Description,
+		Title:        oas.Title,
+		Format:       oas.Format,
+		Example:      oas.Example,
+		Deprecated:   oas.Deprecated,
+		ReadOnly:     oas.ReadOnly,
+		WriteOnly:    oas.WriteOnly,
+		Examples:     cloneOasExamples(oas.Examples),
+		Default:      oas.Default,
+		Enum:         cloneOasEnum(oas.Enum),
+		ExternalDocs: cloneOasExternalDocs(oas.ExternalDocs),
+		Nullable:     cloneOasNullable(oas.Nullable),
+		Extensions:   cloneOasExtensions(oas.Extensions),
 	}
 }
+
+func cloneOasExamples(src map[string]string) map[string]string {
+	if src == nil {
+		return nil
+	}
+	result := make(map[string]string, len(src))
+	for k, v := range src {
+		result[k] = v
+	}
+	return result
+}
+
+func cloneOasEnum(src []string) []string {
+	if src == nil {
+		return nil
+	}
+	return append(make([]string, 0, len(src)), src...)
+}
+
+func cloneOasExternalDocs(src *OasExternalDocs) *OasExternalDocs {
+	if src == nil {
+		return nil
+	}
+	return &OasExternalDocs{
+		URL:         src.URL,
+		Description: src.Description,
+	}
+}
+
+func cloneOasNullable(src *bool) *bool {
+	if src == nil {
+		return nil
+	}
+	b := *src
+	return &b
+}
+
+func cloneOasExtensions(src map[string]interface{}) map[string]interface{} {
+	if src == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(src))
+	for k, v := range src {
+		result[k] = v
+	}
+	return result
+}
@@ -56,6 +56,31 @@ func TestOasInfo_Clone(t *testing.T) {
 	require.Nil(t, dst)
 }
 
+func TestOasInfo_CloneDeep(t *testing.T) {
+	nullable := true
+	src := &OasInfo{
+		Examples:     map[string]string{"foo": "bar"},
+		Default:      "baz",
+		Enum:         []string{"bar", "baz"},
+		ExternalDocs: &OasExternalDocs{URL: "https://example.com"},
+		Nullable:     &nullable,
+		Extensions:   map[string]interface{}{"x-foo": "bar"},
+	}
+	dst := src.Clone()
+	require.Equal(t, src, dst)
+
+	dst.Examples["foo"] = "qux"
+	dst.Enum[0] = "qux"
+	dst.ExternalDocs.URL = "https://example.org"
+	*dst.Nullable = false
+	dst.Extensions["x-foo"] = "qux"
+	require.Equal(t, "bar", src.Examples["foo"])
+	require.Equal(t, "bar", src.Enum[0])
+	require.Equal(t, "https://example.com", src.ExternalDocs.URL)
+	require.True(t, *src.Nullable)
+	require.Equal(t, "bar", src.Extensions["x-foo"])
+}
+
 func TestOthersExpr_Clone(t *testing.T) {
 	item := &OtherProperty{Name: "foo"}
 	src := OthersExpr{item}
@@ -129,6 +129,7 @@ func defaultConstraints() map[string]Constraint {
 		"StringValidToken":                &StringValidToken{},
 		"StringValidUnicodeNormalization": &StringValidUnicodeNormalization{},
 		"StringValidUuid":                 &StringValidUuid{},
+		"WarningOnly":                     &WarningOnly{},
 		// abbreviations...
 		"acond":      &ArrayConditionalConstraint{},
 		"adistinctp": &ArrayDistinctProperty{},
@@ -233,6 +234,7 @@ func defaultConstraints() map[string]Constraint {
 		"strvcn":     &StringValidCardNumber{},
 		"strxlen":    &StringExactLength{},
 		"xof":        &MultipleOf{},
+		"warn":       &WarningOnly{},
 		// special abbreviations...
 		"iso3166":           &StringValidCountryCode{Allow3166_1_Numeric: true, Allow3166_2: true},
 		"iso3166-1":         &StringValidCountryCode{},
@@ -361,6 +363,43 @@ func (r *constraintRegistry) search(name string) (alias string, constraint Const
 	return
 }
 
+// nameOf returns the name that the constraint is registered with - where the constraint (i.e. the same instance)
+// is not registered, the constraint's type name is returned
+func (r *constraintRegistry) nameOf(constraint Constraint) string {
+	defer r.sync.Unlock()
+	r.sync.Lock()
+	ty := reflect.TypeOf(constraint)
+	if ty.Kind() != reflect.Ptr {
+		return ty.Name()
+	}
+	name := ty.Elem().Name()
+	if c, ok := r.namedConstraints[name]; ok && c == constraint {
+		return name
+	}
+	result := ""
+	for n, c := range r.namedConstraints {
+		// where the same instance is registered under more than one name, use the first name (alphabetically)...
+		if c == constraint && (result == "" || n < result) {
+			result = n
+		}
+	}
+	if result != "" {
+		return result
+	}
+	return name
+}
+
+// all returns a copy of the registered constraints
+func (r *constraintRegistry) all() map[string]Constraint {
+	defer r.sync.Unlock()
+	r.sync.Lock()
+	result := make(map[string]Constraint, len(r.namedConstraints))
+	for name, c := range r.namedConstraints {
+		result[name] = c
+	}
+	return result
+}
+
 // reset for testing
 func (r *constraintRegistry) reset() {
 	defer r.sync.Unlock()
@@ -7,7 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-const commonConstraintsCount = 103 // excludes abbreviations (every constraint has an abbreviation)
+const commonConstraintsCount = 104 // excludes abbreviations (every constraint has an abbreviation)
 const commonSpecialAbbrsCount = 8  // special abbreviations
 
 func TestConstraintsRegistryInitialized(t *testing.T) {
@@ -296,6 +296,7 @@ func TestAllConstraintMessagesWorkWithNilTranslator(t *testing.T) {
 		"SetConditionIf":             true,
 		"SetConditionOnType":         true,
 		"SetConditionProperty":       true,
+		"WarningOnly":                true,
 		"acond":                      true,
 		"age":                        true,
 		"cfrom":                      true,
@@ -303,6 +304,7 @@ func TestAllConstraintMessagesWorkWithNilTranslator(t *testing.T) {
 		"cond":                       true,
 		"cpty":                       true,
 		"ctype":                      true,
+		"warn":                       true,
 	}
 	for nm, c := range cs {
 		t.Run(nm, func(t *testing.T) {
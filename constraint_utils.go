@@ -5,6 +5,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"golang.org/x/text/unicode/norm"
 	"math"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -22,12 +23,12 @@ const (
 	fmtMsgUnknownPresetPattern   = "Unknown preset pattern '%[1]s'"
 	fmtMsgValidToken             = "String value must be valid token - \"%[1]s\""
 	msgInvalidCharacters         = "String value must not have invalid characters"
-	fmtMsgStringMinLen           = "String value length must be at least %[1]d characters"
-	fmtMsgStringMinLenExc        = "String value length must be greater than %[1]d characters"
-	fmtMsgStringMaxLen           = "String value length must not exceed %[1]d characters"
-	fmtMsgStringMaxLenExc        = "String value length must be less than %[1]d characters"
-	fmtMsgStringExactLen         = "String value length must be %[1]d characters"
-	fmtMsgStringMinMaxLen        = "String value length must be between %[1]d (%[2]s) and %[3]d (%[4]s)"
+	fmtMsgStringMinLen           = "String value length must be at least {1, plural, one{# character} other{# characters}}"
+	fmtMsgStringMinLenExc        = "String value length must be greater than {1, plural, one{# character} other{# characters}}"
+	fmtMsgStringMaxLen           = "String value length must not exceed {1, plural, one{# character} other{# characters}}"
+	fmtMsgStringMaxLenExc        = "String value length must be less than {1, plural, one{# character} other{# characters}}"
+	fmtMsgStringExactLen         = "String value length must be {1, plural, one{# character} other{# characters}}"
+	fmtMsgStringMinMaxLen        = "String value length must be between %[1]d (%[2]s) and {3, plural, one{# character} other{# characters}} (%[4]s)"
 	msgStringLowercase           = "String value must contain only lowercase letters"
 	msgStringUppercase           = "String value must contain only uppercase letters"
 	msgStringValidJson           = "String value must be valid JSON"
@@ -42,10 +43,10 @@ const (
 	fmtMsgStringNotStartsWith    = "String value must not start with %[1]s"
 	fmtMsgStringEndsWith         = "String value must end with %[1]s"
 	fmtMsgStringNotEndsWith      = "String value must not end with %[1]s"
-	fmtMsgMinLen                 = "Value length must be at least %[1]d"
-	fmtMsgMinLenExc              = "Value length must be greater than %[1]d"
-	fmtMsgExactLen               = "Value length must be %[1]d"
-	fmtMsgMinMax                 = "Value length must be between %[1]d (%[2]s) and %[3]d (%[4]s)"
+	fmtMsgMinLen                 = "Value length must be at least {1, plural, one{# item} other{# items}}"
+	fmtMsgMinLenExc              = "Value length must be greater than {1, plural, one{# item} other{# items}}"
+	fmtMsgExactLen               = "Value length must be {1, plural, one{# item} other{# items}}"
+	fmtMsgMinMax                 = "Value length must be between %[1]d (%[2]s) and {3, plural, one{# item} other{# items}} (%[4]s)"
 	msgPositive                  = "Value must be positive"
 	msgPositiveOrZero            = "Value must be positive or zero"
 	msgNegative                  = "Value must be negative"
@@ -60,7 +61,7 @@ const (
 	fmtMsgStrLt                  = "Value must be less than '%[1]s'"
 	fmtMsgStrLte                 = "Value must be less than or equal to '%[1]s'"
 	fmtMsgRange                  = "Value must be between %[1]v (%[2]s) and %[3]v (%[4]s)"
-	fmtMsgMultipleOf             = "Value must be a multiple of %[1]d"
+	fmtMsgMultipleOf             = "Value must be a multiple of {1, number}"
 	fmtMsgArrayElementType       = "Array elements must be of type %[1]s"
 	fmtMsgArrayElementTypeOrNull = "Array elements must be of type %[1]s or null"
 	msgArrayUnique               = "Array elements must be unique"
@@ -420,7 +421,7 @@ func typedEquals(v1, v2 interface{}) bool {
 func jsonNumericCompareFilter(v1, v2 interface{}) bool {
 	if isN1, n1Type := isNumericType(v1); isN1 {
 		if isN2, n2type := isNumericType(v2); isN2 {
-			return n1Type == numericJsonNumber || n1Type != n2type
+			return n1Type == numericJsonNumber || n1Type != n2type || reflect.TypeOf(v1) != reflect.TypeOf(v2)
 		}
 	}
 	return false
@@ -456,30 +457,37 @@ func jsonNumericComparator(av1, av2 interface{}) bool {
 			}
 		case numericFloat:
 			if f1, ok1 := coerceJsonNumberToFloat(jn1); ok1 {
-				return f1 == v2.(float64)
+				f2, _, _ := coerceToFloat(v2)
+				return f1 == f2
 			}
 		case numericInt:
 			if i1, ok1 := coerceJsonNumberToInt(jn1); ok1 {
-				return i1 == int64(v2.(int))
+				i2, _, _ := coerceToInt(v2)
+				return i1 == i2
 			}
 		}
 	case numericFloat:
-		f1 := v1.(float64)
-		switch vt2 {
-		case numericInt:
-			return f1 == float64(v2.(int))
-		}
+		f1, _, _ := coerceToFloat(v1)
+		f2, _, _ := coerceToFloat(v2)
+		return f1 == f2
+	case numericInt:
+		// different int types (e.g. int and int64)...
+		i1, _, _ := coerceToInt(v1)
+		i2, _, _ := coerceToInt(v2)
+		return i1 == i2
 	}
 	return false
 }
 
 func isNumericType(v interface{}) (bool, int) {
-	switch v.(type) {
-	case json.Number:
+	if _, ok := v.(json.Number); ok {
 		return true, numericJsonNumber
-	case float64:
+	}
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Float32, reflect.Float64:
 		return true, numericFloat
-	case int:
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 		return true, numericInt
 	}
 	return false, 0
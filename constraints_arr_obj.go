@@ -55,7 +55,7 @@ func (c *ArrayOf) checkConstraints(i int, elem interface{}, vcx *ValidatorContex
 					vcx.popPath()
 					return false, msg
 				} else {
-					vcx.AddViolationForCurrent(msg, false)
+					vcx.addConstraintViolationForCurrent(msg, constraint)
 				}
 			}
 			vcx.popPath()
@@ -222,13 +222,13 @@ func TestLengthWithString(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgMinMax, 2, tokenInclusive, 3, tokenInclusive), violations[0].Message)
+	require.Equal(t, "Value length must be between 2 (inclusive) and 3 items (inclusive)", violations[0].Message)
 
 	obj["foo"] = "Abcd"
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgMinMax, 2, tokenInclusive, 3, tokenInclusive), violations[0].Message)
+	require.Equal(t, "Value length must be between 2 (inclusive) and 3 items (inclusive)", violations[0].Message)
 
 	obj["foo"] = "Abc"
 	ok, _ = validator.Validate(obj)
@@ -247,7 +247,7 @@ func TestLengthWithString(t *testing.T) {
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgMinLen, 2), violations[0].Message)
+	require.Equal(t, "Value length must be at least 2 items", violations[0].Message)
 }
 
 func TestLengthWithObject(t *testing.T) {
@@ -262,7 +262,7 @@ func TestLengthWithObject(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgMinMax, 2, tokenInclusive, 3, tokenInclusive), violations[0].Message)
+	require.Equal(t, "Value length must be between 2 (inclusive) and 3 items (inclusive)", violations[0].Message)
 
 	obj["foo"] = map[string]interface{}{
 		"foo": nil,
@@ -273,7 +273,7 @@ func TestLengthWithObject(t *testing.T) {
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgMinMax, 2, tokenInclusive, 3, tokenInclusive), violations[0].Message)
+	require.Equal(t, "Value length must be between 2 (inclusive) and 3 items (inclusive)", violations[0].Message)
 
 	obj["foo"] = map[string]interface{}{
 		"foo": nil,
@@ -298,13 +298,13 @@ func TestLengthWithArray(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgMinMax, 2, tokenInclusive, 3, tokenInclusive), violations[0].Message)
+	require.Equal(t, "Value length must be between 2 (inclusive) and 3 items (inclusive)", violations[0].Message)
 
 	obj["foo"] = []interface{}{"foo", "bar", "baz", "quz"}
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgMinMax, 2, tokenInclusive, 3, tokenInclusive), violations[0].Message)
+	require.Equal(t, "Value length must be between 2 (inclusive) and 3 items (inclusive)", violations[0].Message)
 
 	obj["foo"] = []interface{}{"foo", "bar", "baz"}
 	ok, _ = validator.Validate(obj)
@@ -325,7 +325,7 @@ func TestLengthMinOnlyExc(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgMinLenExc, 3), violations[0].Message)
+	require.Equal(t, "Value length must be greater than 3 items", violations[0].Message)
 
 	obj["foo"] = []interface{}{"foo", "bar", "baz", "quz"}
 	ok, _ = validator.Validate(obj)
@@ -335,7 +335,7 @@ func TestLengthMinOnlyExc(t *testing.T) {
 	ok, _ = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgMinLenExc, 3), violations[0].Message)
+	require.Equal(t, "Value length must be greater than 3 items", violations[0].Message)
 
 	obj["foo"] = nil
 	ok, _ = validator.Validate(obj)
@@ -352,13 +352,13 @@ func TestLengthExactWithString(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgExactLen, 3), violations[0].Message)
+	require.Equal(t, "Value length must be 3 items", violations[0].Message)
 
 	obj["foo"] = "Abcd"
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgExactLen, 3), violations[0].Message)
+	require.Equal(t, "Value length must be 3 items", violations[0].Message)
 
 	obj["foo"] = "Abc"
 	ok, _ = validator.Validate(obj)
@@ -381,7 +381,7 @@ func TestLengthExactWithObject(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgExactLen, 3), violations[0].Message)
+	require.Equal(t, "Value length must be 3 items", violations[0].Message)
 
 	obj["foo"] = map[string]interface{}{
 		"foo": nil,
@@ -392,7 +392,7 @@ func TestLengthExactWithObject(t *testing.T) {
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgExactLen, 3), violations[0].Message)
+	require.Equal(t, "Value length must be 3 items", violations[0].Message)
 
 	obj["foo"] = map[string]interface{}{
 		"foo": nil,
@@ -417,13 +417,13 @@ func TestLengthExactWithArray(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgExactLen, 3), violations[0].Message)
+	require.Equal(t, "Value length must be 3 items", violations[0].Message)
 
 	obj["foo"] = []interface{}{"foo", "bar", "baz", "quz"}
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgExactLen, 3), violations[0].Message)
+	require.Equal(t, "Value length must be 3 items", violations[0].Message)
 
 	obj["foo"] = []interface{}{"foo", "bar", "baz"}
 	ok, _ = validator.Validate(obj)
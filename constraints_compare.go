@@ -948,36 +948,48 @@ func (c *DatetimeTolerance) GetMessage(tcx I18nContext) string {
 	if useAmount < 0 {
 		useAmount = 0 - useAmount
 	}
-	if useAmount > 1 {
-		useUnit = useUnit + "..."
-	}
+	amount := newUnitsAmount(useTcx, useAmount, useUnit)
 	if c.MinCheck {
 		useFmt := fmtMsgDtToleranceFixedMinAfter
 		if c.Duration < 0 {
 			useFmt = fmtMsgDtToleranceFixedMinBefore
 		}
-		return useTcx.TranslateFormat(useFmt, useAmount, useTcx.TranslateToken(useUnit), c.Value)
+		return useTcx.TranslateFormat(useFmt, amount, c.Value)
 	}
 	useFmt := fmtMsgDtToleranceFixedMaxAfter
 	if c.Duration < 0 {
 		useFmt = fmtMsgDtToleranceFixedMaxBefore
 	}
-	return useTcx.TranslateFormat(useFmt, useAmount, useTcx.TranslateToken(useUnit), c.Value)
+	return useTcx.TranslateFormat(useFmt, amount, c.Value)
 }
 
+// defaultToleranceUnit returns the (translatable) unit token for a tolerance unit
 func defaultToleranceUnit(unit string) string {
-	if unit == "" {
+	switch unit {
+	case "":
 		return "day"
+	case "millen", "mille":
+		return "millennium"
+	case "min":
+		return "minute"
+	case "sec":
+		return "second"
+	case "milli":
+		return "millisecond"
+	case "micro":
+		return "microsecond"
+	case "nano":
+		return "nanosecond"
 	}
 	return unit
 }
 
 const (
 	fmtMsgDtToleranceFixedSame      = "Value must be same %[1]s as %[2]s"
-	fmtMsgDtToleranceFixedMaxAfter  = "Value must not be more than %[1]d %[2]s after %[3]s"
-	fmtMsgDtToleranceFixedMaxBefore = "Value must not be more than %[1]d %[2]s before %[3]s"
-	fmtMsgDtToleranceFixedMinAfter  = "Value must be at least %[1]d %[2]s after %[3]s"
-	fmtMsgDtToleranceFixedMinBefore = "Value must be at least %[1]d %[2]s before %[3]s"
+	fmtMsgDtToleranceFixedMaxAfter  = "Value must not be more than %[1]s after %[2]s"
+	fmtMsgDtToleranceFixedMaxBefore = "Value must not be more than %[1]s before %[2]s"
+	fmtMsgDtToleranceFixedMinAfter  = "Value must be at least %[1]s after %[2]s"
+	fmtMsgDtToleranceFixedMinBefore = "Value must be at least %[1]s before %[2]s"
 )
 
 // DatetimeToleranceToNow constraint to check that a date/time (as an ISO string) value meets a tolerance against the current time
@@ -1048,29 +1060,27 @@ func (c *DatetimeToleranceToNow) GetMessage(tcx I18nContext) string {
 	if useAmount < 0 {
 		useAmount = 0 - useAmount
 	}
-	if useAmount > 1 {
-		useUnit = useUnit + "..."
-	}
+	amount := newUnitsAmount(useTcx, useAmount, useUnit)
 	if c.MinCheck {
 		useFmt := fmtMsgDtToleranceNowMinAfter
 		if c.Duration < 0 {
 			useFmt = fmtMsgDtToleranceNowMinBefore
 		}
-		return useTcx.TranslateFormat(useFmt, useAmount, useTcx.TranslateToken(useUnit))
+		return useTcx.TranslateFormat(useFmt, amount)
 	}
 	useFmt := fmtMsgDtToleranceNowMaxAfter
 	if c.Duration < 0 {
 		useFmt = fmtMsgDtToleranceNowMaxBefore
 	}
-	return useTcx.TranslateFormat(useFmt, useAmount, useTcx.TranslateToken(useUnit))
+	return useTcx.TranslateFormat(useFmt, amount)
 }
 
 const (
 	fmtMsgDtToleranceNowSame      = "Value must be same %[1]s as now"
-	fmtMsgDtToleranceNowMaxAfter  = "Value must not be more than %[1]d %[2]s after now"
-	fmtMsgDtToleranceNowMaxBefore = "Value must not be more than %[1]d %[2]s before now"
-	fmtMsgDtToleranceNowMinAfter  = "Value must be at least %[1]d %[2]s after now"
-	fmtMsgDtToleranceNowMinBefore = "Value must be at least %[1]d %[2]s before now"
+	fmtMsgDtToleranceNowMaxAfter  = "Value must not be more than %[1]s after now"
+	fmtMsgDtToleranceNowMaxBefore = "Value must not be more than %[1]s before now"
+	fmtMsgDtToleranceNowMinAfter  = "Value must be at least %[1]s after now"
+	fmtMsgDtToleranceNowMinBefore = "Value must be at least %[1]s before now"
 )
 
 // DatetimeToleranceToOther constraint to check that a date/time (as an ISO string) value meets a tolerance against the
@@ -1153,29 +1163,27 @@ func (c *DatetimeToleranceToOther) GetMessage(tcx I18nContext) string {
 	if useAmount < 0 {
 		useAmount = 0 - useAmount
 	}
-	if useAmount > 1 {
-		useUnit = useUnit + "..."
-	}
+	amount := newUnitsAmount(useTcx, useAmount, useUnit)
 	if c.MinCheck {
 		useFmt := fmtMsgDtToleranceOtherMinAfter
 		if c.Duration < 0 {
 			useFmt = fmtMsgDtToleranceOtherMinBefore
 		}
-		return useTcx.TranslateFormat(useFmt, useAmount, useTcx.TranslateToken(useUnit), c.PropertyName)
+		return useTcx.TranslateFormat(useFmt, amount, c.PropertyName)
 	}
 	useFmt := fmtMsgDtToleranceOtherMaxAfter
 	if c.Duration < 0 {
 		useFmt = fmtMsgDtToleranceOtherMaxBefore
 	}
-	return useTcx.TranslateFormat(useFmt, useAmount, useTcx.TranslateToken(useUnit), c.PropertyName)
+	return useTcx.TranslateFormat(useFmt, amount, c.PropertyName)
 }
 
 const (
 	fmtMsgDtToleranceOtherSame      = "Value must be same %[1]s as value of property '%[2]s'"
-	fmtMsgDtToleranceOtherMaxAfter  = "Value must not be more than %[1]d %[2]s after value of property '%[3]s'"
-	fmtMsgDtToleranceOtherMaxBefore = "Value must not be more than %[1]d %[2]s before value of property '%[3]s'"
-	fmtMsgDtToleranceOtherMinAfter  = "Value must be at least %[1]d %[2]s after value of property '%[3]s'"
-	fmtMsgDtToleranceOtherMinBefore = "Value must be at least %[1]d %[2]s before value of property '%[3]s'"
+	fmtMsgDtToleranceOtherMaxAfter  = "Value must not be more than %[1]s after value of property '%[2]s'"
+	fmtMsgDtToleranceOtherMaxBefore = "Value must not be more than %[1]s before value of property '%[2]s'"
+	fmtMsgDtToleranceOtherMinAfter  = "Value must be at least %[1]s after value of property '%[2]s'"
+	fmtMsgDtToleranceOtherMinBefore = "Value must be at least %[1]s before value of property '%[2]s'"
 )
 
 func checkDatetimeTolerance(value *time.Time, other *time.Time, amount int64, unit string, minCheck bool) bool {
@@ -571,14 +571,14 @@ func (c *DatetimeYearsOld) calculateAge(now, dob time.Time) int {
 }
 
 const (
-	fmtMsgDtAgeMin          = "Age must be over %[1]d years old"
-	fmtMsgDtAgeMinOrOver    = "Age must be %[1]d years old or over"
-	fmtMsgDtAgeMax          = "Age must be under %[1]d years old"
-	fmtMsgDtAgeMaxOrUnder   = "Age must be %[1]d years old or under"
-	fmtMsgDtAgeMinExcMaxExc = "Age must be over %[1]d years old and under %[2]d years old"
-	fmtMsgDtAgeMinMax       = "Age must be between %[1]d years old and %[2]d years old"
-	fmtMsgDtAgeMinMaxExc    = "Age must be %[1]d years old or over and under %[2]d years old"
-	fmtMsgDtAgeMinExcMax    = "Age must be between over %[1]d years old and %[2]d years old or under"
+	fmtMsgDtAgeMin          = "Age must be over {1, plural, one{# year} other{# years}} old"
+	fmtMsgDtAgeMinOrOver    = "Age must be {1, plural, one{# year} other{# years}} old or over"
+	fmtMsgDtAgeMax          = "Age must be under {1, plural, one{# year} other{# years}} old"
+	fmtMsgDtAgeMaxOrUnder   = "Age must be {1, plural, one{# year} other{# years}} old or under"
+	fmtMsgDtAgeMinExcMaxExc = "Age must be over {1, plural, one{# year} other{# years}} old and under {2, plural, one{# year} other{# years}} old"
+	fmtMsgDtAgeMinMax       = "Age must be between {1, plural, one{# year} other{# years}} old and {2, plural, one{# year} other{# years}} old"
+	fmtMsgDtAgeMinMaxExc    = "Age must be {1, plural, one{# year} other{# years}} old or over and under {2, plural, one{# year} other{# years}} old"
+	fmtMsgDtAgeMinExcMax    = "Age must be between over {1, plural, one{# year} other{# years}} old and {2, plural, one{# year} other{# years}} old or under"
 )
 
 // GetMessage implements the Constraint.GetMessage
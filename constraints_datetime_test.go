@@ -1089,27 +1089,27 @@ func TestDatetimeYearsOld_GetMessage(t *testing.T) {
 		Minimum: 1,
 	}
 	msg := c.GetMessage(vcx)
-	require.Equal(t, "Age must be 1 years old or over", msg)
+	require.Equal(t, "Age must be 1 year old or over", msg)
 
 	c = &DatetimeYearsOld{
 		Minimum:      1,
 		ExclusiveMin: true,
 	}
 	msg = c.GetMessage(vcx)
-	require.Equal(t, "Age must be over 1 years old", msg)
+	require.Equal(t, "Age must be over 1 year old", msg)
 
 	c = &DatetimeYearsOld{
 		Maximum: 1,
 	}
 	msg = c.GetMessage(vcx)
-	require.Equal(t, "Age must be 1 years old or under", msg)
+	require.Equal(t, "Age must be 1 year old or under", msg)
 
 	c = &DatetimeYearsOld{
 		Maximum:      1,
 		ExclusiveMax: true,
 	}
 	msg = c.GetMessage(vcx)
-	require.Equal(t, "Age must be under 1 years old", msg)
+	require.Equal(t, "Age must be under 1 year old", msg)
 
 	c = &DatetimeYearsOld{
 		Minimum: 18,
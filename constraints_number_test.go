@@ -414,19 +414,19 @@ func TestMultipleOf(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgMultipleOf, 5), violations[0].Message)
+	require.Equal(t, "Value must be a multiple of 5", violations[0].Message)
 
 	obj["foo"] = 6
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgMultipleOf, 5), violations[0].Message)
+	require.Equal(t, "Value must be a multiple of 5", violations[0].Message)
 
 	obj["foo"] = json.Number("16")
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgMultipleOf, 5), violations[0].Message)
+	require.Equal(t, "Value must be a multiple of 5", violations[0].Message)
 
 	obj["foo"] = json.Number("20.00000000")
 	ok, _ = validator.Validate(obj)
@@ -559,8 +559,8 @@ const (
 	constraintSetFieldOneOf         = "OneOf"
 	constraintSetFieldMessage       = constraintPtyNameMessage
 	constraintSetFieldStop          = constraintPtyNameStop
-	fmtMsgConstraintSetDefaultAllOf = "Constraint set must pass all of %[1]d undisclosed validations"
-	fmtMsgConstraintSetDefaultOneOf = "Constraint set must pass one of %[1]d undisclosed validations"
+	fmtMsgConstraintSetDefaultAllOf = "Constraint set must pass all of {1, plural, one{# undisclosed validation} other{# undisclosed validations}}"
+	fmtMsgConstraintSetDefaultOneOf = "Constraint set must pass one of {1, plural, one{# undisclosed validation} other{# undisclosed validations}}"
 )
 
 // Check implements the Constraint.Check and checks the constraints within the set
@@ -687,3 +687,43 @@ func (c *IsNotNull) Check(v interface{}, vcx *ValidatorContext) (bool, string) {
 func (c *IsNotNull) GetMessage(tcx I18nContext) string {
 	return defaultMessage(tcx, c.Message, msgValueCannotBeNull)
 }
+
+// WarningOnly is a special constraint that wraps another constraint - where the wrapped constraint fails, a
+// violation with SeverityWarning (or SeverityInfo) is added but the validation does not fail
+//
+// WarningOnly constraints can also be specified in v8n tags by prefixing the constraint name with '?' - e.g.
+//   v8n:"&?StringMaxLength{20}"
+//
+// Note: the wrapped constraint should not itself add violations or stop validation (e.g. by having its Stop set)
+type WarningOnly struct {
+	// Constraint is the wrapped constraint
+	Constraint Constraint
+	// Info when set to true, the violation is added with SeverityInfo (rather than SeverityWarning)
+	Info bool
+}
+
+// Check implements Constraint.Check
+func (c *WarningOnly) Check(v interface{}, vcx *ValidatorContext) (bool, string) {
+	if c.Constraint != nil {
+		if ok, msg := c.Constraint.Check(v, vcx); !ok {
+			severity := SeverityWarning
+			if c.Info {
+				severity = SeverityInfo
+			}
+			if vcx.currentStackItem().property == nil {
+				vcx.addSeverityConstraintViolationForCurrent(severity, msg, c.Constraint, CodeValidatorConstraintFail)
+			} else {
+				vcx.addSeverityConstraintViolationForCurrent(severity, msg, c.Constraint, CodePropertyConstraintFail)
+			}
+		}
+	}
+	return true, ""
+}
+
+// GetMessage implements the Constraint.GetMessage
+func (c *WarningOnly) GetMessage(tcx I18nContext) string {
+	if c.Constraint != nil {
+		return c.Constraint.GetMessage(tcx)
+	}
+	return ""
+}
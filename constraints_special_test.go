@@ -1292,7 +1292,7 @@ func TestConstraintSetNoMsg(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinMaxLen, 16, tokenInclusive, 64, tokenInclusive), violations[0].Message)
+	require.Equal(t, "String value length must be between 16 (inclusive) and 64 characters (inclusive)", violations[0].Message)
 
 	// some more not oks...
 	obj["foo"] = "abcdefghijklmnopqrstuvwxyz" // not starts with capital
@@ -1304,12 +1304,12 @@ func TestConstraintSetNoMsg(t *testing.T) {
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinMaxLen, 16, tokenInclusive, 64, tokenInclusive), violations[0].Message)
+	require.Equal(t, "String value length must be between 16 (inclusive) and 64 characters (inclusive)", violations[0].Message)
 	obj["foo"] = "Abc" // too short
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinMaxLen, 16, tokenInclusive, 64, tokenInclusive), violations[0].Message)
+	require.Equal(t, "String value length must be between 16 (inclusive) and 64 characters (inclusive)", violations[0].Message)
 	obj["foo"] = "Abc.01234567890123456" // contains invalid char
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
@@ -1363,12 +1363,12 @@ func TestConstraintSetCeases(t *testing.T) {
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinMaxLen, 16, tokenInclusive, 64, tokenInclusive), violations[0].Message)
+	require.Equal(t, "String value length must be between 16 (inclusive) and 64 characters (inclusive)", violations[0].Message)
 	obj["foo"] = "Abc" // too short
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinMaxLen, 16, tokenInclusive, 64, tokenInclusive), violations[0].Message)
+	require.Equal(t, "String value length must be between 16 (inclusive) and 64 characters (inclusive)", violations[0].Message)
 	obj["foo"] = "Abc.01234567890123456" // contains invalid char
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
@@ -1388,7 +1388,7 @@ func TestConstraintSetOneOf(t *testing.T) {
 		OneOf:       true,
 		Constraints: Constraints{constraint1, constraint2},
 	}
-	require.Equal(t, fmt.Sprintf(fmtMsgConstraintSetDefaultOneOf, 2), set.GetMessage(nil))
+	require.Equal(t, "Constraint set must pass one of 2 undisclosed validations", set.GetMessage(nil))
 
 	validator := buildFooValidator(JsonString, set, false)
 	obj := jsonObject(`{
@@ -1402,7 +1402,7 @@ func TestConstraintSetOneOf(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgConstraintSetDefaultOneOf, 2), violations[0].Message)
+	require.Equal(t, "Constraint set must pass one of 2 undisclosed validations", violations[0].Message)
 
 	constraint1.stops = false
 	constraint1.msg = "first message"
@@ -1418,7 +1418,7 @@ func TestConstraintSetDefaultMessage(t *testing.T) {
 	set := &ConstraintSet{
 		Constraints: Constraints{&testConstraint{}},
 	}
-	require.Equal(t, fmt.Sprintf(fmtMsgConstraintSetDefaultAllOf, 1), set.GetMessage(nil))
+	require.Equal(t, "Constraint set must pass all of 1 undisclosed validation", set.GetMessage(nil))
 
 	validator := buildFooValidator(JsonString, set, false)
 	obj := jsonObject(`{
@@ -1427,14 +1427,14 @@ func TestConstraintSetDefaultMessage(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgConstraintSetDefaultAllOf, 1), violations[0].Message)
+	require.Equal(t, "Constraint set must pass all of 1 undisclosed validation", violations[0].Message)
 
 	set.OneOf = true
-	require.Equal(t, fmt.Sprintf(fmtMsgConstraintSetDefaultOneOf, 1), set.GetMessage(nil))
+	require.Equal(t, "Constraint set must pass one of 1 undisclosed validation", set.GetMessage(nil))
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgConstraintSetDefaultOneOf, 1), violations[0].Message)
+	require.Equal(t, "Constraint set must pass one of 1 undisclosed validation", violations[0].Message)
 }
 
 func TestConstraintSetWithConditionals(t *testing.T) {
@@ -1521,3 +1521,99 @@ func TestIsNotNull(t *testing.T) {
 	require.Equal(t, "foo", violations[0].Property)
 	require.Equal(t, "", violations[0].Path)
 }
+
+func TestWarningOnly(t *testing.T) {
+	validator := buildFooValidator(JsonString, &WarningOnly{Constraint: &StringMaxLength{Value: 3}}, false)
+	obj := jsonObject(`{
+		"foo": "abc"
+	}`)
+	ok, violations := validator.Validate(obj)
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+
+	obj["foo"] = "abcd"
+	var warnings []*Violation
+	ok, violations = validator.ValidateWith(obj, WithWarnings(&warnings))
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+	require.Equal(t, 1, len(warnings))
+	require.Equal(t, SeverityWarning, warnings[0].Severity)
+	require.False(t, warnings[0].IsError())
+	require.Equal(t, "String value length must not exceed 3 characters", warnings[0].Message)
+	require.Equal(t, CodePropertyConstraintFail, warnings[0].Code)
+	require.Equal(t, "StringMaxLength", warnings[0].Constraint)
+	require.Equal(t, "foo", warnings[0].Property)
+	require.Equal(t, "", warnings[0].Path)
+
+	validator = buildFooValidator(JsonString, &WarningOnly{Constraint: &StringMaxLength{Value: 3}, Info: true}, false)
+	warnings = nil
+	ok, violations = validator.ValidateWith(obj, WithWarnings(&warnings))
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+	require.Equal(t, 1, len(warnings))
+	require.Equal(t, SeverityInfo, warnings[0].Severity)
+}
+
+func TestWarningOnly_OnValidatorAndWithErrors(t *testing.T) {
+	validator := &Validator{
+		Constraints: Constraints{&WarningOnly{Constraint: &Length{Minimum: 2}}},
+		Properties: Properties{
+			"foo": {Type: JsonString, Mandatory: true},
+		},
+	}
+	var warnings []*Violation
+	ok, violations := validator.ValidateWith(map[string]interface{}{"foo": "a"}, WithWarnings(&warnings))
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+	require.Equal(t, 1, len(warnings))
+	require.Equal(t, CodeValidatorConstraintFail, warnings[0].Code)
+	require.Equal(t, SeverityWarning, warnings[0].Severity)
+
+	warnings = nil
+	ok, violations = validator.ValidateWith(map[string]interface{}{}, WithWarnings(&warnings))
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, CodeMissingProperty, violations[0].Code)
+	require.Equal(t, 1, len(warnings))
+
+	// without WithWarnings, warnings are not reported...
+	ok, violations = validator.Validate(map[string]interface{}{"foo": "a"})
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+}
+
+func TestWarningOnly_FromTag(t *testing.T) {
+	type myStruct struct {
+		Foo string `json:"foo" v8n:"&?StringMaxLength{3}, &StringNotEmpty"`
+		Bar string `json:"bar" v8n:"&?[!SKIP]StringMinLength{2}"`
+	}
+	validator, err := ValidatorFor(myStruct{})
+	require.NoError(t, err)
+	w, ok := validator.Properties["foo"].Constraints[0].(*WarningOnly)
+	require.True(t, ok)
+	require.Equal(t, 3, w.Constraint.(*StringMaxLength).Value)
+	_, ok = validator.Properties["foo"].Constraints[1].(*StringNotEmpty)
+	require.True(t, ok)
+	w, ok = validator.Properties["bar"].Constraints[0].(*WarningOnly)
+	require.True(t, ok)
+	_, ok = w.Constraint.(*ConditionalConstraint)
+	require.True(t, ok)
+
+	var warnings []*Violation
+	ok, violations := validator.ValidateWith(map[string]interface{}{"foo": "abcd", "bar": "a"}, WithWarnings(&warnings))
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+	require.Equal(t, 2, len(warnings))
+	for _, v := range warnings {
+		require.Equal(t, SeverityWarning, v.Severity)
+	}
+	ok, violations = validator.Validate(map[string]interface{}{"foo": "", "bar": "ab"})
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.True(t, violations[0].IsError())
+
+	_, err = ValidatorFor(struct {
+		Foo string `json:"foo" v8n:"&?Unknown"`
+	}{})
+	require.Error(t, err)
+}
@@ -531,13 +531,13 @@ func TestStringExactLength(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringExactLen, 2), violations[0].Message)
+	require.Equal(t, "String value length must be 2 characters", violations[0].Message)
 
 	obj["foo"] = "Abc"
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringExactLen, 2), violations[0].Message)
+	require.Equal(t, "String value length must be 2 characters", violations[0].Message)
 
 	obj["foo"] = "Ab"
 	ok, _ = validator.Validate(obj)
@@ -571,13 +571,13 @@ func TestStringExactLengthWithRuneLength(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringExactLen, 2), violations[0].Message)
+	require.Equal(t, "String value length must be 2 characters", violations[0].Message)
 
 	obj["foo"] = "Abc"
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringExactLen, 2), violations[0].Message)
+	require.Equal(t, "String value length must be 2 characters", violations[0].Message)
 
 	obj["foo"] = "Ab"
 	ok, _ = validator.Validate(obj)
@@ -607,7 +607,7 @@ func TestStringExactLengthWithNormalisationForm(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringExactLen, 1), violations[0].Message)
+	require.Equal(t, "String value length must be 1 character", violations[0].Message)
 
 	validator = buildFooValidator(JsonString,
 		&StringExactLength{Value: 1, UseRuneLen: true, NormalisationForm: "NFC"}, false)
@@ -630,13 +630,13 @@ func TestStringLength(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinMaxLen, 2, tokenInclusive, 3, tokenInclusive), violations[0].Message)
+	require.Equal(t, "String value length must be between 2 (inclusive) and 3 characters (inclusive)", violations[0].Message)
 
 	obj["foo"] = "Abcd"
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinMaxLen, 2, tokenInclusive, 3, tokenInclusive), violations[0].Message)
+	require.Equal(t, "String value length must be between 2 (inclusive) and 3 characters (inclusive)", violations[0].Message)
 
 	obj["foo"] = "Abc"
 	ok, _ = validator.Validate(obj)
@@ -656,7 +656,7 @@ func TestStringLength(t *testing.T) {
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinLen, 2), violations[0].Message)
+	require.Equal(t, "String value length must be at least 2 characters", violations[0].Message)
 }
 
 func TestStringLength_Strict(t *testing.T) {
@@ -682,7 +682,7 @@ func TestStringLengthMinOnlyExc(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinLenExc, 3), violations[0].Message)
+	require.Equal(t, "String value length must be greater than 3 characters", violations[0].Message)
 }
 
 func TestStringLengthExc(t *testing.T) {
@@ -695,13 +695,13 @@ func TestStringLengthExc(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinMaxLen, 3, tokenExclusive, 5, tokenExclusive), violations[0].Message)
+	require.Equal(t, "String value length must be between 3 (exclusive) and 5 characters (exclusive)", violations[0].Message)
 
 	obj["foo"] = "Abcde"
 	ok, violations = validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinMaxLen, 3, tokenExclusive, 5, tokenExclusive), violations[0].Message)
+	require.Equal(t, "String value length must be between 3 (exclusive) and 5 characters (exclusive)", violations[0].Message)
 
 	obj["foo"] = "Abcd"
 	ok, _ = validator.Validate(obj)
@@ -719,7 +719,7 @@ func TestStringLengthWithRuneLength(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinMaxLen, 1, tokenInclusive, 1, tokenInclusive), violations[0].Message)
+	require.Equal(t, "String value length must be between 1 (inclusive) and 1 character (inclusive)", violations[0].Message)
 
 	// now try again but using rune length (actual Unicode length)...
 	validator = buildFooValidator(JsonString,
@@ -738,7 +738,7 @@ func TestStringLengthWithNormalisationForm(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinMaxLen, 1, tokenInclusive, 1, tokenInclusive), violations[0].Message)
+	require.Equal(t, "String value length must be between 1 (inclusive) and 1 character (inclusive)", violations[0].Message)
 
 	validator = buildFooValidator(JsonString,
 		&StringLength{Minimum: 1, Maximum: 1, UseRuneLen: true, NormalisationForm: "NFC"}, false)
@@ -795,7 +795,7 @@ func TestStringMaxLength(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMaxLen, 2), violations[0].Message)
+	require.Equal(t, "String value length must not exceed 2 characters", violations[0].Message)
 
 	obj["foo"] = "Ab"
 	ok, _ = validator.Validate(obj)
@@ -829,7 +829,7 @@ func TestStringMaxLengthExclusive(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMaxLenExc, 2), violations[0].Message)
+	require.Equal(t, "String value length must be less than 2 characters", violations[0].Message)
 
 	obj["foo"] = "Ab"
 	ok, _ = validator.Validate(obj)
@@ -855,7 +855,7 @@ func TestStringMaxLengthWithRuneLength(t *testing.T) {
 	ok, violations := vWithoutUnicode.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMaxLen, 1), violations[0].Message)
+	require.Equal(t, "String value length must not exceed 1 character", violations[0].Message)
 }
 
 func TestStringMaxLengthWithNormalisationForm(t *testing.T) {
@@ -868,7 +868,7 @@ func TestStringMaxLengthWithNormalisationForm(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMaxLen, 1), violations[0].Message)
+	require.Equal(t, "String value length must not exceed 1 character", violations[0].Message)
 
 	validator = buildFooValidator(JsonString,
 		&StringMaxLength{Value: 1, UseRuneLen: true, NormalisationForm: "NFC"}, false)
@@ -891,7 +891,7 @@ func TestStringMinLength(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinLen, 2), violations[0].Message)
+	require.Equal(t, "String value length must be at least 2 characters", violations[0].Message)
 
 	obj["foo"] = "Ab"
 	ok, _ = validator.Validate(obj)
@@ -925,7 +925,7 @@ func TestStringMinLengthExclusive(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinLenExc, 2), violations[0].Message)
+	require.Equal(t, "String value length must be greater than 2 characters", violations[0].Message)
 
 	obj["foo"] = "Ab"
 	ok, _ = validator.Validate(obj)
@@ -949,7 +949,7 @@ func TestStringMinLengthWithRuneLength(t *testing.T) {
 	ok, violations := vWithUnicode.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinLen, 2), violations[0].Message)
+	require.Equal(t, "String value length must be at least 2 characters", violations[0].Message)
 	ok, _ = vWithoutUnicode.Validate(obj)
 	require.True(t, ok)
 }
@@ -969,7 +969,7 @@ func TestStringMinLengthWithNormalisationForm(t *testing.T) {
 	ok, violations := validator.Validate(obj)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinLen, 2), violations[0].Message)
+	require.Equal(t, "String value length must be at least 2 characters", violations[0].Message)
 
 	validator = buildFooValidator(JsonString,
 		&StringMinLength{Value: 1, UseRuneLen: true, NormalisationForm: "NFC"}, false)
@@ -2,7 +2,6 @@ package valix
 
 import (
 	"fmt"
-	"golang.org/x/text/language"
 	"net/http"
 	"strings"
 )
@@ -22,12 +21,18 @@ type ValidatorContext struct {
 	rootValidator *Validator
 	// violations is the collected violations
 	violations []*Violation
+	// warnings is the collected warnings (i.e. violations with SeverityWarning or SeverityInfo)
+	warnings []*Violation
+	// warningsTo, if set, is where warnings are also collected to (see WithWarnings)
+	warningsTo *[]*Violation
 	// pathStack is the path as each property or array index is checked
 	pathStack []*pathStackItem
 	// i18nContext is the actual I18nContext used to perform translations
 	i18nContext I18nContext
 	// locking is the locking level of the context
 	locking uint
+	// response is whether the context is validating a response (see ResponseValidator)
+	response bool
 }
 
 type Conditions []string
@@ -40,7 +45,7 @@ func newValidatorContext(root interface{}, rootValidator *Validator, stopOnFirst
 		root:          root,
 		rootValidator: rootValidator,
 		violations:    []*Violation{},
-		pathStack:     []*pathStackItem{newRootPathStackItem(root, rootValidator)},
+		pathStack:     []*pathStackItem{newRootPathStackItem(root, rootValidator, rootPathFormat(rootValidator))},
 		i18nContext:   obtainI18nContext(i18nCtx),
 	}
 }
@@ -53,16 +58,25 @@ func newEmptyValidatorContext(i18nCtx I18nContext) *ValidatorContext {
 		root:          nil,
 		rootValidator: nil,
 		violations:    []*Violation{},
-		pathStack:     []*pathStackItem{newRootPathStackItem(nil, nil)},
+		pathStack:     []*pathStackItem{newRootPathStackItem(nil, nil, PathFormatDotted)},
 		i18nContext:   obtainI18nContext(i18nCtx),
 	}
 }
 
 // AddViolation adds a Violation to the validation context
 //
-// Note: Adding a violation always causes the validator to fail!
+// Note: Adding a violation always causes the validator to fail! (unless the violation has
+// a Violation.Severity of SeverityWarning or SeverityInfo - in which case the violation is
+// reported as a warning and not in the returned violations, see WithWarnings)
 func (vc *ValidatorContext) AddViolation(v *Violation) {
 	if vc.locking == 0 {
+		if !v.IsError() {
+			vc.warnings = append(vc.warnings, v)
+			if vc.warningsTo != nil {
+				*vc.warningsTo = append(*vc.warningsTo, v)
+			}
+			return
+		}
 		vc.violations = append(vc.violations, v)
 		vc.ok = false
 		if vc.stopOnFirst {
@@ -84,18 +98,55 @@ func (vc *ValidatorContext) AddViolationForCurrent(msg string, translate bool, c
 		if translate {
 			useMsg = vc.TranslateMessage(msg)
 		}
-		vc.AddViolation(NewViolation(curr.propertyAsString(), curr.path, useMsg, codes...))
+		violation := NewViolation(curr.propertyAsString(), curr.path, vc.renderMessageForCurrent(useMsg, nil, nil), codes...)
+		violation.location, violation.pathFormat = vc.currentLocation(), curr.format
+		vc.AddViolation(violation)
+	}
+}
+
+// AddViolationErrorForCurrent adds a Violation to the validation context for
+// the current property and path - where the violation message is the error message and the
+// error is wrapped by the violation (so can be obtained using errors.Is / errors.As - see Violation.Unwrap)
+//
+// Note: Adding a violation always causes the validator to fail!
+func (vc *ValidatorContext) AddViolationErrorForCurrent(err error, codes ...interface{}) {
+	if vc.locking == 0 && err != nil {
+		vc.addTranslatedViolationForCurrent(err.Error(), append(codes, err)...)
 	}
 }
 
 // internal and message is already translated
 func (vc *ValidatorContext) addTranslatedViolationForCurrent(msg string, codes ...interface{}) {
 	if vc.locking == 0 {
-		curr := vc.currentStackItem()
-		vc.AddViolation(NewViolation(curr.propertyAsString(), curr.path, msg, codes...))
+		vc.AddViolation(vc.newViolationForCurrent(msg, codes...))
 	}
 }
 
+// internal, message is already translated and the violation is for the failing constraint
+func (vc *ValidatorContext) addConstraintViolationForCurrent(msg string, constraint Constraint, codes ...interface{}) {
+	vc.addSeverityConstraintViolationForCurrent(SeverityError, msg, constraint, codes...)
+}
+
+// internal, message is already translated and the violation is for the failing constraint
+func (vc *ValidatorContext) addSeverityConstraintViolationForCurrent(severity Severity, msg string, constraint Constraint, codes ...interface{}) {
+	if vc.locking == 0 {
+		violation := vc.newViolationForCurrent(vc.renderConstraintMessageForCurrent(msg, constraint), codes...)
+		violation.setConstraint(constraint)
+		violation.Severity = severity
+		vc.AddViolation(violation)
+	}
+}
+
+func (vc *ValidatorContext) newViolationForCurrent(msg string, codes ...interface{}) *Violation {
+	curr := vc.currentStackItem()
+	result := NewViolation(curr.propertyAsString(), curr.path, msg, codes...)
+	result.location, result.pathFormat = vc.currentLocation(), curr.format
+	if vc.rootValidator != nil && vc.rootValidator.IncludeRejectedValues {
+		result.Value = curr.value
+	}
+	return result
+}
+
 // internal and message gets translated
 func (vc *ValidatorContext) addUnTranslatedViolationForCurrent(msg string, codes ...interface{}) {
 	if vc.locking == 0 {
@@ -105,9 +156,17 @@ func (vc *ValidatorContext) addUnTranslatedViolationForCurrent(msg string, codes
 
 // internal and message is translated
 func (vc *ValidatorContext) addViolationPropertyForCurrent(name string, msg string, codes ...interface{}) {
+	vc.addSeverityViolationPropertyForCurrent(SeverityError, name, msg, codes...)
+}
+
+// internal and message is translated
+func (vc *ValidatorContext) addSeverityViolationPropertyForCurrent(severity Severity, name string, msg string, codes ...interface{}) {
 	if vc.locking == 0 {
 		curr := vc.currentStackItem()
-		vc.AddViolation(NewViolation(name, curr.asPath(), vc.TranslateMessage(msg), codes...))
+		violation := NewViolation(name, curr.asPath(), vc.renderMessageForCurrent(vc.TranslateMessage(msg), &name, nil), codes...)
+		violation.location, violation.pathFormat = append(vc.currentLocation(), name), curr.format
+		violation.Severity = severity
+		vc.AddViolation(violation)
 	}
 }
 
@@ -171,6 +230,18 @@ func (vc *ValidatorContext) CurrentPropertyName() *string {
 	return nil
 }
 
+// CurrentLabel returns the display label of the current property - translated using TranslateToken
+// (see PropertyValidator.Label)
+//
+// If the current property has no label, the property name (or "[n]" for array elements) is returned
+func (vc *ValidatorContext) CurrentLabel() string {
+	curr := vc.currentStackItem()
+	if pv, ok := curr.validator.(*PropertyValidator); ok && pv.Label != "" {
+		return vc.TranslateToken(pv.Label)
+	}
+	return curr.propertyAsString()
+}
+
 // CurrentArrayIndex returns the current array index (or nil if current is a property name)
 func (vc *ValidatorContext) CurrentArrayIndex() *int {
 	pty := vc.CurrentProperty()
@@ -434,18 +505,10 @@ func (vc *ValidatorContext) setConditionsFromRequest(req *http.Request) {
 	vc.SetCondition("METHOD_" + req.Method)
 	contentLang := DefaultLanguage
 	contentRegion := DefaultRegion
-	tags, _, err := language.ParseAcceptLanguage(req.Header.Get("Content-Language"))
-	if err == nil {
-		for _, tag := range tags {
-			baseLang, _ := tag.Base()
-			if baseLang.String() == defaultLanguage(baseLang.String()) {
-				contentLang = baseLang.String()
-				_, _, rawRgn := tag.Raw()
-				if rawRgn.String() != "ZZ" {
-					contentRegion = rawRgn.String()
-				}
-				break
-			}
+	if lang, rgn, ok := matchAcceptLanguage(req.Header.Get("Content-Language")); ok {
+		contentLang = lang
+		if rgn != "" {
+			contentRegion = rgn
 		}
 	}
 	vc.SetCondition("LANG_" + contentLang)
@@ -475,12 +538,46 @@ func (vc *ValidatorContext) pushPathIndex(idx int, value interface{}, validator
 	vc.pathStack = append(vc.pathStack, vc.currentStackItem().clone(idx, value, validator))
 }
 
+// currentLocation returns the property names and array indices from the root to the current property
+func (vc *ValidatorContext) currentLocation() []interface{} {
+	result := make([]interface{}, 0, len(vc.pathStack))
+	for _, itm := range vc.pathStack {
+		if itm.property != nil {
+			result = append(result, itm.property)
+		}
+	}
+	return result
+}
+
 func (vc *ValidatorContext) popPath() {
 	if len(vc.pathStack) > 1 {
 		vc.pathStack = vc.pathStack[:len(vc.pathStack)-1]
 	}
 }
 
+// IsResponse returns whether the validation is of a response (see ResponseValidator)
+func (vc *ValidatorContext) IsResponse() bool {
+	return vc.response
+}
+
+// isDirectionalUnwanted checks whether the property is unwanted for the direction of validation
+// (i.e. a read-only property in a request or a write-only property in a response)
+func (vc *ValidatorContext) isDirectionalUnwanted(pv *PropertyValidator) bool {
+	if pv.OasInfo != nil {
+		return (vc.response && pv.OasInfo.WriteOnly) || (!vc.response && pv.OasInfo.ReadOnly)
+	}
+	return false
+}
+
+// isDirectionalMandatory checks whether the property can be mandatory for the direction of validation
+// (i.e. a read-only property is not mandatory in a request and a write-only property is not mandatory in a response)
+func (vc *ValidatorContext) isDirectionalMandatory(pv *PropertyValidator) bool {
+	if pv.OasInfo != nil {
+		return !((vc.response && pv.OasInfo.WriteOnly) || (!vc.response && pv.OasInfo.ReadOnly))
+	}
+	return true
+}
+
 func (vc *ValidatorContext) continuePty() bool {
 	return !vc.currentStackItem().stopped
 }
@@ -510,19 +607,26 @@ func (vc *ValidatorContext) Region() string {
 	return vc.i18nContext.Region()
 }
 
+// RenderTemplate implements I18nTemplateRenderer.RenderTemplate (and relays it to internal i18nContext)
+func (vc *ValidatorContext) RenderTemplate(template string, values map[string]interface{}) string {
+	return RenderMessageTemplate(vc.i18nContext, template, values)
+}
+
 type pathStackItem struct {
 	property   interface{}
 	path       string
+	format     PathFormat
 	value      interface{}
 	validator  interface{}
 	stopped    bool
 	conditions map[string]bool
 }
 
-func newRootPathStackItem(value interface{}, validator interface{}) *pathStackItem {
+func newRootPathStackItem(value interface{}, validator interface{}, format PathFormat) *pathStackItem {
 	return &pathStackItem{
 		property:   nil,
-		path:       "",
+		path:       format.rootPath(),
+		format:     format,
 		value:      value,
 		validator:  validator,
 		stopped:    false,
@@ -530,10 +634,18 @@ func newRootPathStackItem(value interface{}, validator interface{}) *pathStackIt
 	}
 }
 
+func rootPathFormat(rootValidator *Validator) PathFormat {
+	if rootValidator != nil {
+		return rootValidator.PathFormat
+	}
+	return PathFormatDotted
+}
+
 func (p *pathStackItem) clone(pty interface{}, value interface{}, validator interface{}) *pathStackItem {
 	result := &pathStackItem{
 		property:   pty,
 		path:       p.asPath(),
+		format:     p.format,
 		value:      value,
 		validator:  validator,
 		conditions: make(map[string]bool, len(p.conditions)),
@@ -546,15 +658,9 @@ func (p *pathStackItem) clone(pty interface{}, value interface{}, validator inte
 
 func (p *pathStackItem) asPath() string {
 	if p.property == nil {
-		return ""
-	}
-	if pty, ok := p.property.(string); ok {
-		if p.path != "" {
-			return p.path + "." + pty
-		}
-		return pty
+		return p.path
 	}
-	return p.path + p.propertyAsString()
+	return p.format.join(p.path, p.property)
 }
 
 func (p *pathStackItem) propertyAsString() string {
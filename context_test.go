@@ -728,3 +728,18 @@ func TestContextLock(t *testing.T) {
 	vcx.CeaseFurtherIf(true)
 	require.True(t, vcx.continuePty())
 }
+
+func TestValidatorContext_CurrentLabel(t *testing.T) {
+	const label = "Test label"
+	DefaultTranslator.AddTokenLanguageTranslation("fr", label, "Libellé de test")
+	defer delete(defaultInternalTranslator.Tokens, label)
+	vcx := newValidatorContext(map[string]interface{}{}, nil, false, newDefaultI18nContext("fr", ""))
+	require.Equal(t, "", vcx.CurrentLabel())
+	vcx.pushPathProperty("foo", "x", &PropertyValidator{})
+	require.Equal(t, "foo", vcx.CurrentLabel())
+	vcx.popPath()
+	vcx.pushPathProperty("foo", "x", &PropertyValidator{Label: label})
+	require.Equal(t, "Libellé de test", vcx.CurrentLabel())
+	vcx.pushPathIndex(1, "x", nil)
+	require.Equal(t, "[1]", vcx.CurrentLabel())
+}
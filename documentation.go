@@ -0,0 +1,345 @@
+package valix
+
+import (
+	"html"
+	"strconv"
+	"strings"
+)
+
+const (
+	docTokenProperty           = "Property"
+	docTokenType               = "Type"
+	docTokenMandatory          = "Mandatory"
+	docTokenNullable           = "Nullable"
+	docTokenConditions         = "Conditions"
+	docTokenDescription        = "Description"
+	docTokenConstraints        = "Constraints"
+	docTokenYes                = "Yes"
+	docTokenNo                 = "No"
+	docTokenWhen               = "when"
+	docTokenMandatoryWhen      = "mandatory when"
+	docTokenUnwantedWhen       = "unwanted when"
+	docTokenRequiredWith       = "required with"
+	docTokenUnwantedWith       = "unwanted with"
+	docTokenOnly               = "only"
+	docTokenOnlyWhen           = "only when"
+	docTokenEachItem           = "each item"
+	docTokenConditionalVariant = "Conditional variant"
+	docTokenObjectConstraints  = "Object constraints"
+	docTokenUnknownAllowed     = "Unknown properties are allowed"
+	docTokenDeprecated         = "deprecated"
+	docTokenExample            = "Example"
+)
+
+// ToMarkdown renders human-readable reference documentation (in Markdown) for the validator
+//
+// The documentation describes each property (type, mandatory, nullable, conditions, description) and gives a
+// plain-language description of every constraint - nested object validators and conditional variants are
+// rendered as further sections
+//
+// All text is localised using the supplied I18nContext (if nil, the default I18nContext is used)
+func (v *Validator) ToMarkdown(tcx I18nContext) string {
+	return newDocBuilder(tcx).build(v).markdown()
+}
+
+// ToHTML renders human-readable reference documentation (in HTML) for the validator
+//
+// The rendered HTML is a fragment (i.e. not a complete document) - see Validator.ToMarkdown for details of the content
+func (v *Validator) ToHTML(tcx I18nContext) string {
+	return newDocBuilder(tcx).build(v).html()
+}
+
+type docSection struct {
+	heading     string
+	level       int
+	description string
+	notes       []string
+	constraints []string
+	rows        []*docRow
+}
+
+type docRow struct {
+	property    string
+	typ         string
+	mandatory   string
+	nullable    string
+	conditions  []string
+	description []string
+	constraints []string
+}
+
+type docBuilder struct {
+	tcx      I18nContext
+	sections []*docSection
+}
+
+func newDocBuilder(tcx I18nContext) *docBuilder {
+	return &docBuilder{
+		tcx: obtainI18nContext(tcx),
+	}
+}
+
+func (b *docBuilder) build(v *Validator) *docBuilder {
+	if v == nil {
+		return b
+	}
+	root := &docSection{level: 1}
+	if v.OasInfo != nil {
+		root.heading = v.OasInfo.Title
+		root.description = v.OasInfo.Description
+	}
+	b.sections = append(b.sections, root)
+	b.validator(root, v, "")
+	return b
+}
+
+func (b *docBuilder) validator(sec *docSection, v *Validator, path string) {
+	if v.IgnoreUnknownProperties {
+		sec.notes = append(sec.notes, b.tcx.TranslateToken(docTokenUnknownAllowed))
+	}
+	sec.constraints = b.constraints(v.Constraints)
+	b.properties(sec, v, v.Properties, path)
+	b.variants(v, v.ConditionalVariants, path, nil)
+}
+
+func (b *docBuilder) properties(sec *docSection, v *Validator, properties Properties, path string) {
+	names, pvs := v.orderedProperties(properties)
+	quickSortOrdersAndNames(pvs, names)
+	nested := make([]int, 0)
+	for i, pv := range pvs {
+		sec.rows = append(sec.rows, b.property(names[i], pv))
+		if pv.ObjectValidator != nil {
+			nested = append(nested, i)
+		}
+	}
+	for _, i := range nested {
+		pv := pvs[i]
+		subPath := path + ternary(path == "").string("", ".") + names[i] + ternary(pv.Type == JsonArray).string("[]", "")
+		sub := &docSection{heading: subPath, level: 2}
+		if pv.ObjectValidator.OasInfo != nil {
+			sub.description = pv.ObjectValidator.OasInfo.Description
+		}
+		b.sections = append(b.sections, sub)
+		b.validator(sub, pv.ObjectValidator, subPath)
+	}
+}
+
+func (b *docBuilder) variants(v *Validator, cvs ConditionalVariants, path string, parentIndices []int) {
+	for i, cv := range cvs {
+		indices := append(append([]int{}, parentIndices...), i+1)
+		numbers := make([]string, len(indices))
+		for n, idx := range indices {
+			numbers[n] = strconv.Itoa(idx)
+		}
+		heading := b.tcx.TranslateToken(docTokenConditionalVariant) + " " + strings.Join(numbers, ".") +
+			" (" + b.tcx.TranslateToken(docTokenWhen) + ": " + strings.Join(cv.WhenConditions, ", ") + ")"
+		if path != "" {
+			heading = path + " - " + heading
+		}
+		sec := &docSection{heading: heading, level: 2}
+		sec.constraints = b.constraints(cv.Constraints)
+		b.sections = append(b.sections, sec)
+		b.properties(sec, v, cv.Properties, path)
+		b.variants(v, cv.ConditionalVariants, path, indices)
+	}
+}
+
+func (b *docBuilder) property(name string, pv *PropertyValidator) *docRow {
+	yes, no := b.tcx.TranslateToken(docTokenYes), b.tcx.TranslateToken(docTokenNo)
+	result := &docRow{
+		property:    name,
+		typ:         b.tcx.TranslateToken(pv.Type.String()),
+		mandatory:   ternary(pv.Mandatory).string(yes, no),
+		nullable:    ternary(pv.NotNull).string(no, yes),
+		constraints: b.constraints(pv.Constraints),
+	}
+	if len(pv.WhenConditions) > 0 {
+		result.conditions = append(result.conditions, b.conditions(docTokenWhen, pv.WhenConditions))
+	}
+	if pv.Mandatory && len(pv.MandatoryWhen) > 0 {
+		result.conditions = append(result.conditions, b.conditions(docTokenMandatoryWhen, pv.MandatoryWhen))
+	}
+	if len(pv.UnwantedConditions) > 0 {
+		result.conditions = append(result.conditions, b.conditions(docTokenUnwantedWhen, pv.UnwantedConditions))
+	}
+	if len(pv.RequiredWith) > 0 {
+		result.conditions = append(result.conditions, b.tcx.TranslateToken(docTokenRequiredWith)+": "+pv.RequiredWith.String())
+	}
+	if len(pv.UnwantedWith) > 0 {
+		result.conditions = append(result.conditions, b.tcx.TranslateToken(docTokenUnwantedWith)+": "+pv.UnwantedWith.String())
+	}
+	if pv.Only {
+		result.conditions = append(result.conditions, b.tcx.TranslateToken(docTokenOnly))
+	}
+	if len(pv.OnlyConditions) > 0 {
+		result.conditions = append(result.conditions, b.conditions(docTokenOnlyWhen, pv.OnlyConditions))
+	}
+	if pv.OasInfo != nil {
+		if pv.OasInfo.Description != "" {
+			result.description = append(result.description, pv.OasInfo.Description)
+		}
+		if pv.OasInfo.Deprecated {
+			result.description = append(result.description, "("+b.tcx.TranslateToken(docTokenDeprecated)+")")
+		}
+		if pv.OasInfo.Example != "" {
+			result.description = append(result.description, b.tcx.TranslateToken(docTokenExample)+": "+pv.OasInfo.Example)
+		}
+	}
+	return result
+}
+
+func (b *docBuilder) conditions(token string, conditions Conditions) string {
+	return b.tcx.TranslateToken(token) + ": " + strings.Join(conditions, ", ")
+}
+
+func (b *docBuilder) constraints(constraints Constraints) []string {
+	result := make([]string, 0, len(constraints))
+	for _, c := range constraints {
+		switch ct := c.(type) {
+		case *ConstraintSet:
+			if ct.Message != "" {
+				result = append(result, ct.GetMessage(b.tcx))
+			} else {
+				result = append(result, b.constraints(ct.Constraints)...)
+			}
+		case *ConditionalConstraint:
+			clauses := make([]string, 0, 2)
+			if len(ct.When) > 0 {
+				clauses = append(clauses, strings.Join(ct.When, ", "))
+			}
+			if len(ct.Others) > 0 {
+				clauses = append(clauses, ct.Others.String())
+			}
+			prefix := "(" + b.tcx.TranslateToken(docTokenWhen) + ": " + strings.Join(clauses, "; ") + ") "
+			for _, msg := range b.constraints(Constraints{ct.Constraint}) {
+				result = append(result, prefix+msg)
+			}
+		case *ArrayOf:
+			if msg := ct.GetMessage(b.tcx); msg != "" {
+				result = append(result, msg)
+			}
+			prefix := b.tcx.TranslateToken(docTokenEachItem) + ": "
+			for _, msg := range b.constraints(ct.Constraints) {
+				result = append(result, prefix+msg)
+			}
+		default:
+			if c != nil {
+				if msg := c.GetMessage(b.tcx); msg != "" {
+					result = append(result, msg)
+				}
+			}
+		}
+	}
+	return result
+}
+
+func (b *docBuilder) headings() []string {
+	return []string{
+		b.tcx.TranslateToken(docTokenProperty),
+		b.tcx.TranslateToken(docTokenType),
+		b.tcx.TranslateToken(docTokenMandatory),
+		b.tcx.TranslateToken(docTokenNullable),
+		b.tcx.TranslateToken(docTokenConditions),
+		b.tcx.TranslateToken(docTokenDescription),
+		b.tcx.TranslateToken(docTokenConstraints),
+	}
+}
+
+func (b *docBuilder) markdown() string {
+	var sb strings.Builder
+	for _, sec := range b.sections {
+		if sec.heading != "" {
+			sb.WriteString(strings.Repeat("#", sec.level) + " " + markdownEscape(sec.heading) + "\n\n")
+		}
+		if sec.description != "" {
+			sb.WriteString(markdownEscape(sec.description) + "\n\n")
+		}
+		for _, note := range sec.notes {
+			sb.WriteString("_" + markdownEscape(note) + "_\n\n")
+		}
+		if len(sec.constraints) > 0 {
+			sb.WriteString("**" + markdownEscape(b.tcx.TranslateToken(docTokenObjectConstraints)) + "**\n\n")
+			for _, c := range sec.constraints {
+				sb.WriteString("- " + markdownEscape(c) + "\n")
+			}
+			sb.WriteString("\n")
+		}
+		if len(sec.rows) > 0 {
+			headings := b.headings()
+			sb.WriteString("| " + strings.Join(headings, " | ") + " |\n")
+			sb.WriteString(strings.Repeat("| --- ", len(headings)) + "|\n")
+			for _, row := range sec.rows {
+				sb.WriteString("| `" + row.property + "` | " + markdownEscape(row.typ) +
+					" | " + row.mandatory + " | " + row.nullable +
+					" | " + markdownCell(row.conditions) +
+					" | " + markdownCell(row.description) +
+					" | " + markdownCell(row.constraints) + " |\n")
+			}
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+func markdownCell(lines []string) string {
+	escaped := make([]string, len(lines))
+	for i, line := range lines {
+		escaped[i] = markdownEscape(line)
+	}
+	return strings.Join(escaped, "<br>")
+}
+
+var markdownEscaper = strings.NewReplacer("\\", "\\\\", "|", "\\|", "*", "\\*", "_", "\\_", "`", "\\`", "<", "&lt;", ">", "&gt;", "\r\n", "<br>", "\n", "<br>")
+
+func markdownEscape(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+func (b *docBuilder) html() string {
+	var sb strings.Builder
+	for _, sec := range b.sections {
+		if sec.heading != "" {
+			tag := "h" + strconv.Itoa(sec.level)
+			sb.WriteString("<" + tag + ">" + html.EscapeString(sec.heading) + "</" + tag + ">\n")
+		}
+		if sec.description != "" {
+			sb.WriteString("<p>" + html.EscapeString(sec.description) + "</p>\n")
+		}
+		for _, note := range sec.notes {
+			sb.WriteString("<p><em>" + html.EscapeString(note) + "</em></p>\n")
+		}
+		if len(sec.constraints) > 0 {
+			sb.WriteString("<p><strong>" + html.EscapeString(b.tcx.TranslateToken(docTokenObjectConstraints)) + "</strong></p>\n<ul>\n")
+			for _, c := range sec.constraints {
+				sb.WriteString("<li>" + html.EscapeString(c) + "</li>\n")
+			}
+			sb.WriteString("</ul>\n")
+		}
+		if len(sec.rows) > 0 {
+			sb.WriteString("<table>\n<thead>\n<tr>")
+			for _, h := range b.headings() {
+				sb.WriteString("<th>" + html.EscapeString(h) + "</th>")
+			}
+			sb.WriteString("</tr>\n</thead>\n<tbody>\n")
+			for _, row := range sec.rows {
+				sb.WriteString("<tr><td><code>" + html.EscapeString(row.property) + "</code></td>" +
+					"<td>" + html.EscapeString(row.typ) + "</td>" +
+					"<td>" + html.EscapeString(row.mandatory) + "</td>" +
+					"<td>" + html.EscapeString(row.nullable) + "</td>" +
+					"<td>" + htmlCell(row.conditions) + "</td>" +
+					"<td>" + htmlCell(row.description) + "</td>" +
+					"<td>" + htmlCell(row.constraints) + "</td></tr>\n")
+			}
+			sb.WriteString("</tbody>\n</table>\n")
+		}
+	}
+	return sb.String()
+}
+
+func htmlCell(lines []string) string {
+	escaped := make([]string, len(lines))
+	for i, line := range lines {
+		escaped[i] = html.EscapeString(line)
+	}
+	return strings.Join(escaped, "<br>")
+}
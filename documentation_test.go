@@ -0,0 +1,135 @@
+package valix
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testDocumentationValidator = &Validator{
+	IgnoreUnknownProperties: true,
+	OasInfo:                 &OasInfo{Title: "Person", Description: "A person"},
+	Constraints:             Constraints{&Length{Minimum: 1}},
+	Properties: Properties{
+		"name": {
+			Type:        JsonString,
+			Mandatory:   true,
+			NotNull:     true,
+			Constraints: Constraints{&StringLength{Minimum: 2, Maximum: 10}},
+			OasInfo:     &OasInfo{Description: "The name | nickname", Example: "Bilbo", Deprecated: true},
+		},
+		"age": {
+			Type:           JsonInteger,
+			WhenConditions: Conditions{"adult"},
+			Constraints: Constraints{
+				&ConditionalConstraint{When: Conditions{"strict"}, Constraint: &Minimum{Value: 18}},
+				&SetConditionFrom{},
+			},
+		},
+		"tags": {
+			Type:         JsonArray,
+			RequiredWith: MustParseExpression("name && !age"),
+			Constraints: Constraints{
+				&ArrayOf{Type: "string", Constraints: Constraints{&StringMaxLength{Value: 5}}},
+			},
+		},
+		"address": {
+			Type: JsonObject,
+			ObjectValidator: &Validator{
+				Properties: Properties{
+					"postcode": {
+						Type:          JsonString,
+						Mandatory:     true,
+						MandatoryWhen: Conditions{"uk"},
+						UnwantedWith:  MustParseExpression("zip"),
+					},
+				},
+			},
+		},
+	},
+	ConditionalVariants: ConditionalVariants{
+		{
+			WhenConditions: Conditions{"business"},
+			Properties: Properties{
+				"company": {Type: JsonString, Mandatory: true, Only: true},
+			},
+		},
+	},
+}
+
+func TestValidator_ToMarkdown(t *testing.T) {
+	md := testDocumentationValidator.ToMarkdown(nil)
+	require.True(t, strings.HasPrefix(md, "# Person\n\nA person\n\n_Unknown properties are allowed_\n\n**Object constraints**\n\n- Value length must be at least 1 item\n\n"))
+	require.Contains(t, md, "| Property | Type | Mandatory | Nullable | Conditions | Description | Constraints |\n| --- | --- | --- | --- | --- | --- | --- |\n| `address` |")
+	require.Contains(t, md, "| `name` | string | Yes | No |  | The name \\| nickname<br>(deprecated)<br>Example: Bilbo | String value length must be between 2 (inclusive) and 10 characters (inclusive) |\n")
+	require.Contains(t, md, "| `age` | integer | No | Yes | when: adult |  | (when: strict) Value must be greater than or equal to 18 |\n")
+	require.Contains(t, md, "| `tags` | array | No | Yes | required with: name && !age |  | Array elements must be of type string<br>each item: String value length must not exceed 5 characters |\n")
+	require.Contains(t, md, "## address\n\n")
+	require.Contains(t, md, "| `postcode` | string | Yes | Yes | mandatory when: uk<br>unwanted with: zip |  |  |\n")
+	require.Contains(t, md, "## Conditional variant 1 (when: business)\n\n")
+	require.Contains(t, md, "| `company` | string | Yes | Yes | only |  |  |\n")
+
+	// properties are listed in name order...
+	require.True(t, strings.Index(md, "`address`") < strings.Index(md, "`age`"))
+	require.True(t, strings.Index(md, "`age`") < strings.Index(md, "`name`"))
+	require.True(t, strings.Index(md, "`name`") < strings.Index(md, "`tags`"))
+}
+
+func TestValidator_ToMarkdownLocalised(t *testing.T) {
+	md := testDocumentationValidator.ToMarkdown(newDefaultI18nContext("fr", ""))
+	require.Contains(t, md, "| Propriété | Type | Obligatoire |")
+	require.Contains(t, md, "_Les propriétés inconnues sont autorisées_")
+	require.NotContains(t, md, "Unknown properties are allowed")
+}
+
+func TestValidator_ToHTML(t *testing.T) {
+	h := testDocumentationValidator.ToHTML(nil)
+	require.True(t, strings.HasPrefix(h, "<h1>Person</h1>\n<p>A person</p>\n<p><em>Unknown properties are allowed</em></p>\n"))
+	require.Contains(t, h, "<tr><th>Property</th><th>Type</th><th>Mandatory</th><th>Nullable</th><th>Conditions</th><th>Description</th><th>Constraints</th></tr>")
+	require.Contains(t, h, "<tr><td><code>tags</code></td><td>array</td><td>No</td><td>Yes</td><td>required with: name &amp;&amp; !age</td><td></td><td>Array elements must be of type string<br>each item: String value length must not exceed 5 characters</td></tr>")
+	require.Contains(t, h, "<h2>address</h2>\n")
+	require.Contains(t, h, "<h2>Conditional variant 1 (when: business)</h2>\n")
+}
+
+func TestValidator_ToMarkdownNestedPaths(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"items": {
+				Type: JsonArray,
+				ObjectValidator: &Validator{
+					AllowArray:     true,
+					DisallowObject: true,
+					Properties: Properties{
+						"detail": {
+							Type: JsonObject,
+							ObjectValidator: &Validator{
+								Properties: Properties{"foo": {Type: JsonString}},
+								ConditionalVariants: ConditionalVariants{
+									{
+										WhenConditions: Conditions{"a"},
+										ConditionalVariants: ConditionalVariants{
+											{WhenConditions: Conditions{"b"}, Properties: Properties{"bar": {Type: JsonString}}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	md := v.ToMarkdown(nil)
+	require.Contains(t, md, "## items[]\n\n")
+	require.Contains(t, md, "## items[].detail\n\n")
+	require.Contains(t, md, "## items[].detail - Conditional variant 1 (when: a)\n\n")
+	require.Contains(t, md, "## items[].detail - Conditional variant 1.1 (when: b)\n\n")
+	require.True(t, strings.HasPrefix(md, "| Property |"))
+}
+
+func TestValidator_ToMarkdownNil(t *testing.T) {
+	var v *Validator
+	require.Equal(t, "", v.ToMarkdown(nil))
+	require.Equal(t, "", v.ToHTML(nil))
+}
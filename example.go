@@ -0,0 +1,751 @@
+package valix
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	errMsgExampleNilValidator = "cannot generate example for nil validator"
+	errMsgExampleInvalid      = "unable to generate valid example - path '%s', property '%s': %s"
+	maxExampleAttempts        = 10
+	defaultExampleStringLen   = 8
+	defaultExampleNumberRange = 100
+	exampleCheckChars         = "0123456789X"
+	exampleUlidChars          = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+// GenerateExample synthesises an example payload (object or array) that satisfies the supplied validator
+//
+// Generation is deterministic for a given seed (with the exception of datetimes constrained to be in the future or past,
+// which are generated relative to the current time).
+//
+// Where a validator or property has an OasInfo.Example, that example is used in preference to a generated value.
+//
+// Mandatory properties are always generated - other properties are generated unless they have unwanted conditions,
+// unwanted with expressions, are marked as only or are read-only.
+//
+// The generated example is validated by the validator and an error is returned if a valid example could not be
+// generated (e.g. where the validator uses custom constraints or patterns that cannot be synthesised)
+func GenerateExample(v *Validator, seed int64) (interface{}, error) {
+	if v == nil {
+		return nil, errors.New(errMsgExampleNilValidator)
+	}
+	g := &exampleGenerator{rnd: rand.New(rand.NewSource(seed))}
+	var violations []*Violation
+	for attempt := 0; attempt < maxExampleAttempts; attempt++ {
+		example := g.validator(v)
+		data, err := json.Marshal(example)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		if ok, violations, _ = v.ValidateReader(bytes.NewReader(data)); ok {
+			return example, nil
+		}
+	}
+	return nil, fmt.Errorf(errMsgExampleInvalid, violations[0].Path, violations[0].Property, violations[0].Message)
+}
+
+type exampleGenerator struct {
+	rnd *rand.Rand
+}
+
+func (g *exampleGenerator) validator(v *Validator) interface{} {
+	if v.OasInfo != nil && v.OasInfo.Example != "" {
+		var example interface{}
+		if err := json.Unmarshal([]byte(v.OasInfo.Example), &example); err == nil {
+			return example
+		}
+	}
+	if v.AllowArray && v.DisallowObject {
+		return []interface{}{g.object(v)}
+	}
+	return g.object(v)
+}
+
+func (g *exampleGenerator) object(v *Validator) map[string]interface{} {
+	result := map[string]interface{}{}
+	names := make([]string, 0, len(v.Properties))
+	for name := range v.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if pv := v.Properties[name]; pv != nil && exampleWantsProperty(pv) {
+			result[name] = g.property(pv)
+		}
+	}
+	return result
+}
+
+func exampleWantsProperty(pv *PropertyValidator) bool {
+	if pv.OasInfo != nil && pv.OasInfo.ReadOnly {
+		return false
+	}
+	if pv.Mandatory {
+		return true
+	}
+	return len(pv.UnwantedConditions) == 0 && len(pv.UnwantedWith) == 0 && !pv.Only && len(pv.OnlyConditions) == 0
+}
+
+func (g *exampleGenerator) property(pv *PropertyValidator) interface{} {
+	if example, ok := pv.exampleValue(); ok {
+		return example
+	}
+	if pv.OasInfo != nil && len(pv.OasInfo.Enum) > 0 && (pv.Type == JsonString || pv.Type == JsonAny) {
+		return pv.OasInfo.Enum[g.rnd.Intn(len(pv.OasInfo.Enum))]
+	}
+	switch pv.Type {
+	case JsonObject:
+		if pv.ObjectValidator != nil {
+			return g.object(pv.ObjectValidator)
+		}
+		return map[string]interface{}{}
+	case JsonArray:
+		return g.array(pv)
+	case JsonBoolean:
+		return g.rnd.Intn(2) == 1
+	case JsonNumber:
+		return g.number(pv.Constraints, false)
+	case JsonInteger:
+		return g.number(pv.Constraints, true)
+	case JsonDatetime:
+		spec := newExampleStringSpec(pv.Constraints)
+		spec.kind = exampleKindDatetime
+		return g.string(spec)
+	case JsonAny:
+		if pv.ObjectValidator != nil {
+			return g.validator(pv.ObjectValidator)
+		}
+	}
+	return g.string(newExampleStringSpec(pv.Constraints))
+}
+
+func (pv *PropertyValidator) exampleValue() (interface{}, bool) {
+	if pv.OasInfo == nil || pv.OasInfo.Example == "" {
+		return nil, false
+	}
+	example := pv.OasInfo.Example
+	switch pv.Type {
+	case JsonString, JsonDatetime:
+		return example, true
+	case JsonBoolean:
+		if b, err := strconv.ParseBool(example); err == nil {
+			return b, true
+		}
+	case JsonNumber, JsonInteger:
+		if f, err := strconv.ParseFloat(example, 64); err == nil {
+			if pv.Type == JsonInteger && math.Trunc(f) == f {
+				return int(f), true
+			}
+			return f, true
+		}
+	default:
+		var value interface{}
+		if err := json.Unmarshal([]byte(example), &value); err == nil {
+			return value, true
+		} else if pv.Type == JsonAny {
+			return example, true
+		}
+	}
+	return nil, false
+}
+
+func (g *exampleGenerator) array(pv *PropertyValidator) []interface{} {
+	minLen, maxLen := 0, -1
+	var itemPv *PropertyValidator
+	unique := false
+	for _, c := range flattenExampleConstraints(pv.Constraints) {
+		switch ct := c.(type) {
+		case *Length:
+			minLen = ct.Minimum + ternary(ct.ExclusiveMin).int(1, 0)
+			if ct.Maximum > 0 {
+				maxLen = ct.Maximum - ternary(ct.ExclusiveMax).int(1, 0)
+			}
+		case *LengthExact:
+			minLen, maxLen = ct.Value, ct.Value
+		case *NotEmpty:
+			minLen = 1
+		case *ArrayUnique:
+			unique = true
+		case *ArrayOf:
+			if jt, ok := JsonTypeFromString(ct.Type); ok {
+				itemPv = &PropertyValidator{Type: jt, NotNull: true, Constraints: ct.Constraints}
+			}
+		}
+	}
+	if itemPv == nil {
+		itemPv = &PropertyValidator{Type: JsonString, NotNull: true}
+		if pv.ObjectValidator != nil {
+			itemPv = &PropertyValidator{Type: JsonObject, NotNull: true, ObjectValidator: pv.ObjectValidator}
+		}
+	}
+	count := minLen
+	if count < 1 {
+		count = 1
+	}
+	if maxLen >= 0 && count > maxLen {
+		count = maxLen
+	}
+	result := make([]interface{}, 0, count)
+	seen := map[string]bool{}
+	for tries := 0; len(result) < count && tries < count*maxExampleAttempts; tries++ {
+		item := g.property(itemPv)
+		if unique {
+			key := fmt.Sprintf("%v", item)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func (g *exampleGenerator) number(constraints Constraints, isInt bool) interface{} {
+	var lo, hi float64
+	hasLo, hasHi, excLo, excHi := false, false, false, false
+	var multipleOf int64
+	setLo := func(v float64, exc bool) {
+		if !hasLo || v > lo || (v == lo && exc) {
+			lo, hasLo, excLo = v, true, exc
+		}
+	}
+	setHi := func(v float64, exc bool) {
+		if !hasHi || v < hi || (v == hi && exc) {
+			hi, hasHi, excHi = v, true, exc
+		}
+	}
+	for _, c := range flattenExampleConstraints(constraints) {
+		switch ct := c.(type) {
+		case *Range:
+			setLo(ct.Minimum, ct.ExclusiveMin)
+			setHi(ct.Maximum, ct.ExclusiveMax)
+		case *RangeInt:
+			setLo(float64(ct.Minimum), ct.ExclusiveMin)
+			setHi(float64(ct.Maximum), ct.ExclusiveMax)
+		case *Minimum:
+			setLo(ct.Value, ct.ExclusiveMin)
+		case *MinimumInt:
+			setLo(float64(ct.Value), ct.ExclusiveMin)
+		case *Maximum:
+			setHi(ct.Value, ct.ExclusiveMax)
+		case *MaximumInt:
+			setHi(float64(ct.Value), ct.ExclusiveMax)
+		case *Positive:
+			setLo(0, true)
+		case *PositiveOrZero:
+			setLo(0, false)
+		case *Negative:
+			setHi(0, true)
+		case *NegativeOrZero:
+			setHi(0, false)
+		case *MultipleOf:
+			multipleOf = ct.Value
+		}
+	}
+	if !hasLo {
+		lo = 0
+		if hasHi && hi <= 0 {
+			lo = hi - defaultExampleNumberRange
+		}
+	}
+	if !hasHi {
+		hi = lo + defaultExampleNumberRange
+	}
+	if isInt || multipleOf > 0 {
+		iLo, iHi := int64(math.Ceil(lo)), int64(math.Floor(hi))
+		if excLo && float64(iLo) == lo {
+			iLo++
+		}
+		if excHi && float64(iHi) == hi {
+			iHi--
+		}
+		var result int64
+		if multipleOf > 0 {
+			first := int64(math.Ceil(float64(iLo)/float64(multipleOf))) * multipleOf
+			result = first
+			if count := (iHi-first)/multipleOf + 1; count > 1 {
+				result = first + g.rnd.Int63n(count)*multipleOf
+			}
+		} else {
+			result = iLo
+			if iHi > iLo {
+				result = iLo + g.rnd.Int63n(iHi-iLo+1)
+			}
+		}
+		if isInt {
+			return int(result)
+		}
+		return float64(result)
+	}
+	result := math.Round((lo+(0.1+0.8*g.rnd.Float64())*(hi-lo))*100) / 100
+	if result < lo || result > hi || (excLo && result == lo) || (excHi && result == hi) {
+		result = (lo + hi) / 2
+	}
+	return result
+}
+
+type exampleStringKind int
+
+const (
+	exampleKindNone exampleStringKind = iota
+	exampleKindDatetime
+	exampleKindDate
+	exampleKindUuid
+	exampleKindCountry
+	exampleKindCountryNumeric
+	exampleKindCurrency
+	exampleKindCurrencyNumeric
+	exampleKindLanguage
+	exampleKindCard
+	exampleKindDuration
+	exampleKindTimezone
+	exampleKindEmail
+	exampleKindURL
+	exampleKindHostname
+	exampleKindIPv4
+	exampleKindIPv6
+	exampleKindMac
+	exampleKindCIDR
+	exampleKindTld
+	exampleKindJson
+)
+
+type exampleStringSpec struct {
+	kind        exampleStringKind
+	minLen      int
+	maxLen      int
+	tokens      []string
+	preset      string
+	prefix      string
+	suffix      string
+	contains    string
+	upper       bool
+	uuidVersion uint8
+	noOffset    bool
+	dtMin       *time.Time
+	dtMax       *time.Time
+}
+
+func newExampleStringSpec(constraints Constraints) *exampleStringSpec {
+	spec := &exampleStringSpec{maxLen: -1}
+	setMin := func(v int) {
+		if v > spec.minLen {
+			spec.minLen = v
+		}
+	}
+	setMax := func(v int) {
+		if spec.maxLen == -1 || v < spec.maxLen {
+			spec.maxLen = v
+		}
+	}
+	for _, c := range flattenExampleConstraints(constraints) {
+		switch ct := c.(type) {
+		case *Length:
+			setMin(ct.Minimum + ternary(ct.ExclusiveMin).int(1, 0))
+			if ct.Maximum > 0 {
+				setMax(ct.Maximum - ternary(ct.ExclusiveMax).int(1, 0))
+			}
+		case *LengthExact:
+			setMin(ct.Value)
+			setMax(ct.Value)
+		case *StringLength:
+			setMin(ct.Minimum + ternary(ct.ExclusiveMin).int(1, 0))
+			if ct.Maximum > 0 {
+				setMax(ct.Maximum - ternary(ct.ExclusiveMax).int(1, 0))
+			}
+		case *StringMinLength:
+			setMin(ct.Value + ternary(ct.ExclusiveMin).int(1, 0))
+		case *StringMaxLength:
+			setMax(ct.Value - ternary(ct.ExclusiveMax).int(1, 0))
+		case *StringExactLength:
+			setMin(ct.Value)
+			setMax(ct.Value)
+		case *NotEmpty, *StringNotEmpty, *StringNotBlank:
+			setMin(1)
+		case *StringValidToken:
+			spec.tokens = ct.Tokens
+		case *StringPresetPattern:
+			spec.preset = ct.Preset
+		case *StringStartsWith:
+			if !ct.Not {
+				spec.prefix = firstExampleValue(ct.Value, ct.Values)
+			}
+		case *StringEndsWith:
+			if !ct.Not {
+				spec.suffix = firstExampleValue(ct.Value, ct.Values)
+			}
+		case *StringContains:
+			if !ct.Not {
+				spec.contains = firstExampleValue(ct.Value, ct.Values)
+			}
+		case *StringUppercase:
+			spec.upper = true
+		case *StringValidISODatetime:
+			spec.kind = exampleKindDatetime
+			spec.noOffset = ct.NoOffset
+		case *StringValidISODate:
+			spec.kind = exampleKindDate
+		case *StringValidUuid:
+			spec.kind = exampleKindUuid
+			spec.uuidVersion = ct.SpecificVersion
+			if spec.uuidVersion == 0 && ct.MinVersion > 4 {
+				spec.uuidVersion = ct.MinVersion
+			}
+		case *StringValidCountryCode:
+			spec.kind = exampleKindCountry
+			if ct.NumericOnly {
+				spec.kind = exampleKindCountryNumeric
+			}
+		case *StringValidCurrencyCode:
+			spec.kind = exampleKindCurrency
+			if ct.NumericOnly {
+				spec.kind = exampleKindCurrencyNumeric
+			}
+		case *StringValidLanguageCode:
+			spec.kind = exampleKindLanguage
+		case *StringValidCardNumber:
+			spec.kind = exampleKindCard
+		case *StringValidISODuration:
+			spec.kind = exampleKindDuration
+		case *StringValidTimezone:
+			spec.kind = exampleKindTimezone
+		case *StringValidEmail:
+			spec.kind = exampleKindEmail
+		case *NetIsURL, *NetIsURI:
+			spec.kind = exampleKindURL
+		case *NetIsHostname:
+			spec.kind = exampleKindHostname
+		case *NetIsIP:
+			spec.kind = exampleKindIPv4
+			if ct.V6Only {
+				spec.kind = exampleKindIPv6
+			}
+		case *NetIsMac:
+			spec.kind = exampleKindMac
+		case *NetIsCIDR:
+			spec.kind = exampleKindCIDR
+		case *NetIsTld:
+			spec.kind = exampleKindTld
+		case *StringValidJson:
+			spec.kind = exampleKindJson
+		case *DatetimeRange:
+			spec.setDatetimeMin(ct.Minimum, ct.ExclusiveMin)
+			spec.setDatetimeMax(ct.Maximum, ct.ExclusiveMax)
+		case *DatetimeGreaterThan:
+			spec.setDatetimeMin(ct.Value, true)
+		case *DatetimeGreaterThanOrEqual:
+			spec.setDatetimeMin(ct.Value, false)
+		case *DatetimeLessThan:
+			spec.setDatetimeMax(ct.Value, true)
+		case *DatetimeLessThanOrEqual:
+			spec.setDatetimeMax(ct.Value, false)
+		case *DatetimeFuture, *DatetimeFutureOrPresent:
+			now := time.Now().Add(24 * time.Hour)
+			spec.dtMin = &now
+		case *DatetimePast, *DatetimePastOrPresent:
+			now := time.Now().Add(-24 * time.Hour)
+			spec.dtMax = &now
+		}
+	}
+	if spec.kind == exampleKindNone && (spec.dtMin != nil || spec.dtMax != nil) {
+		spec.kind = exampleKindDatetime
+	}
+	return spec
+}
+
+func (spec *exampleStringSpec) setDatetimeMin(value string, exclusive bool) {
+	if dt, ok := stringToDatetime(value, false); ok {
+		t := *dt
+		if exclusive {
+			t = t.Add(24 * time.Hour)
+		}
+		spec.dtMin = &t
+	}
+}
+
+func (spec *exampleStringSpec) setDatetimeMax(value string, exclusive bool) {
+	if dt, ok := stringToDatetime(value, false); ok {
+		t := *dt
+		if exclusive {
+			t = t.Add(-24 * time.Hour)
+		}
+		spec.dtMax = &t
+	}
+}
+
+func firstExampleValue(value string, values []string) string {
+	if value == "" && len(values) > 0 {
+		return values[0]
+	}
+	return value
+}
+
+func flattenExampleConstraints(constraints Constraints) Constraints {
+	result := make(Constraints, 0, len(constraints))
+	for _, c := range constraints {
+		if cs, ok := c.(*ConstraintSet); ok {
+			if !cs.OneOf {
+				result = append(result, flattenExampleConstraints(cs.Constraints)...)
+			} else if len(cs.Constraints) > 0 {
+				result = append(result, flattenExampleConstraints(cs.Constraints[:1])...)
+			}
+		} else if w, ok := c.(*WarningOnly); ok {
+			result = append(result, flattenExampleConstraints(Constraints{w.Constraint})...)
+		} else if c != nil {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func (g *exampleGenerator) string(spec *exampleStringSpec) string {
+	if len(spec.tokens) > 0 {
+		return spec.tokens[g.rnd.Intn(len(spec.tokens))]
+	}
+	if spec.preset != "" {
+		if str, ok := g.preset(spec.preset); ok {
+			return str
+		}
+	}
+	switch spec.kind {
+	case exampleKindDatetime:
+		if spec.noOffset {
+			return g.datetime(spec).Format("2006-01-02T15:04:05")
+		}
+		return g.datetime(spec).Format("2006-01-02T15:04:05Z07:00")
+	case exampleKindDate:
+		return g.datetime(spec).Format("2006-01-02")
+	case exampleKindUuid:
+		version := spec.uuidVersion
+		if version == 0 {
+			version = 4
+		}
+		return g.template("hhhhhhhh-hhhh-" + strconv.Itoa(int(version)) + "hhh-8hhh-hhhhhhhhhhhh")
+	case exampleKindCountry:
+		return g.pick(assignedCountryCodes())
+	case exampleKindCountryNumeric:
+		return g.pick(sortedCodes(iSO3166_1_NumericCodes))
+	case exampleKindCurrency:
+		return g.pick(sortedCodes(iSO4217CurrencyCodes))
+	case exampleKindCurrencyNumeric:
+		return g.pick(sortedCodes(iSO4217CurrencyCodesNumeric))
+	case exampleKindLanguage:
+		return g.pick([]string{"en", "en-GB", "en-US", "fr", "de", "es", "it"})
+	case exampleKindCard:
+		if str, ok := g.preset(PresetCard); ok {
+			return str
+		}
+	case exampleKindDuration:
+		return fmt.Sprintf("P%dDT%dH", g.rnd.Intn(30)+1, g.rnd.Intn(24))
+	case exampleKindTimezone:
+		return fmt.Sprintf("+%02d:00", g.rnd.Intn(12))
+	case exampleKindEmail:
+		return g.template("aaaaaa") + "@example.com"
+	case exampleKindURL:
+		return "https://example.com/" + g.template("aaaaaa")
+	case exampleKindHostname:
+		return g.template("aaaaaa") + ".example.com"
+	case exampleKindIPv4:
+		return fmt.Sprintf("203.0.113.%d", g.rnd.Intn(254)+1)
+	case exampleKindIPv6:
+		return fmt.Sprintf("2001:db8::%x", g.rnd.Intn(0xfffe)+1)
+	case exampleKindMac:
+		return g.template("hh:hh:hh:hh:hh:hh")
+	case exampleKindCIDR:
+		return fmt.Sprintf("10.%d.0.0/16", g.rnd.Intn(256))
+	case exampleKindTld:
+		return g.pick([]string{"com", "net", "org", "uk", "fr", "de"})
+	case exampleKindJson:
+		return "{}"
+	}
+	return g.text(spec)
+}
+
+func (g *exampleGenerator) text(spec *exampleStringSpec) string {
+	fixed := len(spec.prefix) + len(spec.contains) + len(spec.suffix)
+	length := defaultExampleStringLen
+	if length < spec.minLen {
+		length = spec.minLen
+	}
+	if spec.maxLen >= 0 && length > spec.maxLen {
+		length = spec.maxLen
+	}
+	fill := length - fixed
+	if fill < 0 {
+		fill = 0
+	}
+	filler := g.template(strings.Repeat("a", fill))
+	if spec.upper {
+		filler = strings.ToUpper(filler)
+	}
+	half := fill / 2
+	return spec.prefix + filler[:half] + spec.contains + filler[half:] + spec.suffix
+}
+
+func (g *exampleGenerator) datetime(spec *exampleStringSpec) time.Time {
+	const day = 24 * time.Hour
+	lo := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	switch {
+	case spec.dtMin != nil && spec.dtMax != nil:
+		lo = *spec.dtMin
+		if span := spec.dtMax.Sub(lo); span > 0 {
+			return lo.Add(time.Duration(g.rnd.Int63n(int64(span/time.Second)+1)) * time.Second).Truncate(time.Second)
+		}
+		return lo
+	case spec.dtMin != nil:
+		lo = *spec.dtMin
+	case spec.dtMax != nil:
+		lo = spec.dtMax.Add(-366 * day)
+	}
+	return lo.Add(time.Duration(g.rnd.Intn(365)+1) * day).Add(time.Duration(g.rnd.Intn(86400)) * time.Second).Truncate(time.Second)
+}
+
+func (g *exampleGenerator) pick(from []string) string {
+	return from[g.rnd.Intn(len(from))]
+}
+
+// template fills a template string where...
+//
+// 'D' is replaced by a random digit, 'h'/'H' by a random lower/upper case hex digit,
+// 'a'/'A' by a random lower/upper case letter, 'U' by a random ULID character and '?' by a check character
+// (the check character is left as '?' to be resolved by the caller)
+func (g *exampleGenerator) template(tmp string) string {
+	var sb strings.Builder
+	for _, ch := range tmp {
+		switch ch {
+		case 'D':
+			sb.WriteByte(byte('0' + g.rnd.Intn(10)))
+		case 'h':
+			sb.WriteByte("0123456789abcdef"[g.rnd.Intn(16)])
+		case 'H':
+			sb.WriteByte("0123456789ABCDEF"[g.rnd.Intn(16)])
+		case 'a':
+			sb.WriteByte(byte('a' + g.rnd.Intn(26)))
+		case 'A':
+			sb.WriteByte(byte('A' + g.rnd.Intn(26)))
+		case 'U':
+			sb.WriteByte(exampleUlidChars[g.rnd.Intn(len(exampleUlidChars))])
+		default:
+			sb.WriteRune(ch)
+		}
+	}
+	return sb.String()
+}
+
+func (g *exampleGenerator) preset(token string) (string, bool) {
+	preset, ok := GetRegisteredPreset(token)
+	if !ok {
+		return "", false
+	}
+	if literal, ok := presetExampleLiterals[token]; ok && preset.Check(literal) {
+		return literal, true
+	}
+	tmp, ok := presetExampleTemplates[token]
+	if !ok {
+		return "", false
+	}
+	for attempt := 0; attempt < maxExampleAttempts; attempt++ {
+		str := g.template(tmp)
+		if at := strings.IndexByte(str, '?'); at != -1 {
+			for _, ck := range exampleCheckChars {
+				candidate := str[:at] + string(ck) + str[at+1:]
+				if preset.Check(candidate) {
+					return candidate, true
+				}
+			}
+		} else if preset.Check(str) {
+			return str, true
+		}
+	}
+	return "", false
+}
+
+var presetExampleLiterals = map[string]string{
+	PresetBase64:    "dmFsaXg=",
+	PresetBase64URL: "dmFsaXg=",
+	PresetCMYK:      "cmyk(0.1, 0.2, 0.3, 0.4)",
+	PresetCMYK300:   "cmyk(0.1, 0.2, 0.3, 0.4)",
+	PresetHsl:       "hsl(120, 50%, 50%)",
+	PresetHsla:      "hsla(120, 50%, 50%, 0.5)",
+	PresetRgb:       "rgb(255, 128, 0)",
+	PresetRgba:      "rgba(255, 128, 0, 0.5)",
+	PresetRgbIcc:    "rgb-icc(255, 128, 0, #CMYK, 0.1, 0.2, 0.3, 0.4)",
+}
+
+var presetExampleTemplates = map[string]string{
+	PresetAlpha:        "aaaaaaaa",
+	PresetAlphaNumeric: "aaaaDDDD",
+	PresetBarcode:      "DDDDDDDDDDDD?",
+	PresetCard:         "4DDDDDDDDDDDDDD?",
+	PresetDUN14:        "DDDDDDDDDDDDD?",
+	PresetE164:         "+44DDDDDDDDDD",
+	PresetEAN:          "DDDDDDDDDDDD?",
+	PresetEAN8:         "DDDDDDD?",
+	PresetEAN13:        "DDDDDDDDDDDD?",
+	PresetEAN14:        "DDDDDDDDDDDDD?",
+	PresetEAN18:        "DDDDDDDDDDDDDDDDD?",
+	PresetEAN99:        "99DDDDDDDDDD?",
+	PresetHexadecimal:  "hhhhhhhh",
+	PresetHtmlColor:    "#hhhhhh",
+	PresetInteger:      "DDDD",
+	PresetISBN:         "978DDDDDDDDD?",
+	PresetISBN10:       "DDDDDDDDD?",
+	PresetISBN13:       "978DDDDDDDDD?",
+	PresetISSN:         "DDDDDDD?",
+	PresetISSN8:        "DDDDDDD?",
+	PresetISSN13:       "977DDDDDDDDD?",
+	PresetNumeric:      "DDD.DD",
+	PresetNumericE:     "DDD.DD",
+	PresetNumericX:     "DDD.DD",
+	PresetPublication:  "978DDDDDDDDD?",
+	PresetULID:         "0UUUUUUUUUUUUUUUUUUUUUUUUU",
+	PresetUPC:          "DDDDDDDDDDD?",
+	PresetUPCA:         "DDDDDDDDDDD?",
+	PresetUPCE:         "0DDDDDD?",
+	PresetUuid:         "hhhhhhhh-hhhh-4hhh-8hhh-hhhhhhhhhhhh",
+	PresetUUID:         "HHHHHHHH-HHHH-4HHH-8HHH-HHHHHHHHHHHH",
+	PresetUuid1:        "hhhhhhhh-hhhh-1hhh-8hhh-hhhhhhhhhhhh",
+	PresetUUID1:        "HHHHHHHH-HHHH-1HHH-8HHH-HHHHHHHHHHHH",
+	PresetUuid2:        "hhhhhhhh-hhhh-2hhh-8hhh-hhhhhhhhhhhh",
+	PresetUUID2:        "HHHHHHHH-HHHH-2HHH-8HHH-HHHHHHHHHHHH",
+	PresetUuid3:        "hhhhhhhh-hhhh-3hhh-8hhh-hhhhhhhhhhhh",
+	PresetUUID3:        "HHHHHHHH-HHHH-3HHH-8HHH-HHHHHHHHHHHH",
+	PresetUuid4:        "hhhhhhhh-hhhh-4hhh-8hhh-hhhhhhhhhhhh",
+	PresetUUID4:        "HHHHHHHH-HHHH-4HHH-8HHH-HHHHHHHHHHHH",
+	PresetUuid5:        "hhhhhhhh-hhhh-5hhh-8hhh-hhhhhhhhhhhh",
+	PresetUUID5:        "HHHHHHHH-HHHH-5HHH-8HHH-HHHHHHHHHHHH",
+}
+
+func assignedCountryCodes() []string {
+	result := make([]string, 0, 256)
+	for first, seconds := range iso3166_1_CountryCodesMatrix {
+		for second, assignment := range seconds {
+			if assignment == ccAs {
+				result = append(result, string([]byte{first, second}))
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func sortedCodes(codes map[string]bool) []string {
+	result := make([]string, 0, len(codes))
+	for code := range codes {
+		result = append(result, code)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,266 @@
+package valix
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateExample(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"name": {
+				Type:        JsonString,
+				Mandatory:   true,
+				NotNull:     true,
+				Constraints: Constraints{&StringLength{Minimum: 10, Maximum: 12}, &StringUppercase{}},
+			},
+			"code": {
+				Type:        JsonString,
+				Constraints: Constraints{&StringStartsWith{Value: "ABC-"}, &StringEndsWith{Value: "-XYZ"}, &StringMaxLength{Value: 10}},
+			},
+			"status": {
+				Type:        JsonString,
+				Constraints: Constraints{&StringValidToken{Tokens: []string{"active", "inactive"}}},
+			},
+			"age": {
+				Type:        JsonInteger,
+				Constraints: Constraints{&RangeInt{Minimum: 18, Maximum: 21}},
+			},
+			"score": {
+				Type:        JsonNumber,
+				Constraints: Constraints{&Positive{}, &Maximum{Value: 1, ExclusiveMax: true}},
+			},
+			"quantity": {
+				Type:        JsonInteger,
+				Constraints: Constraints{&MultipleOf{Value: 5}, &Minimum{Value: 12}},
+			},
+			"active": {
+				Type: JsonBoolean,
+			},
+			"country": {
+				Type:        JsonString,
+				Constraints: Constraints{&StringValidCountryCode{}},
+			},
+			"currency": {
+				Type:        JsonString,
+				Constraints: Constraints{&StringValidCurrencyCode{}},
+			},
+			"id": {
+				Type:        JsonString,
+				Constraints: Constraints{&StringValidUuid{SpecificVersion: 4}},
+			},
+			"card": {
+				Type:        JsonString,
+				Constraints: Constraints{&StringValidCardNumber{}},
+			},
+			"when": {
+				Type:        JsonString,
+				Constraints: Constraints{&StringValidISODatetime{}, &DatetimeRange{Minimum: "2022-01-01T00:00:00Z", Maximum: "2022-01-31T00:00:00Z"}},
+			},
+			"tags": {
+				Type:        JsonArray,
+				Constraints: Constraints{&Length{Minimum: 3, Maximum: 5}, &ArrayUnique{}, &ArrayOf{Type: "string", Constraints: Constraints{&StringValidToken{Tokens: []string{"a", "b", "c", "d"}}}}},
+			},
+			"address": {
+				Type: JsonObject,
+				ObjectValidator: &Validator{
+					Properties: Properties{
+						"postcode": {
+							Type:      JsonString,
+							Mandatory: true,
+							OasInfo:   &OasInfo{Example: "SW1A 1AA"},
+						},
+					},
+				},
+			},
+		},
+	}
+	example, err := GenerateExample(v, 1)
+	require.NoError(t, err)
+	obj := example.(map[string]interface{})
+	require.Equal(t, len(v.Properties), len(obj))
+
+	name := obj["name"].(string)
+	require.True(t, len(name) >= 10 && len(name) <= 12)
+	require.Equal(t, strings.ToUpper(name), name)
+	code := obj["code"].(string)
+	require.True(t, strings.HasPrefix(code, "ABC-"))
+	require.True(t, strings.HasSuffix(code, "-XYZ"))
+	require.Contains(t, []string{"active", "inactive"}, obj["status"])
+	age := obj["age"].(int)
+	require.True(t, age >= 18 && age <= 21)
+	score := obj["score"].(float64)
+	require.True(t, score > 0 && score < 1)
+	quantity := obj["quantity"].(int)
+	require.True(t, quantity >= 12 && quantity%5 == 0)
+	require.True(t, strings.HasPrefix(obj["when"].(string), "2022-01-"))
+	require.Equal(t, 3, len(obj["tags"].([]interface{})))
+	require.Equal(t, "SW1A 1AA", obj["address"].(map[string]interface{})["postcode"])
+	require.Equal(t, "4", obj["id"].(string)[14:15])
+
+	// deterministic per seed...
+	example2, err := GenerateExample(v, 1)
+	require.NoError(t, err)
+	require.Equal(t, example, example2)
+	example3, err := GenerateExample(v, 2)
+	require.NoError(t, err)
+	require.NotEqual(t, example, example3)
+}
+
+func TestGenerateExampleAllPresets(t *testing.T) {
+	for token := range getBuiltInPresets() {
+		t.Run(token, func(t *testing.T) {
+			v := &Validator{
+				Properties: Properties{
+					"foo": {
+						Type:        JsonString,
+						Mandatory:   true,
+						Constraints: Constraints{&StringPresetPattern{Preset: token}},
+					},
+				},
+			}
+			for seed := int64(0); seed < 5; seed++ {
+				example, err := GenerateExample(v, seed)
+				require.NoError(t, err)
+				preset, _ := GetRegisteredPreset(token)
+				require.True(t, preset.Check(example.(map[string]interface{})["foo"].(string)))
+			}
+		})
+	}
+}
+
+func TestGenerateExampleSkipsUnwantedProperties(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"foo": {
+				Type: JsonString,
+			},
+			"bar": {
+				Type:         JsonString,
+				UnwantedWith: MustParseExpression("foo"),
+			},
+			"id": {
+				Type:    JsonString,
+				OasInfo: &OasInfo{ReadOnly: true},
+			},
+			"baz": {
+				Type:               JsonString,
+				UnwantedConditions: []string{"qux"},
+			},
+		},
+	}
+	example, err := GenerateExample(v, 0)
+	require.NoError(t, err)
+	obj := example.(map[string]interface{})
+	require.Equal(t, 1, len(obj))
+	_, ok := obj["foo"]
+	require.True(t, ok)
+}
+
+func TestGenerateExampleUsesOasExamples(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"count": {
+				Type:    JsonInteger,
+				OasInfo: &OasInfo{Example: "42"},
+			},
+			"flag": {
+				Type:    JsonBoolean,
+				OasInfo: &OasInfo{Example: "true"},
+			},
+			"obj": {
+				Type:    JsonObject,
+				OasInfo: &OasInfo{Example: `{"foo":"bar"}`},
+			},
+			"choice": {
+				Type:    JsonString,
+				OasInfo: &OasInfo{Enum: []string{"only"}},
+			},
+		},
+	}
+	example, err := GenerateExample(v, 0)
+	require.NoError(t, err)
+	data, _ := json.Marshal(example)
+	require.Equal(t, `{"choice":"only","count":42,"flag":true,"obj":{"foo":"bar"}}`, string(data))
+
+	v = &Validator{
+		OasInfo:    &OasInfo{Example: `{"foo":"bar"}`},
+		Properties: Properties{"foo": {Type: JsonString, Mandatory: true}},
+	}
+	example, err = GenerateExample(v, 0)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"foo": "bar"}, example)
+}
+
+func TestGenerateExampleArrays(t *testing.T) {
+	v := &Validator{
+		AllowArray:     true,
+		DisallowObject: true,
+		Properties: Properties{
+			"items": {
+				Type:      JsonArray,
+				Mandatory: true,
+				ObjectValidator: &Validator{
+					AllowArray:     true,
+					DisallowObject: true,
+					Properties: Properties{
+						"value": {Type: JsonNumber, Mandatory: true, Constraints: Constraints{&Range{Minimum: -1, Maximum: 1}}},
+					},
+				},
+				Constraints: Constraints{&LengthExact{Value: 2}},
+			},
+		},
+	}
+	example, err := GenerateExample(v, 0)
+	require.NoError(t, err)
+	arr := example.([]interface{})
+	require.Equal(t, 1, len(arr))
+	items := arr[0].(map[string]interface{})["items"].([]interface{})
+	require.Equal(t, 2, len(items))
+}
+
+func TestGenerateExampleDatetimes(t *testing.T) {
+	testCases := []Constraint{
+		&DatetimeRange{Minimum: "2000-01-01", Maximum: "2000-01-02", ExclusiveMax: true},
+		&DatetimeGreaterThan{Value: "2030-01-01T00:00:00Z"},
+		&DatetimeLessThanOrEqual{Value: "1990-01-01T00:00:00Z"},
+		&DatetimeFuture{},
+		&DatetimePast{},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("[%d]", i+1), func(t *testing.T) {
+			v := &Validator{
+				Properties: Properties{
+					"dt":   {Type: JsonDatetime, Mandatory: true, Constraints: Constraints{tc}},
+					"date": {Type: JsonString, Mandatory: true, Constraints: Constraints{&StringValidISODate{}, tc}},
+				},
+			}
+			_, err := GenerateExample(v, 0)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGenerateExampleFails(t *testing.T) {
+	_, err := GenerateExample(nil, 0)
+	require.Error(t, err)
+	require.Equal(t, errMsgExampleNilValidator, err.Error())
+
+	v := &Validator{
+		Properties: Properties{
+			"foo": {
+				Type:        JsonString,
+				Mandatory:   true,
+				Constraints: Constraints{&StringPattern{Regexp: *regexp.MustCompile("^[0-9]{3}-[A-Z]{2}$")}},
+			},
+		},
+	}
+	_, err = GenerateExample(v, 0)
+	require.Error(t, err)
+	require.True(t, strings.HasPrefix(err.Error(), "unable to generate valid example - path '', property 'foo': "))
+}
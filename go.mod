@@ -1,6 +1,6 @@
 module github.com/marrow16/valix
 
-go 1.19
+go 1.23.0
 
 require (
 	github.com/go-andiamo/splitter v1.2.5
@@ -8,10 +8,10 @@ require (
 	github.com/stretchr/testify v1.8.2
 	golang.org/x/net v0.38.0
 	golang.org/x/text v0.23.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
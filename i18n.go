@@ -2,7 +2,6 @@ package valix
 
 import (
 	"encoding/json"
-	"golang.org/x/text/language"
 	"net/http"
 	"strings"
 )
@@ -13,13 +12,16 @@ var (
 
 // DefaultLanguage is the default language used by the default I18nProvider
 //
-// Languages provided are "de", "en", "es", "fr" & "it"
+// Built-in languages provided are "de", "en", "es", "fr", "it", "ja", "nl", "pl", "pt" & "zh" - but any language
+// that DefaultTranslator has translations for is supported (see SupportedLanguages)
 var DefaultLanguage = "en"
 
 // DefaultRegion is the default region used by the default I18nProvider
 var DefaultRegion = ""
 
 // DefaultFallbackLanguages is a map of language codes with their fallback language code
+//
+// Fallback languages are used in preference to matching a language to its closest supported language
 var DefaultFallbackLanguages = map[string]string{}
 
 // I18n interface for supporting i18n (internationalisation) in valix -
@@ -40,8 +42,9 @@ type I18nContext interface {
 }
 
 type defaultI18nContext struct {
-	lang   string
-	region string
+	lang       string
+	region     string
+	translator Translator
 }
 
 func newDefaultI18nContext(lang string, region string) I18nContext {
@@ -51,33 +54,51 @@ func newDefaultI18nContext(lang string, region string) I18nContext {
 	}
 }
 
+// newTranslatorI18nContext creates an I18nContext that uses the specified translator (rather than DefaultTranslator) -
+// the language is not matched against supported languages, as the translator may support other languages
+func newTranslatorI18nContext(lang string, region string, translator Translator) I18nContext {
+	return &defaultI18nContext{
+		lang:       normalizeLanguage(lang),
+		region:     strings.ToUpper(region),
+		translator: translator,
+	}
+}
+
 func defaultLanguage(lang string) string {
-	result := strings.ToLower(lang)
-	if result != "en" && result != "de" && result != "es" && result != "fr" && result != "it" {
-		if fb, ok := DefaultFallbackLanguages[result]; ok {
-			result = strings.ToLower(fb)
-		} else {
-			result = strings.ToLower(DefaultLanguage)
-		}
-	} else {
+	result := normalizeLanguage(lang)
+	if isSupportedLanguage(result) {
 		return result
 	}
-	if result != "en" && result != "de" && result != "es" && result != "fr" && result != "it" {
-		result = "en"
+	if fb, ok := DefaultFallbackLanguages[result]; ok {
+		result = normalizeLanguage(fb)
+	} else if matched, ok := matchLanguage(result); ok {
+		return matched
+	} else {
+		result = normalizeLanguage(DefaultLanguage)
+	}
+	if !isSupportedLanguage(result) {
+		result = langEn
 	}
 	return result
 }
 
 func (d *defaultI18nContext) TranslateMessage(msg string) string {
-	return DefaultTranslator.TranslateMessage(d.lang, d.region, msg)
+	return d.getTranslator().TranslateMessage(d.lang, d.region, msg)
 }
 
 func (d *defaultI18nContext) TranslateFormat(format string, a ...interface{}) string {
-	return DefaultTranslator.TranslateFormat(d.lang, d.region, format, a...)
+	return translateFormat(d.getTranslator(), d.lang, d.region, format, a...)
 }
 
 func (d *defaultI18nContext) TranslateToken(token string) string {
-	return DefaultTranslator.TranslateToken(d.lang, d.region, token)
+	return d.getTranslator().TranslateToken(d.lang, d.region, token)
+}
+
+func (d *defaultI18nContext) getTranslator() Translator {
+	if d.translator != nil {
+		return d.translator
+	}
+	return DefaultTranslator
 }
 
 func (d *defaultI18nContext) Language() string {
@@ -100,20 +121,12 @@ func (d *defaultI18nContext) MarshalJSON() ([]byte, error) {
 type defaultI18nProvider struct{}
 
 func (i *defaultI18nProvider) ContextFromRequest(r *http.Request) I18nContext {
-	tags, _, err := language.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
 	useLang := DefaultLanguage
 	useRegion := DefaultRegion
-	if err == nil {
-		for _, tag := range tags {
-			baseLang, _ := tag.Base()
-			if baseLang.String() == defaultLanguage(baseLang.String()) {
-				useLang = baseLang.String()
-				_, _, rawRgn := tag.Raw()
-				if rawRgn.String() != "ZZ" {
-					useRegion = rawRgn.String()
-				}
-				break
-			}
+	if lang, rgn, ok := matchAcceptLanguage(r.Header.Get("Accept-Language")); ok {
+		useLang = lang
+		if rgn != "" {
+			useRegion = rgn
 		}
 	}
 	return newDefaultI18nContext(useLang, useRegion)
@@ -123,6 +136,11 @@ func (i *defaultI18nProvider) DefaultContext() I18nContext {
 	return newDefaultI18nContext(DefaultLanguage, DefaultRegion)
 }
 
+// LanguageContext implements I18nLanguageProvider.LanguageContext
+func (i *defaultI18nProvider) LanguageContext(lang string, region string) I18nContext {
+	return newDefaultI18nContext(lang, region)
+}
+
 var fallbackI18nProvider I18n = &defaultI18nProvider{}
 
 func obtainI18nProvider() I18n {
@@ -149,132 +167,140 @@ func obtainI18nContext(i18nCtx I18nContext) I18nContext {
 // used by defaultI18nContext.MarshalJSON - to allow listing of translation reference
 // also as a kind of double-entry bookkeeping to ensure everything has been translated into TranslationsMessages
 var internalMessages = map[string]string{
-	msgUnableToDecode:                 msgUnableToDecode,
-	msgNotJsonNull:                    msgNotJsonNull,
-	msgNotJsonArray:                   msgNotJsonArray,
-	msgNotJsonObject:                  msgNotJsonObject,
-	msgExpectedJsonArray:              msgExpectedJsonArray,
-	msgExpectedJsonObject:             msgExpectedJsonObject,
-	msgErrorReading:                   msgErrorReading,
-	msgErrorUnmarshall:                msgErrorUnmarshall,
-	msgRequestBodyEmpty:               msgRequestBodyEmpty,
-	msgUnableToDecodeRequest:          msgUnableToDecodeRequest,
-	msgRequestBodyNotJsonNull:         msgRequestBodyNotJsonNull,
-	msgRequestBodyNotJsonArray:        msgRequestBodyNotJsonArray,
-	msgRequestBodyNotJsonObject:       msgRequestBodyNotJsonObject,
-	msgRequestBodyExpectedJsonArray:   msgRequestBodyExpectedJsonArray,
-	msgRequestBodyExpectedJsonObject:  msgRequestBodyExpectedJsonObject,
-	msgArrayElementMustBeObject:       msgArrayElementMustBeObject,
-	msgArrayElementMustNotBeNull:      msgArrayElementMustNotBeNull,
-	msgMissingProperty:                msgMissingProperty,
-	msgUnwantedProperty:               msgUnwantedProperty,
-	msgUnknownProperty:                msgUnknownProperty,
-	msgOnlyProperty:                   msgOnlyProperty,
-	msgInvalidProperty:                msgInvalidProperty,
-	msgInvalidPropertyName:            msgInvalidPropertyName,
-	msgPropertyValueMustBeObject:      msgPropertyValueMustBeObject,
-	msgPropertyRequiredWhen:           msgPropertyRequiredWhen,
-	msgPropertyUnwantedWhen:           msgPropertyUnwantedWhen,
-	msgValueCannotBeNull:              msgValueCannotBeNull,
-	msgNull:                           msgNull,
-	msgValueMustBeObject:              msgValueMustBeObject,
-	msgValueMustBeArray:               msgValueMustBeArray,
-	msgValueMustBeObjectOrArray:       msgValueMustBeObjectOrArray,
-	msgPropertyObjectValidatorError:   msgPropertyObjectValidatorError,
-	msgNotEmpty:                       msgNotEmpty,
-	msgNotEmptyString:                 msgNotEmptyString,
-	msgNotBlankString:                 msgNotBlankString,
-	msgNoControlChars:                 msgNoControlChars,
-	msgValidPattern:                   msgValidPattern,
-	msgInvalidCharacters:              msgInvalidCharacters,
-	msgStringValidJson:                msgStringValidJson,
-	msgStringLowercase:                msgStringLowercase,
-	msgStringUppercase:                msgStringUppercase,
-	msgUnicodeNormalization:           msgUnicodeNormalization,
-	msgUnicodeNormalizationNFC:        msgUnicodeNormalizationNFC,
-	msgUnicodeNormalizationNFKC:       msgUnicodeNormalizationNFKC,
-	msgUnicodeNormalizationNFD:        msgUnicodeNormalizationNFD,
-	msgUnicodeNormalizationNFKD:       msgUnicodeNormalizationNFKD,
-	msgPositive:                       msgPositive,
-	msgPositiveOrZero:                 msgPositiveOrZero,
-	msgNegative:                       msgNegative,
-	msgNegativeOrZero:                 msgNegativeOrZero,
-	msgArrayUnique:                    msgArrayUnique,
-	msgValidUuid:                      msgValidUuid,
-	msgValidCardNumber:                msgValidCardNumber,
-	msgValidCountryCode:               msgValidCountryCode,
-	msgValidCurrencyCode:              msgValidCurrencyCode,
-	msgValidEmail:                     msgValidEmail,
-	msgValidLanguageCode:              msgValidLanguageCode,
-	msgFailure:                        msgFailure,
-	msgValidISODate:                   msgValidISODate,
-	msgValidISODatetimeFormatFull:     msgValidISODatetimeFormatFull,
-	msgValidISODatetimeFormatNoOffs:   msgValidISODatetimeFormatNoOffs,
-	msgValidISODatetimeFormatNoMillis: msgValidISODatetimeFormatNoMillis,
-	msgValidISODatetimeFormatMin:      msgValidISODatetimeFormatMin,
-	msgValidISODuration:               msgValidISODuration,
-	msgValidTimezone:                  msgValidTimezone,
-	msgDatetimeDayOfWeek:              msgDatetimeDayOfWeek,
-	msgDatetimeFuture:                 msgDatetimeFuture,
-	msgDatetimeFutureOrPresent:        msgDatetimeFutureOrPresent,
-	msgDatetimePast:                   msgDatetimePast,
-	msgDatetimePastOrPresent:          msgDatetimePastOrPresent,
-	msgPresetISBN:                     msgPresetISBN,
-	msgPresetISBN10:                   msgPresetISBN10,
-	msgPresetISBN13:                   msgPresetISBN13,
-	msgPresetISSN:                     msgPresetISSN,
-	msgPresetEAN:                      msgPresetEAN,
-	msgPresetEAN8:                     msgPresetEAN8,
-	msgPresetEAN13:                    msgPresetEAN13,
-	msgPresetDUN14:                    msgPresetDUN14,
-	msgPresetEAN14:                    msgPresetEAN14,
-	msgPresetEAN18:                    msgPresetEAN18,
-	msgPresetEAN99:                    msgPresetEAN99,
-	msgPresetUPC:                      msgPresetUPC,
-	msgPresetUPCA:                     msgPresetUPCA,
-	msgPresetUPCE:                     msgPresetUPCE,
-	msgPresetPublication:              msgPresetPublication,
-	msgPresetAlpha:                    msgPresetAlpha,
-	msgPresetAlphaNumeric:             msgPresetAlphaNumeric,
-	msgPresetBarcode:                  msgPresetBarcode,
-	msgPresetNumeric:                  msgPresetNumeric,
-	msgPresetInteger:                  msgPresetInteger,
-	msgPresetHexadecimal:              msgPresetHexadecimal,
-	msgPresetCMYK:                     msgPresetCMYK,
-	msgPresetCMYK300:                  msgPresetCMYK300,
-	msgPresetHtmlColor:                msgPresetHtmlColor,
-	msgPresetRgb:                      msgPresetRgb,
-	msgPresetRgba:                     msgPresetRgba,
-	msgPresetRgbIcc:                   msgPresetRgbIcc,
-	msgPresetHsl:                      msgPresetHsl,
-	msgPresetHsla:                     msgPresetHsla,
-	msgPresetE164:                     msgPresetE164,
-	msgPresetBase64:                   msgPresetBase64,
-	msgPresetBase64URL:                msgPresetBase64URL,
-	msgPresetUuid1:                    msgPresetUuid1,
-	msgPresetUuid2:                    msgPresetUuid2,
-	msgPresetUuid3:                    msgPresetUuid3,
-	msgPresetUuid4:                    msgPresetUuid4,
-	msgPresetUuid5:                    msgPresetUuid5,
-	msgPresetULID:                     msgPresetULID,
-	msgValidMAC:                       msgValidMAC,
-	msgValidCIDR:                      msgValidCIDR,
-	msgValidCIDRv4:                    msgValidCIDRv4,
-	msgValidCIDRv6:                    msgValidCIDRv6,
-	msgValidHostname:                  msgValidHostname,
-	msgValidIP:                        msgValidIP,
-	msgValidIPv4:                      msgValidIPv4,
-	msgValidIPv6:                      msgValidIPv6,
-	msgValidTCP:                       msgValidTCP,
-	msgValidTCPv4:                     msgValidTCPv4,
-	msgValidTCPv6:                     msgValidTCPv6,
-	msgValidUDP:                       msgValidUDP,
-	msgValidUDPv4:                     msgValidUDPv4,
-	msgValidUDPv6:                     msgValidUDPv6,
-	msgValidTld:                       msgValidTld,
-	msgValidURI:                       msgValidURI,
-	msgValidURL:                       msgValidURL,
-	msgQueryParamMultiNotAllowed:      msgQueryParamMultiNotAllowed,
+	msgUnableToDecode:                  msgUnableToDecode,
+	msgNotJsonNull:                     msgNotJsonNull,
+	msgNotJsonArray:                    msgNotJsonArray,
+	msgNotJsonObject:                   msgNotJsonObject,
+	msgExpectedJsonArray:               msgExpectedJsonArray,
+	msgExpectedJsonObject:              msgExpectedJsonObject,
+	msgErrorReading:                    msgErrorReading,
+	msgErrorUnmarshall:                 msgErrorUnmarshall,
+	msgRequestBodyEmpty:                msgRequestBodyEmpty,
+	msgUnableToDecodeRequest:           msgUnableToDecodeRequest,
+	msgRequestBodyNotJsonNull:          msgRequestBodyNotJsonNull,
+	msgRequestBodyNotJsonArray:         msgRequestBodyNotJsonArray,
+	msgRequestBodyNotJsonObject:        msgRequestBodyNotJsonObject,
+	msgRequestBodyExpectedJsonArray:    msgRequestBodyExpectedJsonArray,
+	msgRequestBodyExpectedJsonObject:   msgRequestBodyExpectedJsonObject,
+	msgArrayElementMustBeObject:        msgArrayElementMustBeObject,
+	msgArrayElementMustNotBeNull:       msgArrayElementMustNotBeNull,
+	msgMissingProperty:                 msgMissingProperty,
+	msgUnwantedProperty:                msgUnwantedProperty,
+	msgUnknownProperty:                 msgUnknownProperty,
+	msgOnlyProperty:                    msgOnlyProperty,
+	msgDeprecatedProperty:              msgDeprecatedProperty,
+	msgInvalidProperty:                 msgInvalidProperty,
+	msgInvalidPropertyName:             msgInvalidPropertyName,
+	msgPropertyValueMustBeObject:       msgPropertyValueMustBeObject,
+	msgPropertyRequiredWhen:            msgPropertyRequiredWhen,
+	msgPropertyUnwantedWhen:            msgPropertyUnwantedWhen,
+	msgValueCannotBeNull:               msgValueCannotBeNull,
+	msgNull:                            msgNull,
+	msgValueMustBeObject:               msgValueMustBeObject,
+	msgValueMustBeArray:                msgValueMustBeArray,
+	msgValueMustBeObjectOrArray:        msgValueMustBeObjectOrArray,
+	msgPropertyObjectValidatorError:    msgPropertyObjectValidatorError,
+	msgNotEmpty:                        msgNotEmpty,
+	msgNotEmptyString:                  msgNotEmptyString,
+	msgNotBlankString:                  msgNotBlankString,
+	msgNoControlChars:                  msgNoControlChars,
+	msgValidPattern:                    msgValidPattern,
+	msgInvalidCharacters:               msgInvalidCharacters,
+	msgStringValidJson:                 msgStringValidJson,
+	msgStringLowercase:                 msgStringLowercase,
+	msgStringUppercase:                 msgStringUppercase,
+	msgUnicodeNormalization:            msgUnicodeNormalization,
+	msgUnicodeNormalizationNFC:         msgUnicodeNormalizationNFC,
+	msgUnicodeNormalizationNFKC:        msgUnicodeNormalizationNFKC,
+	msgUnicodeNormalizationNFD:         msgUnicodeNormalizationNFD,
+	msgUnicodeNormalizationNFKD:        msgUnicodeNormalizationNFKD,
+	msgPositive:                        msgPositive,
+	msgPositiveOrZero:                  msgPositiveOrZero,
+	msgNegative:                        msgNegative,
+	msgNegativeOrZero:                  msgNegativeOrZero,
+	msgArrayUnique:                     msgArrayUnique,
+	msgValidUuid:                       msgValidUuid,
+	msgValidCardNumber:                 msgValidCardNumber,
+	msgValidCountryCode:                msgValidCountryCode,
+	msgValidCurrencyCode:               msgValidCurrencyCode,
+	msgValidEmail:                      msgValidEmail,
+	msgValidLanguageCode:               msgValidLanguageCode,
+	msgFailure:                         msgFailure,
+	msgValidISODate:                    msgValidISODate,
+	msgValidISODatetimeFormatFull:      msgValidISODatetimeFormatFull,
+	msgValidISODatetimeFormatNoOffs:    msgValidISODatetimeFormatNoOffs,
+	msgValidISODatetimeFormatNoMillis:  msgValidISODatetimeFormatNoMillis,
+	msgValidISODatetimeFormatMin:       msgValidISODatetimeFormatMin,
+	msgValidISODuration:                msgValidISODuration,
+	msgValidTimezone:                   msgValidTimezone,
+	msgDatetimeDayOfWeek:               msgDatetimeDayOfWeek,
+	msgDatetimeFuture:                  msgDatetimeFuture,
+	msgDatetimeFutureOrPresent:         msgDatetimeFutureOrPresent,
+	msgDatetimePast:                    msgDatetimePast,
+	msgDatetimePastOrPresent:           msgDatetimePastOrPresent,
+	msgPresetISBN:                      msgPresetISBN,
+	msgPresetISBN10:                    msgPresetISBN10,
+	msgPresetISBN13:                    msgPresetISBN13,
+	msgPresetISSN:                      msgPresetISSN,
+	msgPresetISSN8:                     msgPresetISSN8,
+	msgPresetISSN13:                    msgPresetISSN13,
+	msgPresetEAN:                       msgPresetEAN,
+	msgPresetEAN8:                      msgPresetEAN8,
+	msgPresetEAN13:                     msgPresetEAN13,
+	msgPresetDUN14:                     msgPresetDUN14,
+	msgPresetEAN14:                     msgPresetEAN14,
+	msgPresetEAN18:                     msgPresetEAN18,
+	msgPresetEAN99:                     msgPresetEAN99,
+	msgPresetUPC:                       msgPresetUPC,
+	msgPresetUPCA:                      msgPresetUPCA,
+	msgPresetUPCE:                      msgPresetUPCE,
+	msgPresetPublication:               msgPresetPublication,
+	msgPresetAlpha:                     msgPresetAlpha,
+	msgPresetAlphaNumeric:              msgPresetAlphaNumeric,
+	msgPresetBarcode:                   msgPresetBarcode,
+	msgPresetNumeric:                   msgPresetNumeric,
+	msgPresetInteger:                   msgPresetInteger,
+	msgPresetHexadecimal:               msgPresetHexadecimal,
+	msgPresetCMYK:                      msgPresetCMYK,
+	msgPresetCMYK300:                   msgPresetCMYK300,
+	msgPresetHtmlColor:                 msgPresetHtmlColor,
+	msgPresetRgb:                       msgPresetRgb,
+	msgPresetRgba:                      msgPresetRgba,
+	msgPresetRgbIcc:                    msgPresetRgbIcc,
+	msgPresetHsl:                       msgPresetHsl,
+	msgPresetHsla:                      msgPresetHsla,
+	msgPresetE164:                      msgPresetE164,
+	msgPresetBase64:                    msgPresetBase64,
+	msgPresetBase64URL:                 msgPresetBase64URL,
+	msgPresetUuid1:                     msgPresetUuid1,
+	msgPresetUuid2:                     msgPresetUuid2,
+	msgPresetUuid3:                     msgPresetUuid3,
+	msgPresetUuid4:                     msgPresetUuid4,
+	msgPresetUuid5:                     msgPresetUuid5,
+	msgPresetULID:                      msgPresetULID,
+	msgValidMAC:                        msgValidMAC,
+	msgValidCIDR:                       msgValidCIDR,
+	msgValidCIDRv4:                     msgValidCIDRv4,
+	msgValidCIDRv6:                     msgValidCIDRv6,
+	msgValidHostname:                   msgValidHostname,
+	msgValidIP:                         msgValidIP,
+	msgValidIPv4:                       msgValidIPv4,
+	msgValidIPv6:                       msgValidIPv6,
+	msgValidTCP:                        msgValidTCP,
+	msgValidTCPv4:                      msgValidTCPv4,
+	msgValidTCPv6:                      msgValidTCPv6,
+	msgValidUDP:                        msgValidUDP,
+	msgValidUDPv4:                      msgValidUDPv4,
+	msgValidUDPv6:                      msgValidUDPv6,
+	msgValidTld:                        msgValidTld,
+	msgValidURI:                        msgValidURI,
+	msgValidURL:                        msgValidURL,
+	msgQueryParamMultiNotAllowed:       msgQueryParamMultiNotAllowed,
+	msgProblemTitleBadRequest:          msgProblemTitleBadRequest,
+	msgProblemTitleUnprocessableEntity: msgProblemTitleUnprocessableEntity,
+	msgResponseFailedValidation:        msgResponseFailedValidation,
+	msgHeaderMultiNotAllowed:           msgHeaderMultiNotAllowed,
+	msgSchemaOneOfMultiple:             msgSchemaOneOfMultiple,
 }
 
 // used by defaultI18nContext.MarshalJSON - to allow listing of translation reference
@@ -355,45 +381,84 @@ var internalFormats = map[string]string{
 	fmtMsgConstraintSetDefaultOneOf: fmtMsgConstraintSetDefaultOneOf,
 	// request query validate...
 	fmtMsgQueryParamType: fmtMsgQueryParamType,
+	// api...
+	fmtMsgPathParamType: fmtMsgPathParamType,
+	fmtMsgHeaderType:    fmtMsgHeaderType,
+	// units...
+	fmtUnitMillennium:  fmtUnitMillennium,
+	fmtUnitCentury:     fmtUnitCentury,
+	fmtUnitDecade:      fmtUnitDecade,
+	fmtUnitYear:        fmtUnitYear,
+	fmtUnitMonth:       fmtUnitMonth,
+	fmtUnitWeek:        fmtUnitWeek,
+	fmtUnitDay:         fmtUnitDay,
+	fmtUnitHour:        fmtUnitHour,
+	fmtUnitMinute:      fmtUnitMinute,
+	fmtUnitSecond:      fmtUnitSecond,
+	fmtUnitMillisecond: fmtUnitMillisecond,
+	fmtUnitMicrosecond: fmtUnitMicrosecond,
+	fmtUnitNanosecond:  fmtUnitNanosecond,
 }
 
 // used by defaultI18nContext.MarshalJSON - to allow listing of translation reference
 // also as a kind of double-entry bookkeeping to ensure everything has been translated into TranslationsTokens
 // "..." at the end indicates pluralisation
 var internalTokens = map[string]string{
-	jsonTypeTokenString:  jsonTypeTokenString,
-	jsonTypeTokenNumber:  jsonTypeTokenNumber,
-	jsonTypeTokenInteger: jsonTypeTokenInteger,
-	jsonTypeTokenBoolean: jsonTypeTokenBoolean,
-	jsonTypeTokenObject:  jsonTypeTokenObject,
-	jsonTypeTokenArray:   jsonTypeTokenArray,
-	jsonTypeTokenAny:     jsonTypeTokenAny,
-	tokenInclusive:       tokenInclusive,
-	tokenExclusive:       tokenExclusive,
-	"millennium":         "millennium",
-	"millennium...":      "millennia",
-	"century":            "century",
-	"century...":         "centuries",
-	"decade":             "decade",
-	"decade...":          "decades",
-	"year":               "year",
-	"year...":            "years",
-	"month":              "month",
-	"month...":           "months",
-	"week":               "week",
-	"week...":            "weeks",
-	"day":                "day",
-	"day...":             "days",
-	"hour":               "hour",
-	"hour...":            "hours",
-	"minute":             "minute",
-	"minute...":          "minutes",
-	"second":             "second",
-	"second...":          "seconds",
-	"millisecond":        "millisecond",
-	"millisecond...":     "milliseconds",
-	"microsecond":        "microsecond",
-	"microsecond...":     "microseconds",
-	"nanosecond":         "nanosecond",
-	"nanosecond...":      "nanoseconds",
+	jsonTypeTokenString:        jsonTypeTokenString,
+	jsonTypeTokenNumber:        jsonTypeTokenNumber,
+	jsonTypeTokenInteger:       jsonTypeTokenInteger,
+	jsonTypeTokenBoolean:       jsonTypeTokenBoolean,
+	jsonTypeTokenObject:        jsonTypeTokenObject,
+	jsonTypeTokenArray:         jsonTypeTokenArray,
+	jsonTypeTokenAny:           jsonTypeTokenAny,
+	tokenInclusive:             tokenInclusive,
+	tokenExclusive:             tokenExclusive,
+	"millennium":               "millennium",
+	"millennium...":            "millennia",
+	"century":                  "century",
+	"century...":               "centuries",
+	"decade":                   "decade",
+	"decade...":                "decades",
+	"year":                     "year",
+	"year...":                  "years",
+	"month":                    "month",
+	"month...":                 "months",
+	"week":                     "week",
+	"week...":                  "weeks",
+	"day":                      "day",
+	"day...":                   "days",
+	"hour":                     "hour",
+	"hour...":                  "hours",
+	"minute":                   "minute",
+	"minute...":                "minutes",
+	"second":                   "second",
+	"second...":                "seconds",
+	"millisecond":              "millisecond",
+	"millisecond...":           "milliseconds",
+	"microsecond":              "microsecond",
+	"microsecond...":           "microseconds",
+	"nanosecond":               "nanosecond",
+	"nanosecond...":            "nanoseconds",
+	docTokenProperty:           docTokenProperty,
+	docTokenType:               docTokenType,
+	docTokenMandatory:          docTokenMandatory,
+	docTokenNullable:           docTokenNullable,
+	docTokenConditions:         docTokenConditions,
+	docTokenDescription:        docTokenDescription,
+	docTokenConstraints:        docTokenConstraints,
+	docTokenYes:                docTokenYes,
+	docTokenNo:                 docTokenNo,
+	docTokenWhen:               docTokenWhen,
+	docTokenMandatoryWhen:      docTokenMandatoryWhen,
+	docTokenUnwantedWhen:       docTokenUnwantedWhen,
+	docTokenRequiredWith:       docTokenRequiredWith,
+	docTokenUnwantedWith:       docTokenUnwantedWith,
+	docTokenOnly:               docTokenOnly,
+	docTokenOnlyWhen:           docTokenOnlyWhen,
+	docTokenEachItem:           docTokenEachItem,
+	docTokenConditionalVariant: docTokenConditionalVariant,
+	docTokenObjectConstraints:  docTokenObjectConstraints,
+	docTokenUnknownAllowed:     docTokenUnknownAllowed,
+	docTokenDeprecated:         docTokenDeprecated,
+	docTokenExample:            docTokenExample,
 }
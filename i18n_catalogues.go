@@ -0,0 +1,273 @@
+package valix
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TranslationCatalogue is a catalogue of translations for a language (and its regional variants) - as read by
+// LoadTranslations, LoadTranslationsFile and LoadTranslationsFS and as produced by ExportTranslations
+//
+// The translations are keyed by the original (English) token, message or format - e.g. in JSON:
+//
+//	{
+//	  "language": "fr",
+//	  "tokens": {
+//	    "array": "tableau"
+//	  },
+//	  "messages": {
+//	    "Missing property": "Propriété manquante"
+//	  },
+//	  "formats": {
+//	    "String value length must not exceed {1, plural, one{# character} other{# characters}}": "La longueur de la chaîne ne doit pas dépasser {1, plural, one{# caractère} other{# caractères}}"
+//	  },
+//	  "regions": {
+//	    "CA": {
+//	      "messages": {
+//	        "Missing property": "Propriété absente"
+//	      }
+//	    }
+//	  }
+//	}
+//
+// or the equivalent in YAML.  A catalogue file may contain either a single catalogue or a list of catalogues
+type TranslationCatalogue struct {
+	// Language is the language code (e.g. "fr") - required
+	Language string `json:"language" yaml:"language"`
+	// TranslationCatalogueEntries is the translations for the language
+	TranslationCatalogueEntries `yaml:",inline"`
+	// Regions is the regional variant translations for the language - keyed by region code (e.g. "CA")
+	Regions map[string]*TranslationCatalogueEntries `json:"regions,omitempty" yaml:"regions,omitempty"`
+}
+
+// TranslationCatalogueEntries is the translations (of tokens, messages and formats) in a TranslationCatalogue
+type TranslationCatalogueEntries struct {
+	// Tokens is the token translations (keyed by original token)
+	Tokens map[string]string `json:"tokens,omitempty" yaml:"tokens,omitempty"`
+	// Messages is the message translations (keyed by original message)
+	Messages map[string]string `json:"messages,omitempty" yaml:"messages,omitempty"`
+	// Formats is the format translations (keyed by original format)
+	Formats map[string]string `json:"formats,omitempty" yaml:"formats,omitempty"`
+}
+
+const (
+	errMsgCatalogueNoLanguage  = "translation catalogue must specify a language"
+	errMsgCatalogueParse       = "unable to parse translation catalogue: %s"
+	errMsgCatalogueNotExported = "translator does not support exporting translations"
+)
+
+// DefaultTranslationFilePatterns is the default file patterns used by LoadTranslationsFS
+var DefaultTranslationFilePatterns = []string{"*.json", "*.yaml", "*.yml"}
+
+// LoadTranslations reads translation catalogues (JSON or YAML - see TranslationCatalogue) from the reader and adds
+// the translations to the translator (if the translator is nil, the translations are added to DefaultTranslator)
+func LoadTranslations(translator Translator, r io.Reader) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	catalogues, err := parseTranslationCatalogues(data)
+	if err != nil {
+		return err
+	}
+	for _, c := range catalogues {
+		c.AddTo(translator)
+	}
+	return nil
+}
+
+// LoadTranslationsFile reads translation catalogues (JSON or YAML - see TranslationCatalogue) from the named file
+// and adds the translations to the translator (if the translator is nil, the translations are added to DefaultTranslator)
+func LoadTranslationsFile(translator Translator, filename string) error {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	return LoadTranslations(translator, bytes.NewReader(data))
+}
+
+// LoadTranslationsFS reads translation catalogues (JSON or YAML - see TranslationCatalogue) from the files in the
+// file system (e.g. an embed.FS) that match any of the patterns (see fs.Glob) and adds the translations to
+// the translator (if the translator is nil, the translations are added to DefaultTranslator)
+//
+// If no patterns are specified, DefaultTranslationFilePatterns is used
+func LoadTranslationsFS(translator Translator, fsys fs.FS, patterns ...string) error {
+	if len(patterns) == 0 {
+		patterns = DefaultTranslationFilePatterns
+	}
+	for _, pattern := range patterns {
+		names, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			data, err := fs.ReadFile(fsys, name)
+			if err != nil {
+				return err
+			}
+			if err = LoadTranslations(translator, bytes.NewReader(data)); err != nil {
+				return fmt.Errorf("%s: %w", path.Clean(name), err)
+			}
+		}
+	}
+	return nil
+}
+
+func parseTranslationCatalogues(data []byte) ([]*TranslationCatalogue, error) {
+	// YAML is a superset of JSON - so the YAML decoder reads both...
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, fmt.Errorf(errMsgCatalogueParse, err.Error())
+	}
+	result := make([]*TranslationCatalogue, 0)
+	if len(node.Content) > 0 && node.Content[0].Kind == yaml.SequenceNode {
+		if err := node.Decode(&result); err != nil {
+			return nil, fmt.Errorf(errMsgCatalogueParse, err.Error())
+		}
+	} else if len(node.Content) > 0 {
+		c := &TranslationCatalogue{}
+		if err := node.Decode(c); err != nil {
+			return nil, fmt.Errorf(errMsgCatalogueParse, err.Error())
+		}
+		result = append(result, c)
+	}
+	for _, c := range result {
+		if c == nil || c.Language == "" {
+			return nil, fmt.Errorf(errMsgCatalogueNoLanguage)
+		}
+	}
+	return result, nil
+}
+
+// AddTo adds the catalogue translations to the translator (if the translator is nil, the translations are
+// added to DefaultTranslator)
+//
+// Existing translations for the same language (or language and region) are replaced
+func (c *TranslationCatalogue) AddTo(translator Translator) {
+	if translator == nil {
+		translator = DefaultTranslator
+	}
+	defer invalidateSupportedLanguages()
+	lang := normalizeLanguage(c.Language)
+	if it, ok := translator.(*internalTranslator); ok {
+		it.addEntries(lang, &c.TranslationCatalogueEntries)
+		for rgn, entries := range c.Regions {
+			if entries != nil {
+				it.addEntries(lang+"-"+strings.ToUpper(rgn), entries)
+			}
+		}
+		return
+	}
+	for k, tr := range c.Tokens {
+		translator.AddTokenLanguageTranslation(lang, k, tr)
+	}
+	for k, tr := range c.Messages {
+		translator.AddMessageLanguageTranslation(lang, k, tr)
+	}
+	for k, tr := range c.Formats {
+		translator.AddFormatLanguageTranslation(lang, k, tr)
+	}
+	for rgn, entries := range c.Regions {
+		if entries != nil {
+			for k, tr := range entries.Tokens {
+				translator.AddTokenRegionTranslation(lang, rgn, k, tr)
+			}
+			for k, tr := range entries.Messages {
+				translator.AddMessageRegionTranslation(lang, rgn, k, tr)
+			}
+			for k, tr := range entries.Formats {
+				translator.AddFormatRegionTranslation(lang, rgn, k, tr)
+			}
+		}
+	}
+}
+
+func (t *internalTranslator) addEntries(key string, entries *TranslationCatalogueEntries) {
+	addCatalogueEntries(t.Tokens, key, entries.Tokens)
+	addCatalogueEntries(t.Messages, key, entries.Messages)
+	formats := make(map[string]string, len(entries.Formats))
+	for k, tr := range entries.Formats {
+		formats[currentFormat(k)] = tr
+	}
+	addCatalogueEntries(t.Formats, key, formats)
+}
+
+func addCatalogueEntries(trs map[string]map[string]string, key string, entries map[string]string) {
+	for k, tr := range entries {
+		if ts, ok := trs[k]; ok {
+			ts[key] = tr
+		} else {
+			trs[k] = map[string]string{key: tr}
+		}
+	}
+}
+
+// ExportTranslations exports the translations of the translator (if the translator is nil, DefaultTranslator is
+// used) as translation catalogues - one per language (sorted by language).  If no languages are specified, all
+// languages are exported.  The returned catalogues can be marshalled to JSON or YAML - and read back using
+// LoadTranslations
+//
+// Note: Only the built-in translator (i.e. DefaultTranslator, unless it has been replaced) supports exporting
+func ExportTranslations(translator Translator, languages ...string) ([]*TranslationCatalogue, error) {
+	if translator == nil {
+		translator = DefaultTranslator
+	}
+	it, ok := translator.(*internalTranslator)
+	if !ok {
+		return nil, fmt.Errorf(errMsgCatalogueNotExported)
+	}
+	onlyLangs := make(map[string]bool, len(languages))
+	for _, l := range languages {
+		onlyLangs[normalizeLanguage(l)] = true
+	}
+	catalogues := map[string]*TranslationCatalogue{}
+	exportEntries := func(trs map[string]map[string]string, entries func(*TranslationCatalogueEntries) *map[string]string) {
+		for k, ts := range trs {
+			for key, tr := range ts {
+				lang, rgn := splitTranslationKey(key)
+				if len(onlyLangs) > 0 && !onlyLangs[lang] {
+					continue
+				}
+				c, exists := catalogues[lang]
+				if !exists {
+					c = &TranslationCatalogue{Language: lang}
+					catalogues[lang] = c
+				}
+				e := &c.TranslationCatalogueEntries
+				if rgn != "" {
+					if c.Regions == nil {
+						c.Regions = map[string]*TranslationCatalogueEntries{}
+					}
+					if e = c.Regions[rgn]; e == nil {
+						e = &TranslationCatalogueEntries{}
+						c.Regions[rgn] = e
+					}
+				}
+				m := entries(e)
+				if *m == nil {
+					*m = map[string]string{}
+				}
+				(*m)[k] = tr
+			}
+		}
+	}
+	exportEntries(it.Tokens, func(e *TranslationCatalogueEntries) *map[string]string { return &e.Tokens })
+	exportEntries(it.Messages, func(e *TranslationCatalogueEntries) *map[string]string { return &e.Messages })
+	exportEntries(it.Formats, func(e *TranslationCatalogueEntries) *map[string]string { return &e.Formats })
+	result := make([]*TranslationCatalogue, 0, len(catalogues))
+	for _, c := range catalogues {
+		result = append(result, c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Language < result[j].Language
+	})
+	return result, nil
+}
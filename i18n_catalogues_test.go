@@ -0,0 +1,192 @@
+package valix
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func newTestTranslator() *internalTranslator {
+	return &internalTranslator{
+		Tokens:   map[string]map[string]string{},
+		Messages: map[string]map[string]string{},
+		Formats:  map[string]map[string]string{},
+	}
+}
+
+const testCatalogueJson = `{
+  "language": "nl",
+  "tokens": {"array": "reeks"},
+  "messages": {"Missing property": "Ontbrekende eigenschap"},
+  "formats": {"Value must be %[1]d": "Waarde moet %[1]d zijn"},
+  "regions": {
+    "be": {"messages": {"Missing property": "Eigenschap ontbreekt"}}
+  }
+}`
+
+const testCatalogueYaml = `
+- language: pt
+  messages:
+    Missing property: Propriedade ausente
+  regions:
+    BR:
+      tokens:
+        array: matriz
+- language: NL
+  tokens:
+    object: object
+`
+
+func TestLoadTranslations_Json(t *testing.T) {
+	tr := newTestTranslator()
+	err := LoadTranslations(tr, strings.NewReader(testCatalogueJson))
+	require.NoError(t, err)
+	require.Equal(t, "reeks", tr.TranslateToken("nl", "", "array"))
+	require.Equal(t, "Ontbrekende eigenschap", tr.TranslateMessage("nl", "", msgMissingProperty))
+	require.Equal(t, "Eigenschap ontbreekt", tr.TranslateMessage("nl", "BE", msgMissingProperty))
+	require.Equal(t, "Ontbrekende eigenschap", tr.TranslateMessage("nl", "NL", msgMissingProperty))
+	require.Equal(t, "Waarde moet 2 zijn", tr.TranslateFormat("nl", "", "Value must be %[1]d", 2))
+	require.Equal(t, msgMissingProperty, tr.TranslateMessage("fr", "", msgMissingProperty))
+}
+
+func TestLoadTranslations_Yaml(t *testing.T) {
+	tr := newTestTranslator()
+	err := LoadTranslations(tr, strings.NewReader(testCatalogueYaml))
+	require.NoError(t, err)
+	require.Equal(t, "Propriedade ausente", tr.TranslateMessage("pt", "", msgMissingProperty))
+	require.Equal(t, "matriz", tr.TranslateToken("pt", "BR", "array"))
+	require.Equal(t, "array", tr.TranslateToken("pt", "", "array"))
+	require.Equal(t, "object", tr.TranslateToken("nl", "", "object"))
+}
+
+func TestLoadTranslations_Errors(t *testing.T) {
+	tr := newTestTranslator()
+	err := LoadTranslations(tr, strings.NewReader(`{"messages": {}}`))
+	require.Error(t, err)
+	require.Equal(t, errMsgCatalogueNoLanguage, err.Error())
+
+	err = LoadTranslations(tr, strings.NewReader(`{"language": "nl", "messages": []}`))
+	require.Error(t, err)
+	require.True(t, strings.HasPrefix(err.Error(), "unable to parse translation catalogue"))
+
+	err = LoadTranslations(tr, strings.NewReader(`{`))
+	require.Error(t, err)
+
+	err = LoadTranslations(tr, strings.NewReader(``))
+	require.NoError(t, err)
+}
+
+func TestLoadTranslationsFile(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "nl.json")
+	require.NoError(t, os.WriteFile(filename, []byte(testCatalogueJson), 0644))
+	tr := newTestTranslator()
+	require.NoError(t, LoadTranslationsFile(tr, filename))
+	require.Equal(t, "reeks", tr.TranslateToken("nl", "", "array"))
+
+	require.Error(t, LoadTranslationsFile(tr, filepath.Join(dir, "missing.json")))
+}
+
+func TestLoadTranslationsFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"nl.json":    {Data: []byte(testCatalogueJson)},
+		"pt.yaml":    {Data: []byte(testCatalogueYaml)},
+		"readme.txt": {Data: []byte(`not a catalogue`)},
+	}
+	tr := newTestTranslator()
+	require.NoError(t, LoadTranslationsFS(tr, fsys))
+	require.Equal(t, "reeks", tr.TranslateToken("nl", "", "array"))
+	require.Equal(t, "Propriedade ausente", tr.TranslateMessage("pt", "", msgMissingProperty))
+
+	tr = newTestTranslator()
+	require.NoError(t, LoadTranslationsFS(tr, fsys, "*.yaml"))
+	require.Equal(t, "array", tr.TranslateToken("nl", "", "array"))
+
+	fsys["bad.json"] = &fstest.MapFile{Data: []byte(`{}`)}
+	err := LoadTranslationsFS(tr, fsys)
+	require.Error(t, err)
+	require.Equal(t, "bad.json: "+errMsgCatalogueNoLanguage, err.Error())
+
+	require.Error(t, LoadTranslationsFS(tr, fsys, "["))
+}
+
+type testOtherTranslator struct {
+	Translator
+	added []string
+}
+
+func (t *testOtherTranslator) AddMessageLanguageTranslation(lang string, message string, translation string, regionals ...RegionalVariantTranslation) {
+	t.added = append(t.added, lang+":"+message+"="+translation)
+}
+
+func (t *testOtherTranslator) AddMessageRegionTranslation(lang string, region string, message string, translation string) {
+	t.added = append(t.added, lang+"-"+region+":"+message+"="+translation)
+}
+
+func TestTranslationCatalogue_AddToOtherTranslator(t *testing.T) {
+	tr := &testOtherTranslator{Translator: newTestTranslator()}
+	c := &TranslationCatalogue{
+		Language:                    "NL",
+		TranslationCatalogueEntries: TranslationCatalogueEntries{Messages: map[string]string{"a": "b"}},
+		Regions: map[string]*TranslationCatalogueEntries{
+			"BE": {Messages: map[string]string{"a": "c"}},
+		},
+	}
+	c.AddTo(tr)
+	require.Equal(t, []string{"nl:a=b", "nl-BE:a=c"}, tr.added)
+
+	_, err := ExportTranslations(tr)
+	require.Error(t, err)
+	require.Equal(t, errMsgCatalogueNotExported, err.Error())
+}
+
+func TestExportTranslations(t *testing.T) {
+	catalogues, err := ExportTranslations(nil)
+	require.NoError(t, err)
+	langs := make([]string, 0, len(catalogues))
+	for _, c := range catalogues {
+		langs = append(langs, c.Language)
+	}
+	require.Equal(t, SupportedLanguages(), langs)
+	fr := catalogues[3]
+	require.Equal(t, "fr", fr.Language)
+	require.Equal(t, "Propriété manquante", fr.Messages[msgMissingProperty])
+	require.Equal(t, "tableau", fr.Tokens[jsonTypeTokenArray])
+	require.Equal(t, len(internalFormats), len(fr.Formats))
+
+	catalogues, err = ExportTranslations(nil, "FR")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(catalogues))
+}
+
+func TestExportTranslations_RoundTrip(t *testing.T) {
+	tr := newTestTranslator()
+	require.NoError(t, LoadTranslations(tr, strings.NewReader(testCatalogueJson)))
+	require.NoError(t, LoadTranslations(tr, strings.NewReader(testCatalogueYaml)))
+	catalogues, err := ExportTranslations(tr)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(catalogues))
+	require.Equal(t, "nl", catalogues[0].Language)
+	require.Equal(t, "Eigenschap ontbreekt", catalogues[0].Regions["BE"].Messages[msgMissingProperty])
+	require.Equal(t, "matriz", catalogues[1].Regions["BR"].Tokens["array"])
+
+	data, err := json.Marshal(catalogues)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `{"language":"nl","tokens":{"array":"reeks","object":"object"}`)
+	tr2 := newTestTranslator()
+	require.NoError(t, LoadTranslations(tr2, strings.NewReader(string(data))))
+	require.Equal(t, tr, tr2)
+
+	data, err = yaml.Marshal(catalogues)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "- language: nl\n  tokens:\n")
+	tr3 := newTestTranslator()
+	require.NoError(t, LoadTranslations(tr3, strings.NewReader(string(data))))
+	require.Equal(t, tr, tr3)
+}
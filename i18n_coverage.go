@@ -0,0 +1,264 @@
+package valix
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Translatables is the messages, formats and tokens used in violation messages (i.e. the strings that
+// require translation) - as collected by CollectTranslatables
+type Translatables struct {
+	// Messages is the messages (see Translator.TranslateMessage)
+	Messages []string
+	// Formats is the message formats (see Translator.TranslateFormat)
+	Formats []string
+	// Tokens is the word tokens (see Translator.TranslateToken)
+	Tokens []string
+}
+
+// TranslatablesReporter is an optional interface that constraints can implement to report all the messages, formats
+// and tokens their violation messages use (see CollectTranslatables)
+type TranslatablesReporter interface {
+	// Translatables returns the messages, formats and tokens used by the constraint
+	Translatables() *Translatables
+}
+
+// MissingTranslations is the translatables that are missing translations for a language (or language and region) - as
+// reported by CheckTranslationCoverage
+type MissingTranslations struct {
+	// Language is the language checked (e.g. "fr")
+	Language string
+	// Region is the region checked (e.g. "CA") - empty if only the language was checked
+	Region string
+	// Messages is the messages without a translation
+	Messages []string
+	// Formats is the message formats without a translation
+	Formats []string
+	// Tokens is the word tokens without a translation
+	Tokens []string
+}
+
+// TranslationSourceLanguage is the language of the original (untranslated) messages, formats and tokens - translations
+// are never reported as missing for this language
+var TranslationSourceLanguage = langEn
+
+// CollectTranslatables collects all the messages, formats and tokens used by valix - the built-in messages, formats
+// and tokens, the default messages of all registered constraints (see RegisterConstraint) and the messages of all
+// registered presets (see RegisterPreset)
+//
+// Registered constraints that use more than one message or format (e.g. depending on their settings) should implement
+// TranslatablesReporter - otherwise only the message of the registered constraint (as registered) is collected
+//
+// Any additional messages (e.g. messages set on constraints in validators, or other custom messages) can be added to
+// the result before checking coverage with Translatables.CheckCoverage
+func CollectTranslatables() *Translatables {
+	rcx := &recordingI18nContext{
+		messages: map[string]bool{},
+		formats:  map[string]bool{},
+		tokens:   map[string]bool{},
+	}
+	for k := range internalMessages {
+		rcx.messages[k] = true
+	}
+	for k := range internalFormats {
+		rcx.formats[k] = true
+	}
+	for k := range internalTokens {
+		rcx.tokens[k] = true
+	}
+	for _, c := range constraintsRegistry.all() {
+		rcx.record(c)
+	}
+	for _, p := range presetsRegistry.all() {
+		if msg := p.GetMessage(); msg != "" {
+			rcx.messages[msg] = true
+		}
+	}
+	return &Translatables{
+		Messages: sortedKeys(rcx.messages),
+		Formats:  sortedKeys(rcx.formats),
+		Tokens:   sortedKeys(rcx.tokens),
+	}
+}
+
+// CheckTranslationCoverage checks which of the translatables used by valix (see CollectTranslatables) are missing
+// translations in the translator (if the translator is nil, DefaultTranslator is used) for each of the languages
+// (e.g. "fr" or "fr-CA") - if no languages are specified, SupportedLanguages is used
+//
+// Only languages (or languages and regions) with missing translations are returned
+func CheckTranslationCoverage(translator Translator, languages ...string) []*MissingTranslations {
+	return CollectTranslatables().CheckCoverage(translator, languages...)
+}
+
+// CheckCoverage checks which of the translatables are missing translations in the translator (if the translator is
+// nil, DefaultTranslator is used) for each of the languages (e.g. "fr" or "fr-CA") - if no languages are
+// specified, SupportedLanguages is used - only languages (or languages and regions) with missing translations are
+// returned
+//
+// Note: For the built-in translator, the translations are checked directly - for other translators, a translation
+// is assumed to be missing where it is the same as the TranslationSourceLanguage translation
+func (t *Translatables) CheckCoverage(translator Translator, languages ...string) []*MissingTranslations {
+	if translator == nil {
+		translator = DefaultTranslator
+	}
+	if len(languages) == 0 {
+		languages = SupportedLanguages()
+	}
+	result := make([]*MissingTranslations, 0)
+	for _, l := range languages {
+		lang, region := splitTranslationKey(normalizeLanguage(l))
+		if lang == TranslationSourceLanguage {
+			continue
+		}
+		missing := &MissingTranslations{
+			Language: lang,
+			Region:   region,
+			Messages: missingTranslations(t.Messages, func(s string) bool {
+				return hasTranslation(translator, translationKindMessage, lang, region, s)
+			}),
+			Formats: missingTranslations(t.Formats, func(s string) bool {
+				return hasTranslation(translator, translationKindFormat, lang, region, s)
+			}),
+			Tokens: missingTranslations(t.Tokens, func(s string) bool {
+				return hasTranslation(translator, translationKindToken, lang, region, s)
+			}),
+		}
+		if missing.Count() > 0 {
+			result = append(result, missing)
+		}
+	}
+	return result
+}
+
+// Count returns the total number of missing translations
+func (m *MissingTranslations) Count() int {
+	return len(m.Messages) + len(m.Formats) + len(m.Tokens)
+}
+
+// String implements fmt.Stringer
+func (m *MissingTranslations) String() string {
+	var sb strings.Builder
+	lang := m.Language
+	if m.Region != "" {
+		lang = lang + "-" + m.Region
+	}
+	sb.WriteString(fmt.Sprintf("%s: %d missing translations", lang, m.Count()))
+	write := func(kind string, strs []string) {
+		for _, s := range strs {
+			sb.WriteString(fmt.Sprintf("\n  %s: %q", kind, s))
+		}
+	}
+	write(translationKindMessage, m.Messages)
+	write(translationKindFormat, m.Formats)
+	write(translationKindToken, m.Tokens)
+	return sb.String()
+}
+
+const (
+	translationKindMessage = "message"
+	translationKindFormat  = "format"
+	translationKindToken   = "token"
+)
+
+func missingTranslations(strs []string, has func(s string) bool) []string {
+	result := make([]string, 0)
+	for _, s := range strs {
+		if !has(s) {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func hasTranslation(translator Translator, kind string, lang string, region string, str string) bool {
+	if it, ok := translator.(*internalTranslator); ok {
+		trs := it.Messages
+		switch kind {
+		case translationKindFormat:
+			trs = it.Formats
+		case translationKindToken:
+			trs = it.Tokens
+		}
+		if ts, ok := trs[str]; ok {
+			if _, ok = ts[lang]; ok {
+				return true
+			}
+			_, ok = ts[lang+"-"+region]
+			return ok && region != ""
+		}
+		return false
+	}
+	translate := translator.TranslateMessage
+	switch kind {
+	case translationKindFormat:
+		translate = func(lang string, region string, format string) string {
+			return translator.TranslateFormat(lang, region, format)
+		}
+	case translationKindToken:
+		translate = translator.TranslateToken
+	}
+	return translate(lang, region, str) != translate(TranslationSourceLanguage, "", str)
+}
+
+func sortedKeys(m map[string]bool) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		if k != "" {
+			result = append(result, k)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// recordingI18nContext is an I18nContext that records the messages, formats and tokens requested
+type recordingI18nContext struct {
+	messages map[string]bool
+	formats  map[string]bool
+	tokens   map[string]bool
+}
+
+func (r *recordingI18nContext) record(c Constraint) {
+	defer func() {
+		// constraints are not expected to be called with zero values - so ignore any that panic...
+		_ = recover()
+	}()
+	if tr, ok := c.(TranslatablesReporter); ok {
+		if ts := tr.Translatables(); ts != nil {
+			r.add(r.messages, ts.Messages)
+			r.add(r.formats, ts.Formats)
+			r.add(r.tokens, ts.Tokens)
+		}
+	}
+	_ = c.GetMessage(r)
+}
+
+func (r *recordingI18nContext) add(m map[string]bool, strs []string) {
+	for _, s := range strs {
+		m[s] = true
+	}
+}
+
+func (r *recordingI18nContext) TranslateMessage(msg string) string {
+	r.messages[msg] = true
+	return msg
+}
+
+func (r *recordingI18nContext) TranslateFormat(format string, a ...interface{}) string {
+	r.formats[format] = true
+	return format
+}
+
+func (r *recordingI18nContext) TranslateToken(token string) string {
+	r.tokens[token] = true
+	return token
+}
+
+func (r *recordingI18nContext) Language() string {
+	return TranslationSourceLanguage
+}
+
+func (r *recordingI18nContext) Region() string {
+	return ""
+}
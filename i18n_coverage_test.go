@@ -0,0 +1,136 @@
+package valix
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectTranslatables(t *testing.T) {
+	tr := CollectTranslatables()
+	require.Contains(t, tr.Messages, msgMissingProperty)
+	require.Contains(t, tr.Messages, msgPresetISSN13)
+	require.Contains(t, tr.Formats, fmtMsgStringMinLen)
+	require.Contains(t, tr.Formats, fmtUnitDay)
+	require.Contains(t, tr.Tokens, tokenInclusive)
+	require.NotContains(t, tr.Messages, "")
+}
+
+func TestCollectTranslatables_RegisteredConstraintsAndPresets(t *testing.T) {
+	defer func() {
+		ConstraintsRegistryReset()
+		presetsRegistry.reset()
+	}()
+	RegisterNamedConstraint("testCustomMessage", &StringNotEmpty{Message: "Custom message not translated"})
+	RegisterNamedConstraint("testCustomConstraint", NewCustomConstraint(func(value interface{}, vcx *ValidatorContext, cc *CustomConstraint) (bool, string) {
+		return true, ""
+	}, "Custom constraint message"))
+	RegisterPresetPattern("testPreset", regexp.MustCompile("^[a-z]$"), "Custom preset message", nil, false)
+
+	tr := CollectTranslatables()
+	require.Contains(t, tr.Messages, "Custom message not translated")
+	require.Contains(t, tr.Messages, "Custom constraint message")
+	require.Contains(t, tr.Messages, "Custom preset message")
+
+	missing := CheckTranslationCoverage(nil, "fr")
+	require.Equal(t, 1, len(missing))
+	require.Equal(t, "fr", missing[0].Language)
+	require.Equal(t, 3, missing[0].Count())
+	require.Equal(t, []string{"Custom constraint message", "Custom message not translated", "Custom preset message"}, missing[0].Messages)
+}
+
+func TestCheckTranslationCoverage_BuiltIns(t *testing.T) {
+	missing := CheckTranslationCoverage(nil)
+	for _, m := range missing {
+		t.Error(m.String())
+	}
+	require.Equal(t, 0, len(missing))
+}
+
+func TestCheckCoverage(t *testing.T) {
+	defer func() {
+		delete(defaultInternalTranslator.Messages, "Foo")
+	}()
+	tr := &Translatables{
+		Messages: []string{"Foo"},
+		Formats:  []string{fmtMsgStringMinLen, "Bar %d"},
+		Tokens:   []string{"year", "fortnight"},
+	}
+	missing := tr.CheckCoverage(nil, "fr", "fr-CA", "en", "en-GB")
+	require.Equal(t, 2, len(missing))
+	require.Equal(t, "fr", missing[0].Language)
+	require.Equal(t, "", missing[0].Region)
+	require.Equal(t, "fr", missing[1].Language)
+	require.Equal(t, "CA", missing[1].Region)
+	require.Equal(t, []string{"Foo"}, missing[0].Messages)
+	require.Equal(t, []string{"Bar %d"}, missing[0].Formats)
+	require.Equal(t, []string{"fortnight"}, missing[0].Tokens)
+	require.Equal(t, 3, missing[0].Count())
+	require.Equal(t, "fr-CA: 3 missing translations\n  message: \"Foo\"\n  format: \"Bar %d\"\n  token: \"fortnight\"", missing[1].String())
+
+	defaultInternalTranslator.Messages["Foo"] = map[string]string{"fr-CA": "Fou"}
+	missing = tr.CheckCoverage(nil, "fr", "fr-CA")
+	require.Equal(t, []string{"Foo"}, missing[0].Messages)
+	require.Equal(t, []string{}, missing[1].Messages)
+}
+
+type testCoverageTranslator struct {
+	Translator
+}
+
+func (t *testCoverageTranslator) TranslateMessage(lang string, region string, message string) string {
+	if lang == "fr" && message == "Foo" {
+		return "Fou"
+	}
+	return message
+}
+
+func TestCheckCoverage_OtherTranslator(t *testing.T) {
+	tr := &Translatables{
+		Messages: []string{"Foo", "Bar"},
+		Formats:  []string{fmtMsgStringMinLen},
+		Tokens:   []string{"year"},
+	}
+	missing := tr.CheckCoverage(&testCoverageTranslator{Translator: defaultInternalTranslator}, "fr", "de")
+	require.Equal(t, 2, len(missing))
+	require.Equal(t, []string{"Bar"}, missing[0].Messages)
+	require.Equal(t, []string{}, missing[0].Formats)
+	require.Equal(t, []string{}, missing[0].Tokens)
+	require.Equal(t, []string{"Foo", "Bar"}, missing[1].Messages)
+}
+
+type testReportingConstraint struct {
+	Strict bool
+}
+
+func (c *testReportingConstraint) Check(v interface{}, vcx *ValidatorContext) (bool, string) {
+	return true, ""
+}
+
+func (c *testReportingConstraint) GetMessage(tcx I18nContext) string {
+	if c.Strict {
+		return tcx.TranslateFormat("Value must strictly be %[1]s", tcx.TranslateToken("testStrictToken"))
+	}
+	return tcx.TranslateMessage("Value must be lenient")
+}
+
+func (c *testReportingConstraint) Translatables() *Translatables {
+	return &Translatables{
+		Messages: []string{"Value must be lenient"},
+		Formats:  []string{"Value must strictly be %[1]s"},
+		Tokens:   []string{"testStrictToken"},
+	}
+}
+
+func TestCollectTranslatables_TranslatablesReporter(t *testing.T) {
+	defer func() {
+		ConstraintsRegistryReset()
+	}()
+	RegisterNamedConstraint("testReporting", &testReportingConstraint{})
+
+	tr := CollectTranslatables()
+	require.Contains(t, tr.Messages, "Value must be lenient")
+	require.Contains(t, tr.Formats, "Value must strictly be %[1]s")
+	require.Contains(t, tr.Tokens, "testStrictToken")
+}
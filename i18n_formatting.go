@@ -0,0 +1,409 @@
+package valix
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// I18nFormatter is an optional interface that I18nContext implementations can implement to provide locale specific
+// formatting of numbers, datetimes and durations (and the plural category of numbers) used in messages
+//
+// Where an I18nContext does not implement this interface, the package functions FormatNumber, FormatDatetime,
+// FormatDuration and PluralCategory format according to the I18nContext.Language and I18nContext.Region
+type I18nFormatter interface {
+	// FormatNumber formats a number (any int, uint or float type or json.Number) for the locale
+	FormatNumber(n interface{}) string
+	// FormatDatetime formats a date/time for the locale
+	FormatDatetime(dt time.Time) string
+	// FormatDate formats a date (without time) for the locale
+	FormatDate(dt time.Time) string
+	// FormatTime formats a time (without date) for the locale
+	FormatTime(dt time.Time) string
+	// FormatDuration formats a duration for the locale (e.g. "1 hour 30 minutes")
+	FormatDuration(d time.Duration) string
+	// PluralCategory returns the CLDR plural category ("zero", "one", "two", "few", "many" or "other") of the
+	// number for the locale
+	PluralCategory(n interface{}) string
+}
+
+// DefaultDateLayouts is the date layouts (see time.Layout) used for formatting dates - keyed by language or
+// language and region (e.g. "en-GB")
+//
+// Where the language (or language and region) is not present, the layout for "" is used
+var DefaultDateLayouts = map[string]string{
+	"":      "2006-01-02",
+	langEn:  "Jan 2, 2006",
+	"en-GB": "2 Jan 2006",
+	langDe:  "02.01.2006",
+	langEs:  "2/1/2006",
+	langFr:  "02/01/2006",
+	langIt:  "02/01/2006",
+	langJa:  "2006/01/02",
+	langNl:  "2-1-2006",
+	langPl:  "2.01.2006",
+	langPt:  "02/01/2006",
+	langZh:  "2006/1/2",
+}
+
+// DefaultTimeLayouts is the time layouts (see time.Layout) used for formatting the time part of datetimes - keyed
+// by language or language and region (e.g. "en-GB")
+//
+// Where the language (or language and region) is not present, the layout for "" is used
+var DefaultTimeLayouts = map[string]string{
+	"":      "15:04:05",
+	langEn:  "3:04:05 PM",
+	"en-GB": "15:04:05",
+}
+
+// plural categories...
+const (
+	pluralZero  = "zero"
+	pluralOne   = "one"
+	pluralTwo   = "two"
+	pluralFew   = "few"
+	pluralMany  = "many"
+	pluralOther = "other"
+)
+
+// formats for duration units...
+const (
+	fmtUnitDay         = "{1, plural, one{# day} other{# days}}"
+	fmtUnitHour        = "{1, plural, one{# hour} other{# hours}}"
+	fmtUnitMinute      = "{1, plural, one{# minute} other{# minutes}}"
+	fmtUnitSecond      = "{1, plural, one{# second} other{# seconds}}"
+	fmtUnitMillisecond = "{1, plural, one{# millisecond} other{# milliseconds}}"
+	fmtUnitMicrosecond = "{1, plural, one{# microsecond} other{# microseconds}}"
+	fmtUnitNanosecond  = "{1, plural, one{# nanosecond} other{# nanoseconds}}"
+	fmtUnitWeek        = "{1, plural, one{# week} other{# weeks}}"
+	fmtUnitMonth       = "{1, plural, one{# month} other{# months}}"
+	fmtUnitYear        = "{1, plural, one{# year} other{# years}}"
+	fmtUnitDecade      = "{1, plural, one{# decade} other{# decades}}"
+	fmtUnitCentury     = "{1, plural, one{# century} other{# centuries}}"
+	fmtUnitMillennium  = "{1, plural, one{# millennium} other{# millennia}}"
+)
+
+// unitFormats is the formats for (translatable) units - keyed by unit token
+var unitFormats = map[string]string{
+	"millennium":  fmtUnitMillennium,
+	"century":     fmtUnitCentury,
+	"decade":      fmtUnitDecade,
+	"year":        fmtUnitYear,
+	"month":       fmtUnitMonth,
+	"week":        fmtUnitWeek,
+	"day":         fmtUnitDay,
+	"hour":        fmtUnitHour,
+	"minute":      fmtUnitMinute,
+	"second":      fmtUnitSecond,
+	"millisecond": fmtUnitMillisecond,
+	"microsecond": fmtUnitMicrosecond,
+	"nanosecond":  fmtUnitNanosecond,
+}
+
+// unitsByFormat is the reverse of unitFormats
+var unitsByFormat = func() map[string]string {
+	result := make(map[string]string, len(unitFormats))
+	for unit, f := range unitFormats {
+		result[f] = unit
+	}
+	return result
+}()
+
+// FormatNumber formats a number for the locale of the I18nContext (if the I18nContext implements I18nFormatter,
+// formatting is delegated to it)
+func FormatNumber(tcx I18nContext, n interface{}) string {
+	return formatterFor(tcx).FormatNumber(n)
+}
+
+// FormatDatetime formats a date/time for the locale of the I18nContext (if the I18nContext implements I18nFormatter,
+// formatting is delegated to it)
+func FormatDatetime(tcx I18nContext, dt time.Time) string {
+	return formatterFor(tcx).FormatDatetime(dt)
+}
+
+// FormatDate formats a date (without time) for the locale of the I18nContext (if the I18nContext implements
+// I18nFormatter, formatting is delegated to it)
+func FormatDate(tcx I18nContext, dt time.Time) string {
+	return formatterFor(tcx).FormatDate(dt)
+}
+
+// FormatTime formats a time (without date) for the locale of the I18nContext (if the I18nContext implements
+// I18nFormatter, formatting is delegated to it)
+func FormatTime(tcx I18nContext, dt time.Time) string {
+	return formatterFor(tcx).FormatTime(dt)
+}
+
+// FormatDuration formats a duration for the locale of the I18nContext (if the I18nContext implements I18nFormatter,
+// formatting is delegated to it)
+func FormatDuration(tcx I18nContext, d time.Duration) string {
+	return formatterFor(tcx).FormatDuration(d)
+}
+
+// FormatUnits formats an amount of units (e.g. 2 "day" gives "2 days") for the locale of the I18nContext
+//
+// The unit must be one of "millennium", "century", "decade", "year", "month", "week", "day", "hour", "minute",
+// "second", "millisecond", "microsecond" or "nanosecond"
+func FormatUnits(tcx I18nContext, amount int64, unit string) string {
+	useTcx := obtainI18nContext(tcx)
+	if f, ok := unitFormats[unit]; ok {
+		return useTcx.TranslateFormat(f, amount)
+	}
+	return FormatNumber(useTcx, amount) + " " + useTcx.TranslateToken(unit)
+}
+
+// PluralCategory returns the CLDR plural category ("zero", "one", "two", "few", "many" or "other") of the number for
+// the locale of the I18nContext (if the I18nContext implements I18nFormatter, it is delegated to it)
+func PluralCategory(tcx I18nContext, n interface{}) string {
+	return formatterFor(tcx).PluralCategory(n)
+}
+
+func formatterFor(tcx I18nContext) I18nFormatter {
+	useTcx := obtainI18nContext(tcx)
+	if f, ok := useTcx.(I18nFormatter); ok {
+		return f
+	}
+	return newLocaleFormatter(useTcx.Language(), useTcx.Region(), useTcx.TranslateFormat)
+}
+
+// localeFormatter is the default I18nFormatter
+type localeFormatter struct {
+	lang      string
+	region    string
+	tag       language.Tag
+	translate func(format string, a ...interface{}) string
+}
+
+func newLocaleFormatter(lang string, region string, translate func(format string, a ...interface{}) string) *localeFormatter {
+	locale := lang
+	if region != "" {
+		locale = lang + "-" + region
+	}
+	return &localeFormatter{
+		lang:      lang,
+		region:    region,
+		tag:       localeTag(locale, lang),
+		translate: translate,
+	}
+}
+
+var (
+	localeTags     sync.Map
+	localePrinters sync.Map
+)
+
+func localeTag(locale string, lang string) language.Tag {
+	if tag, ok := localeTags.Load(locale); ok {
+		return tag.(language.Tag)
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		tag, _ = language.Parse(lang)
+	}
+	localeTags.Store(locale, tag)
+	return tag
+}
+
+func (f *localeFormatter) printer() *message.Printer {
+	key := f.tag.String()
+	if p, ok := localePrinters.Load(key); ok {
+		return p.(*message.Printer)
+	}
+	p, _ := localePrinters.LoadOrStore(key, message.NewPrinter(f.tag))
+	return p.(*message.Printer)
+}
+
+// Sprintf formats according to the format and the locale (i.e. numbers are formatted for the locale)
+func (f *localeFormatter) Sprintf(format string, a ...interface{}) string {
+	return f.printer().Sprintf(format, a...)
+}
+
+// FormatNumber implements I18nFormatter.FormatNumber
+func (f *localeFormatter) FormatNumber(n interface{}) string {
+	if v, ok := numericValue(n); ok {
+		if fv, isFloat := v.(float64); isFloat && !math.IsInf(fv, 0) && !math.IsNaN(fv) {
+			// use the shortest exact representation of the fraction (avoiding exponent and float artifacts)...
+			digits := 0
+			if str := strconv.FormatFloat(fv, 'f', -1, 64); strings.Contains(str, ".") {
+				digits = len(str) - strings.IndexByte(str, '.') - 1
+			}
+			return f.printer().Sprint(number.Decimal(fv, number.MaxFractionDigits(digits)))
+		}
+		return f.printer().Sprint(v)
+	}
+	return fmt.Sprintf("%v", n)
+}
+
+// FormatDatetime implements I18nFormatter.FormatDatetime
+func (f *localeFormatter) FormatDatetime(dt time.Time) string {
+	return dt.Format(f.layout(DefaultDateLayouts) + " " + f.layout(DefaultTimeLayouts))
+}
+
+// FormatDate implements I18nFormatter.FormatDate
+func (f *localeFormatter) FormatDate(dt time.Time) string {
+	return dt.Format(f.layout(DefaultDateLayouts))
+}
+
+// FormatTime implements I18nFormatter.FormatTime
+func (f *localeFormatter) FormatTime(dt time.Time) string {
+	return dt.Format(f.layout(DefaultTimeLayouts))
+}
+
+func (f *localeFormatter) layout(layouts map[string]string) string {
+	if f.region != "" {
+		if l, ok := layouts[f.lang+"-"+f.region]; ok {
+			return l
+		}
+	}
+	if l, ok := layouts[f.lang]; ok {
+		return l
+	}
+	if base, _ := splitTranslationKey(f.lang); base != f.lang {
+		if l, ok := layouts[strings.Split(base, "-")[0]]; ok {
+			return l
+		}
+	}
+	if l, ok := layouts[strings.Split(f.lang, "-")[0]]; ok {
+		return l
+	}
+	return layouts[""]
+}
+
+// FormatDuration implements I18nFormatter.FormatDuration
+func (f *localeFormatter) FormatDuration(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+	parts := make([]string, 0)
+	add := func(amount int64, unitFmt string) {
+		if amount != 0 {
+			parts = append(parts, f.translate(unitFmt, amount))
+		}
+	}
+	if d >= time.Second {
+		add(int64(d/(24*time.Hour)), fmtUnitDay)
+		add(int64((d%(24*time.Hour))/time.Hour), fmtUnitHour)
+		add(int64((d%time.Hour)/time.Minute), fmtUnitMinute)
+		add(int64((d%time.Minute)/time.Second), fmtUnitSecond)
+		add(int64((d%time.Second)/time.Millisecond), fmtUnitMillisecond)
+	} else {
+		add(int64(d/time.Millisecond), fmtUnitMillisecond)
+		add(int64((d%time.Millisecond)/time.Microsecond), fmtUnitMicrosecond)
+		add(int64(d%time.Microsecond), fmtUnitNanosecond)
+	}
+	if len(parts) == 0 {
+		return f.translate(fmtUnitSecond, 0)
+	}
+	return strings.Join(parts, " ")
+}
+
+// PluralCategory implements I18nFormatter.PluralCategory
+func (f *localeFormatter) PluralCategory(n interface{}) string {
+	v, ok := numericValue(n)
+	if !ok {
+		return pluralOther
+	}
+	i, vd, w, fd, t, ok := pluralOperands(v)
+	if !ok {
+		return pluralOther
+	}
+	switch plural.Cardinal.MatchPlural(f.tag, i, vd, w, fd, t) {
+	case plural.Zero:
+		return pluralZero
+	case plural.One:
+		return pluralOne
+	case plural.Two:
+		return pluralTwo
+	case plural.Few:
+		return pluralFew
+	case plural.Many:
+		return pluralMany
+	}
+	return pluralOther
+}
+
+// numericValue returns the number as an int64, uint64 or float64 (ok is false if it is not a number)
+func numericValue(n interface{}) (interface{}, bool) {
+	switch v := n.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint:
+		return uint64(v), true
+	case uint8:
+		return uint64(v), true
+	case uint16:
+		return uint64(v), true
+	case uint32:
+		return uint64(v), true
+	case uint64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, true
+		} else if fv, err := v.Float64(); err == nil {
+			return fv, true
+		}
+	}
+	return nil, false
+}
+
+// pluralOperands returns the CLDR plural operands (see plural.MatchPlural) for a number - as returned by numericValue
+func pluralOperands(n interface{}) (i, v, w, f, t int, ok bool) {
+	var str string
+	switch nv := n.(type) {
+	case int64:
+		str = strconv.FormatInt(nv, 10)
+	case uint64:
+		str = strconv.FormatUint(nv, 10)
+	case float64:
+		if math.IsInf(nv, 0) || math.IsNaN(nv) {
+			return 0, 0, 0, 0, 0, false
+		}
+		str = strconv.FormatFloat(nv, 'f', -1, 64)
+	default:
+		return 0, 0, 0, 0, 0, false
+	}
+	str = strings.TrimPrefix(str, "-")
+	intPart, fracPart := str, ""
+	if dot := strings.IndexByte(str, '.'); dot != -1 {
+		intPart, fracPart = str[:dot], str[dot+1:]
+	}
+	var err error
+	if i, err = strconv.Atoi(intPart); err != nil {
+		// too big for plural rules - use only the last digits (the rules only depend on these)...
+		if i, err = strconv.Atoi(intPart[len(intPart)-6:]); err != nil {
+			return 0, 0, 0, 0, 0, false
+		}
+		i += 1000000
+	}
+	v = len(fracPart)
+	trimmed := strings.TrimRight(fracPart, "0")
+	w = len(trimmed)
+	if v > 0 {
+		f, _ = strconv.Atoi(fracPart)
+	}
+	if w > 0 {
+		t, _ = strconv.Atoi(trimmed)
+	}
+	return i, v, w, f, t, true
+}
@@ -0,0 +1,218 @@
+package valix
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatNumber(t *testing.T) {
+	testCases := []struct {
+		lang   string
+		region string
+		value  interface{}
+		expect string
+	}{
+		{"en", "", 1234567, "1,234,567"},
+		{"en", "", 1234.5, "1,234.5"},
+		{"en", "", 0.0000001, "0.0000001"},
+		{"en", "", json.Number("1234"), "1,234"},
+		{"en", "", json.Number("1234.25"), "1,234.25"},
+		{"en", "", uint8(12), "12"},
+		{"en", "", "not a number", "not a number"},
+		{"de", "", 1234567, "1.234.567"},
+		{"de", "", 1234.5, "1.234,5"},
+		{"de", "CH", 1234567, "1’234’567"},
+		{"fr", "", 1234.5, "1 234,5"},
+		{"ja", "", 1234567, "1,234,567"},
+	}
+	for _, tc := range testCases {
+		tcx := newDefaultI18nContext(tc.lang, tc.region)
+		require.Equal(t, tc.expect, FormatNumber(tcx, tc.value))
+	}
+}
+
+func TestPluralCategory(t *testing.T) {
+	testCases := []struct {
+		lang   string
+		value  interface{}
+		expect string
+	}{
+		{"en", 1, pluralOne},
+		{"en", 0, pluralOther},
+		{"en", 2, pluralOther},
+		{"en", 1.5, pluralOther},
+		{"fr", 0, pluralOne},
+		{"fr", 1, pluralOne},
+		{"fr", 2, pluralOther},
+		{"pl", 1, pluralOne},
+		{"pl", 2, pluralFew},
+		{"pl", 22, pluralFew},
+		{"pl", 102, pluralFew},
+		{"pl", 5, pluralMany},
+		{"pl", 12, pluralMany},
+		{"pl", 21, pluralMany},
+		{"pl", 1.5, pluralOther},
+		{"pl", json.Number("3"), pluralFew},
+		{"ja", 1, pluralOther},
+		{"en", "not a number", pluralOther},
+	}
+	for _, tc := range testCases {
+		tcx := newDefaultI18nContext(tc.lang, "")
+		require.Equal(t, tc.expect, PluralCategory(tcx, tc.value), "%s %v", tc.lang, tc.value)
+	}
+}
+
+func TestFormatDatetime(t *testing.T) {
+	dt := time.Date(2022, 4, 5, 18, 19, 20, 0, time.UTC)
+	testCases := []struct {
+		lang       string
+		region     string
+		expectDt   string
+		expectDate string
+		expectTime string
+	}{
+		{"en", "", "Apr 5, 2022 6:19:20 PM", "Apr 5, 2022", "6:19:20 PM"},
+		{"en", "GB", "5 Apr 2022 18:19:20", "5 Apr 2022", "18:19:20"},
+		{"en", "AU", "Apr 5, 2022 6:19:20 PM", "Apr 5, 2022", "6:19:20 PM"},
+		{"de", "", "05.04.2022 18:19:20", "05.04.2022", "18:19:20"},
+		{"fr", "CA", "05/04/2022 18:19:20", "05/04/2022", "18:19:20"},
+		{"ja", "", "2022/04/05 18:19:20", "2022/04/05", "18:19:20"},
+		{"pl", "", "5.04.2022 18:19:20", "5.04.2022", "18:19:20"},
+	}
+	for _, tc := range testCases {
+		tcx := newDefaultI18nContext(tc.lang, tc.region)
+		require.Equal(t, tc.expectDt, FormatDatetime(tcx, dt))
+		require.Equal(t, tc.expectDate, FormatDate(tcx, dt))
+		require.Equal(t, tc.expectTime, FormatTime(tcx, dt))
+	}
+}
+
+func TestFormatDatetime_DefaultLayouts(t *testing.T) {
+	defer func() {
+		delete(DefaultDateLayouts, "sv")
+	}()
+	tcx := newDefaultI18nContext("sv", "")
+	dt := time.Date(2022, 4, 5, 18, 19, 20, 0, time.UTC)
+	// unsupported language falls back to default language...
+	require.Equal(t, "Apr 5, 2022", FormatDate(tcx, dt))
+	f := newLocaleFormatter("sv", "", nil)
+	require.Equal(t, "2022-04-05 18:19:20", f.FormatDatetime(dt))
+	DefaultDateLayouts["sv"] = "2006-01-02"
+	require.Equal(t, "2022-04-05", f.FormatDate(dt))
+}
+
+func TestFormatDuration(t *testing.T) {
+	testCases := []struct {
+		lang     string
+		duration time.Duration
+		expect   string
+	}{
+		{"en", 90 * time.Minute, "1 hour 30 minutes"},
+		{"en", 49*time.Hour + time.Second, "2 days 1 hour 1 second"},
+		{"en", 1500 * time.Millisecond, "1 second 500 milliseconds"},
+		{"en", 1500 * time.Microsecond, "1 millisecond 500 microseconds"},
+		{"en", 1 * time.Nanosecond, "1 nanosecond"},
+		{"en", -2 * time.Minute, "2 minutes"},
+		{"en", 0, "0 seconds"},
+		{"fr", 90 * time.Minute, "1 heure 30 minutes"},
+		{"de", 26 * time.Hour, "1 Tag 2 Stunden"},
+		{"pl", 22 * time.Minute, "22 minuty"},
+		{"pl", 25 * time.Minute, "25 minut"},
+		{"ja", 90 * time.Minute, "1時間 30分"},
+	}
+	for _, tc := range testCases {
+		tcx := newDefaultI18nContext(tc.lang, "")
+		require.Equal(t, tc.expect, FormatDuration(tcx, tc.duration))
+	}
+}
+
+func TestFormatUnits(t *testing.T) {
+	testCases := []struct {
+		lang   string
+		amount int64
+		unit   string
+		expect string
+	}{
+		{"en", 1, "day", "1 day"},
+		{"en", 2, "day", "2 days"},
+		{"en", 2, "century", "2 centuries"},
+		{"en", 1000, "year", "1,000 years"},
+		{"fr", 2, "year", "2 ans"},
+		{"pl", 1, "year", "1 rok"},
+		{"pl", 3, "year", "3 lata"},
+		{"pl", 5, "year", "5 lat"},
+		{"zh", 3, "day", "3天"},
+		{"en", 3, "fortnight", "3 fortnight"},
+	}
+	for _, tc := range testCases {
+		tcx := newDefaultI18nContext(tc.lang, "")
+		require.Equal(t, tc.expect, FormatUnits(tcx, tc.amount, tc.unit))
+	}
+}
+
+type testFormatterI18nContext struct {
+	I18nContext
+}
+
+func (t *testFormatterI18nContext) FormatNumber(n interface{}) string {
+	return "NUMBER"
+}
+
+func (t *testFormatterI18nContext) FormatDatetime(dt time.Time) string {
+	return "DATETIME"
+}
+
+func (t *testFormatterI18nContext) FormatDate(dt time.Time) string {
+	return "DATE"
+}
+
+func (t *testFormatterI18nContext) FormatTime(dt time.Time) string {
+	return "TIME"
+}
+
+func (t *testFormatterI18nContext) FormatDuration(d time.Duration) string {
+	return "DURATION"
+}
+
+func (t *testFormatterI18nContext) PluralCategory(n interface{}) string {
+	return pluralMany
+}
+
+func TestI18nFormatter(t *testing.T) {
+	tcx := &testFormatterI18nContext{I18nContext: newDefaultI18nContext("en", "")}
+	require.Equal(t, "NUMBER", FormatNumber(tcx, 1))
+	require.Equal(t, "DATETIME", FormatDatetime(tcx, time.Now()))
+	require.Equal(t, "DATE", FormatDate(tcx, time.Now()))
+	require.Equal(t, "TIME", FormatTime(tcx, time.Now()))
+	require.Equal(t, "DURATION", FormatDuration(tcx, time.Second))
+	require.Equal(t, pluralMany, PluralCategory(tcx, 1))
+
+	msg := RenderMessageTemplate(tcx, "{n, plural, one{one} many{# many} other{other}} on {dt, date}", map[string]interface{}{
+		"n":  1,
+		"dt": "2022-04-05",
+	})
+	require.Equal(t, "NUMBER many on DATE", msg)
+}
+
+func TestPluralOperands(t *testing.T) {
+	testCases := []struct {
+		value         interface{}
+		i, v, w, f, t int
+	}{
+		{int64(1), 1, 0, 0, 0, 0},
+		{int64(-12), 12, 0, 0, 0, 0},
+		{uint64(3), 3, 0, 0, 0, 0},
+		{1.5, 1, 1, 1, 5, 5},
+		{1.25, 1, 2, 2, 25, 25},
+	}
+	for _, tc := range testCases {
+		i, v, w, f, tv, ok := pluralOperands(tc.value)
+		require.True(t, ok)
+		require.Equal(t, []int{tc.i, tc.v, tc.w, tc.f, tc.t}, []int{i, v, w, f, tv})
+	}
+	_, _, _, _, _, ok := pluralOperands("1")
+	require.False(t, ok)
+}
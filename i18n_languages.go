@@ -0,0 +1,228 @@
+package valix
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// LanguagesProvider is an optional interface that a Translator can implement to report the languages it has
+// translations for - the default I18n provider uses this to determine which languages are supported
+//
+// Where DefaultTranslator does not implement this interface, the languages of the built-in translations are used
+type LanguagesProvider interface {
+	// Languages returns the language codes (e.g. "en", "fr", "zh-Hant") that the translator has translations for
+	Languages() []string
+}
+
+// SupportedLanguages returns the languages supported by the default I18n provider (sorted) - i.e. the languages that
+// DefaultTranslator has translations for
+//
+// Requested languages (e.g. from an "Accept-Language" header) are matched against these languages using
+// BCP 47 matching (see language.Matcher) - so that, for example, a request for "zh-TW" will match "zh-Hant" and
+// a request for "gsw" (Swiss German) will match "de"
+//
+// Requested languages are never matched to a supported language written in a different script - for example, the
+// built-in Chinese translations ("zh") are Simplified, so a request for "zh-TW" or "zh-Hant" falls back to the default
+// language unless Traditional ("zh-Hant") translations have been added
+//
+// Note: the supported languages are cached - the cache is refreshed when DefaultTranslator is replaced or when
+// translations are added (using the translator's Add...Translation methods or TranslationCatalogue.AddTo)
+func SupportedLanguages() []string {
+	langs := supportedLanguagesCache.get().langs
+	result := make([]string, len(langs))
+	copy(result, langs)
+	return result
+}
+
+// Languages implements LanguagesProvider.Languages
+func (t *internalTranslator) Languages() []string {
+	langs := map[string]bool{}
+	for _, trs := range []map[string]map[string]string{t.Tokens, t.Messages, t.Formats} {
+		for _, ts := range trs {
+			for key := range ts {
+				if lang, _ := splitTranslationKey(key); lang != "" {
+					langs[lang] = true
+				}
+			}
+		}
+	}
+	result := make([]string, 0, len(langs))
+	for lang := range langs {
+		result = append(result, lang)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func isSupportedLanguage(lang string) bool {
+	return supportedLanguagesCache.get().set[lang]
+}
+
+// normalizeLanguage normalizes a language code - lower-case language, title-case script and upper-case region
+// (e.g. "zh-hant-tw" becomes "zh-Hant-TW")
+func normalizeLanguage(lang string) string {
+	if tag, err := language.Raw.Parse(lang); err == nil {
+		base, script, region := tag.Raw()
+		result := base.String()
+		if s := script.String(); s != "Zzzz" {
+			result = result + "-" + s
+		}
+		if r := region.String(); r != "ZZ" {
+			result = result + "-" + r
+		}
+		return result
+	}
+	return strings.ToLower(lang)
+}
+
+// splitTranslationKey splits a translation key (e.g. "en", "en-GB", "zh-Hant" or "zh-Hant-TW") into its
+// language (including any script) and region
+func splitTranslationKey(key string) (lang string, region string) {
+	parts := strings.Split(key, "-")
+	lang = parts[0]
+	rest := parts[1:]
+	if len(rest) > 0 && len(rest[0]) == 4 {
+		lang = lang + "-" + rest[0]
+		rest = rest[1:]
+	}
+	return lang, strings.Join(rest, "-")
+}
+
+// languageSet is the supported languages (and a matcher for them)
+type languageSet struct {
+	langs        []string
+	set          map[string]bool
+	matcherLangs []string
+	matcher      language.Matcher
+}
+
+func newLanguageSet(langs []string) *languageSet {
+	result := &languageSet{
+		langs:        append([]string(nil), langs...),
+		set:          make(map[string]bool, len(langs)),
+		matcherLangs: make([]string, 0, len(langs)),
+	}
+	sort.Strings(result.langs)
+	tags := make([]language.Tag, 0, len(langs))
+	for _, l := range langs {
+		result.set[l] = true
+		if tag, err := language.Raw.Parse(l); err == nil {
+			tags = append(tags, tag)
+			result.matcherLangs = append(result.matcherLangs, l)
+		}
+	}
+	result.matcher = language.NewMatcher(tags)
+	return result
+}
+
+// match returns the supported language that best matches the tag
+//
+// A supported language written in a different script to the (likely) script of the tag is not a match (e.g. "zh-TW",
+// which is written in Traditional script, does not match "zh", which is written in Simplified script)
+func (ls *languageSet) match(tag language.Tag) (string, bool) {
+	if len(ls.matcherLangs) > 0 {
+		if _, idx, conf := ls.matcher.Match(tag); conf != language.No && idx < len(ls.matcherLangs) &&
+			sameScript(tag, ls.matcherLangs[idx]) {
+			return ls.matcherLangs[idx], true
+		}
+	}
+	return "", false
+}
+
+func sameScript(tag language.Tag, lang string) bool {
+	supported, err := language.Raw.Parse(lang)
+	if err != nil {
+		return false
+	}
+	requestedScript, _ := tag.Script()
+	supportedScript, _ := supported.Script()
+	return requestedScript == supportedScript
+}
+
+// languageCache caches the supported languages of DefaultTranslator - so that they are not re-determined
+// (from all the translations) every time an I18nContext is created
+type languageCache struct {
+	mutex      sync.RWMutex
+	translator Translator
+	current    *languageSet
+	generation uint64
+}
+
+var supportedLanguagesCache = &languageCache{}
+
+func (lc *languageCache) get() *languageSet {
+	translator := DefaultTranslator
+	lc.mutex.RLock()
+	current, generation := lc.current, lc.generation
+	if current != nil && !sameTranslator(lc.translator, translator) {
+		current = nil
+	}
+	lc.mutex.RUnlock()
+	if current != nil {
+		return current
+	}
+	var langs []string
+	if lp, ok := translator.(LanguagesProvider); ok {
+		langs = lp.Languages()
+	} else {
+		langs = defaultInternalTranslator.Languages()
+	}
+	result := newLanguageSet(langs)
+	lc.mutex.Lock()
+	// only cache if not invalidated whilst the languages were being determined...
+	if lc.generation == generation {
+		lc.translator, lc.current = translator, result
+	}
+	lc.mutex.Unlock()
+	return result
+}
+
+func (lc *languageCache) invalidate() {
+	lc.mutex.Lock()
+	lc.current = nil
+	lc.generation++
+	lc.mutex.Unlock()
+}
+
+// invalidateSupportedLanguages invalidates the cached supported languages (called whenever translations are added)
+func invalidateSupportedLanguages() {
+	supportedLanguagesCache.invalidate()
+}
+
+// sameTranslator determines whether two translators are the same (without panicking on non-comparable translators)
+func sameTranslator(t1, t2 Translator) bool {
+	if ty := reflect.TypeOf(t1); ty != reflect.TypeOf(t2) || (ty != nil && !ty.Comparable()) {
+		return false
+	}
+	return t1 == t2
+}
+
+// matchLanguage returns the supported language that best matches the language code
+func matchLanguage(lang string) (string, bool) {
+	if tag, err := language.Parse(lang); err == nil {
+		return supportedLanguagesCache.get().match(tag)
+	}
+	return "", false
+}
+
+// matchAcceptLanguage returns the supported language (and requested region, if any) that best matches an
+// "Accept-Language" (or "Content-Language") header value - requested languages are tried in order of
+// their quality weighting
+func matchAcceptLanguage(header string) (lang string, region string, ok bool) {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err == nil {
+		for _, tag := range tags {
+			if lang, ok = supportedLanguagesCache.get().match(tag); ok {
+				if _, _, rgn := tag.Raw(); rgn.String() != "ZZ" {
+					region = rgn.String()
+				}
+				return
+			}
+		}
+	}
+	return "", "", false
+}
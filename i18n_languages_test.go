@@ -0,0 +1,260 @@
+package valix
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportedLanguages(t *testing.T) {
+	langs := SupportedLanguages()
+	require.Equal(t, []string{"de", "en", "es", "fr", "it", "ja", "nl", "pl", "pt", "zh"}, langs)
+}
+
+func TestSupportedLanguages_AddedLanguage(t *testing.T) {
+	defer func() {
+		delete(defaultInternalTranslator.Messages[msgMissingProperty], "sv")
+	}()
+	require.False(t, isSupportedLanguage("sv"))
+	err := LoadTranslations(nil, strings.NewReader(`{"language": "SV", "messages": {"Missing property": "Egenskap saknas"}}`))
+	require.NoError(t, err)
+	require.True(t, isSupportedLanguage("sv"))
+
+	tcx := newDefaultI18nContext("sv", "")
+	require.Equal(t, "sv", tcx.Language())
+	require.Equal(t, "Egenskap saknas", tcx.TranslateMessage(msgMissingProperty))
+	// non-translated falls back to original...
+	require.Equal(t, msgUnknownProperty, tcx.TranslateMessage(msgUnknownProperty))
+
+	r, _ := http.NewRequest("GET", "example.com", nil)
+	r.Header.Set("Accept-Language", "sv-FI, fr;q=0.5")
+	tcx = DefaultI18nProvider.ContextFromRequest(r)
+	require.Equal(t, "sv", tcx.Language())
+	require.Equal(t, "FI", tcx.Region())
+}
+
+func TestSupportedLanguages_ScriptMismatchFallsBackToDefault(t *testing.T) {
+	testCases := []struct {
+		header  string
+		lang    string
+		message string
+	}{
+		{"zh-TW", DefaultLanguage, msgMissingProperty},
+		{"zh-HK", DefaultLanguage, msgMissingProperty},
+		{"zh-Hant", DefaultLanguage, msgMissingProperty},
+		{"zh-Hant, fr;q=0.5", "fr", newDefaultI18nContext("fr", "").TranslateMessage(msgMissingProperty)},
+		{"zh-CN", "zh", "缺少属性"},
+		{"zh-Hans", "zh", "缺少属性"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.header, func(t *testing.T) {
+			r, _ := http.NewRequest("GET", "example.com", nil)
+			r.Header.Set("Accept-Language", tc.header)
+			tcx := DefaultI18nProvider.ContextFromRequest(r)
+			require.Equal(t, tc.lang, tcx.Language())
+			require.Equal(t, tc.message, tcx.TranslateMessage(msgMissingProperty))
+		})
+	}
+	_, ok := matchLanguage("zh-Hant")
+	require.False(t, ok)
+}
+
+func TestSupportedLanguages_ScriptSubtags(t *testing.T) {
+	defer func() {
+		delete(defaultInternalTranslator.Messages[msgMissingProperty], "zh-Hant")
+		delete(defaultInternalTranslator.Messages[msgMissingProperty], "zh-Hant-HK")
+	}()
+	err := LoadTranslations(nil, strings.NewReader(`
+language: zh-hant
+messages:
+  Missing property: 缺少屬性
+regions:
+  hk:
+    messages:
+      Missing property: 欠缺屬性
+`))
+	require.NoError(t, err)
+	require.True(t, isSupportedLanguage("zh-Hant"))
+	require.Contains(t, SupportedLanguages(), "zh-Hant")
+
+	testCases := []struct {
+		header  string
+		lang    string
+		region  string
+		message string
+	}{
+		{"zh-TW", "zh-Hant", "TW", "缺少屬性"},
+		{"zh-HK", "zh-Hant", "HK", "欠缺屬性"},
+		{"zh-Hant", "zh-Hant", "", "缺少屬性"},
+		{"zh-CN", "zh", "CN", "缺少属性"},
+		{"zh-Hans", "zh", "", "缺少属性"},
+		{"zh", "zh", "", "缺少属性"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.header, func(t *testing.T) {
+			r, _ := http.NewRequest("GET", "example.com", nil)
+			r.Header.Set("Accept-Language", tc.header)
+			tcx := DefaultI18nProvider.ContextFromRequest(r)
+			require.Equal(t, tc.lang, tcx.Language())
+			require.Equal(t, tc.region, tcx.Region())
+			require.Equal(t, tc.message, tcx.TranslateMessage(msgMissingProperty))
+		})
+	}
+}
+
+type testLanguagesTranslator struct {
+	Translator
+	langs []string
+}
+
+func (t *testLanguagesTranslator) Languages() []string {
+	return t.langs
+}
+
+func TestSupportedLanguages_LanguagesProvider(t *testing.T) {
+	defer func() {
+		DefaultTranslator = defaultInternalTranslator
+	}()
+	tr := &testLanguagesTranslator{Translator: defaultInternalTranslator, langs: []string{"fr", "en"}}
+	DefaultTranslator = tr
+	require.Equal(t, []string{"en", "fr"}, SupportedLanguages())
+	require.Equal(t, "en", defaultLanguage("de"))
+	require.Equal(t, "fr", defaultLanguage("fr-CA"))
+
+	r, _ := http.NewRequest("GET", "example.com", nil)
+	r.Header.Set("Accept-Language", "de, fr-CA;q=0.5")
+	tcx := DefaultI18nProvider.ContextFromRequest(r)
+	require.Equal(t, "fr", tcx.Language())
+	require.Equal(t, "CA", tcx.Region())
+
+	// supported languages are cached - until the translator is replaced...
+	tr.langs = []string{"de", "en"}
+	tcx = DefaultI18nProvider.ContextFromRequest(r)
+	require.Equal(t, "fr", tcx.Language())
+	DefaultTranslator = &testLanguagesTranslator{Translator: defaultInternalTranslator, langs: []string{"de", "en"}}
+	tcx = DefaultI18nProvider.ContextFromRequest(r)
+	require.Equal(t, "de", tcx.Language())
+	require.Equal(t, "", tcx.Region())
+
+	// translator without languages provider uses built-in languages...
+	DefaultTranslator = struct{ Translator }{defaultInternalTranslator}
+	require.Equal(t, defaultInternalTranslator.Languages(), SupportedLanguages())
+
+	// no languages...
+	DefaultTranslator = &testLanguagesTranslator{Translator: defaultInternalTranslator}
+	_, ok := matchLanguage("fr")
+	require.False(t, ok)
+	require.Equal(t, "en", defaultLanguage("fr"))
+}
+
+func TestDefaultLanguage_Matching(t *testing.T) {
+	defer func() {
+		DefaultFallbackLanguages = map[string]string{}
+	}()
+	require.Equal(t, "nl", defaultLanguage("NL"))
+	require.Equal(t, "pt", defaultLanguage("pt-BR"))
+	require.Equal(t, "de", defaultLanguage("gsw"))
+	require.Equal(t, "en", defaultLanguage("da"))
+	DefaultFallbackLanguages["gsw"] = "fr"
+	require.Equal(t, "fr", defaultLanguage("gsw"))
+}
+
+func TestNormalizeLanguage(t *testing.T) {
+	testCases := map[string]string{
+		"EN":         "en",
+		"en-us":      "en-US",
+		"zh-hant":    "zh-Hant",
+		"ZH-HANT-TW": "zh-Hant-TW",
+		"":           "",
+		"Not Valid":  "not valid",
+	}
+	for lang, expect := range testCases {
+		require.Equal(t, expect, normalizeLanguage(lang))
+	}
+}
+
+func TestSplitTranslationKey(t *testing.T) {
+	testCases := []struct {
+		key    string
+		lang   string
+		region string
+	}{
+		{"en", "en", ""},
+		{"en-GB", "en", "GB"},
+		{"es-419", "es", "419"},
+		{"zh-Hant", "zh-Hant", ""},
+		{"zh-Hant-TW", "zh-Hant", "TW"},
+	}
+	for _, tc := range testCases {
+		lang, rgn := splitTranslationKey(tc.key)
+		require.Equal(t, tc.lang, lang)
+		require.Equal(t, tc.region, rgn)
+	}
+}
+
+func TestBuiltInLanguagesTranslated(t *testing.T) {
+	validator := &Validator{
+		Properties: Properties{
+			"foo": {
+				Mandatory: true,
+			},
+		},
+	}
+	testCases := map[string]string{
+		"nl":    "Ontbrekende eigenschap",
+		"pt-BR": "Propriedade ausente",
+		"pl":    "Brakująca właściwość",
+		"ja":    "プロパティがありません",
+		"zh-CN": "缺少属性",
+	}
+	for lang, expect := range testCases {
+		t.Run(lang, func(t *testing.T) {
+			r, _ := http.NewRequest("POST", "example.com", strings.NewReader(`{}`))
+			r.Header.Set("Accept-Language", lang)
+			ok, violations, _ := validator.RequestValidate(r)
+			require.False(t, ok)
+			require.Equal(t, 1, len(violations))
+			require.Equal(t, expect, violations[0].Message)
+		})
+	}
+}
+
+func TestSupportedLanguages_Cached(t *testing.T) {
+	defer func() {
+		DefaultTranslator = defaultInternalTranslator
+		invalidateSupportedLanguages()
+	}()
+	tr := &testLanguagesTranslator{Translator: defaultInternalTranslator, langs: []string{"fr", "en"}}
+	DefaultTranslator = tr
+	set := supportedLanguagesCache.get()
+	require.Same(t, set, supportedLanguagesCache.get())
+	langs := SupportedLanguages()
+	langs[0] = "xx"
+	require.Equal(t, []string{"en", "fr"}, SupportedLanguages())
+
+	// adding translations (via a catalogue) invalidates the cache...
+	tr.langs = []string{"en", "fr", "sv"}
+	require.False(t, isSupportedLanguage("sv"))
+	(&TranslationCatalogue{Language: "sv"}).AddTo(tr)
+	require.NotSame(t, set, supportedLanguagesCache.get())
+	require.True(t, isSupportedLanguage("sv"))
+
+	// adding translations to the internal translator invalidates the cache...
+	DefaultTranslator = defaultInternalTranslator
+	set = supportedLanguagesCache.get()
+	other := &internalTranslator{Tokens: map[string]map[string]string{}, Messages: map[string]map[string]string{}, Formats: map[string]map[string]string{}}
+	other.AddMessageRegionTranslation("en", "GB", "foo", "bar")
+	require.NotSame(t, set, supportedLanguagesCache.get())
+
+	// non-comparable translators are never seen as the same...
+	require.False(t, sameTranslator(testNonComparableTranslator{}, testNonComparableTranslator{}))
+	require.True(t, sameTranslator(defaultInternalTranslator, defaultInternalTranslator))
+	require.False(t, sameTranslator(nil, defaultInternalTranslator))
+}
+
+type testNonComparableTranslator struct {
+	Translator
+	langs []string
+}
@@ -0,0 +1,409 @@
+package valix
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Message formats
+//
+// Translated formats (see Translator.TranslateFormat) and message templates (see RenderMessageTemplate) may contain
+// ICU MessageFormat style arguments - where the argument name is the 1-based argument index for formats
+// (e.g. "{1, plural, one{# character} other{# characters}}") or the placeholder name for message
+// templates (e.g. "{maximum, plural, one{# character} other{# characters}}")
+//
+// The supported argument types are:
+//   {arg, plural, =0{...} one{...} other{...}} - selects the text by exact value ("=n") or by the CLDR plural category
+//                                                 ("zero", "one", "two", "few", "many" or "other") of the number for
+//                                                 the locale - any "#" in the selected text is replaced by the
+//                                                 locale formatted number
+//   {arg, number}                              - the locale formatted number
+//   {arg, date}                                - the locale formatted date (of a time.Time or ISO-8601 string)
+//   {arg, datetime}                            - the locale formatted date and time (of a time.Time or ISO-8601 string)
+//   {arg, time}                                - the locale formatted time (of a time.Time or ISO-8601 string)
+//   {arg, duration}                            - the locale formatted duration (of a time.Duration)
+//
+// Arguments that are not recognised (or whose value is not available) are left as-is
+
+// FormatMessage formats an (already translated) format for the language and region - expanding any ICU MessageFormat
+// style arguments and formatting numbers for the locale
+//
+// The translator (if nil, DefaultTranslator is used) is used to translate the units of any durations.  Custom
+// Translator implementations can use this to format translated formats in their TranslateFormat
+func FormatMessage(translator Translator, lang string, region string, format string, a ...interface{}) string {
+	if translator == nil {
+		translator = DefaultTranslator
+	}
+	f := newLocaleFormatter(lang, region, func(format string, a ...interface{}) string {
+		return translateFormat(translator, lang, region, format, a...)
+	})
+	return f.Sprintf(expandMessageFormat(f, format, formatArgsLookup(a), escapeFormatPercent), a...)
+}
+
+// MessageFormatTranslator is an optional interface that a Translator can implement to indicate whether its
+// TranslateFormat supports the ICU MessageFormat style arguments used by the built-in formats (e.g. by using
+// FormatMessage to format translated formats)
+//
+// Translators that do not implement this interface (e.g. custom translators that just use fmt.Sprintf) are passed
+// the equivalent printf style formats that were used prior to plural aware formats (e.g. "String value length
+// must not exceed %[1]d characters" rather than "String value length must not exceed {1, plural, one{# character}
+// other{# characters}}") - so that existing custom translators, and translations keyed by those formats, continue
+// to work.  The built-in translator also accepts translations added for these printf style formats.
+//
+// The datetime tolerance formats (e.g. "Value must not be more than %[1]s after now") take the already formatted
+// amount and units (e.g. "3 days") as a single argument - translators that do not implement this interface are
+// passed the previous formats (e.g. "Value must not be more than %[1]d %[2]s after now") with the amount and the
+// translated unit token as separate arguments.  The built-in translator also uses translations added for these
+// previous formats (where there is no translation of the current format for the language).
+type MessageFormatTranslator interface {
+	SupportsMessageFormat() bool
+}
+
+// legacyFormats is the printf style equivalents of built-in formats that use ICU MessageFormat style arguments
+var legacyFormats = map[string]string{
+	fmtMsgStringMinLen:              "String value length must be at least %[1]d characters",
+	fmtMsgStringMinLenExc:           "String value length must be greater than %[1]d characters",
+	fmtMsgStringMaxLen:              "String value length must not exceed %[1]d characters",
+	fmtMsgStringMaxLenExc:           "String value length must be less than %[1]d characters",
+	fmtMsgStringExactLen:            "String value length must be %[1]d characters",
+	fmtMsgDtAgeMin:                  "Age must be over %[1]d years old",
+	fmtMsgDtAgeMinOrOver:            "Age must be %[1]d years old or over",
+	fmtMsgDtAgeMax:                  "Age must be under %[1]d years old",
+	fmtMsgDtAgeMaxOrUnder:           "Age must be %[1]d years old or under",
+	fmtMsgDtAgeMinExcMaxExc:         "Age must be over %[1]d years old and under %[2]d years old",
+	fmtMsgDtAgeMinMax:               "Age must be between %[1]d years old and %[2]d years old",
+	fmtMsgDtAgeMinMaxExc:            "Age must be %[1]d years old or over and under %[2]d years old",
+	fmtMsgDtAgeMinExcMax:            "Age must be between over %[1]d years old and %[2]d years old or under",
+	fmtMsgConstraintSetDefaultAllOf: "Constraint set must pass all of %[1]d undisclosed validations",
+	fmtMsgConstraintSetDefaultOneOf: "Constraint set must pass one of %[1]d undisclosed validations",
+	fmtMsgStringMinMaxLen:           "String value length must be between %[1]d (%[2]s) and %[3]d (%[4]s)",
+	fmtMsgMinLen:                    "Value length must be at least %[1]d",
+	fmtMsgMinLenExc:                 "Value length must be greater than %[1]d",
+	fmtMsgExactLen:                  "Value length must be %[1]d",
+	fmtMsgMinMax:                    "Value length must be between %[1]d (%[2]s) and %[3]d (%[4]s)",
+	fmtMsgMultipleOf:                "Value must be a multiple of %[1]d",
+}
+
+// legacyUnitsFormats is the previous (printf style) formats of the datetime tolerance formats - which took the amount
+// and the (translated) unit token as separate arguments, rather than the formatted units (see unitsAmount)
+var legacyUnitsFormats = map[string]string{
+	fmtMsgDtToleranceFixedMaxAfter:  "Value must not be more than %[1]d %[2]s after %[3]s",
+	fmtMsgDtToleranceFixedMaxBefore: "Value must not be more than %[1]d %[2]s before %[3]s",
+	fmtMsgDtToleranceFixedMinAfter:  "Value must be at least %[1]d %[2]s after %[3]s",
+	fmtMsgDtToleranceFixedMinBefore: "Value must be at least %[1]d %[2]s before %[3]s",
+	fmtMsgDtToleranceNowMaxAfter:    "Value must not be more than %[1]d %[2]s after now",
+	fmtMsgDtToleranceNowMaxBefore:   "Value must not be more than %[1]d %[2]s before now",
+	fmtMsgDtToleranceNowMinAfter:    "Value must be at least %[1]d %[2]s after now",
+	fmtMsgDtToleranceNowMinBefore:   "Value must be at least %[1]d %[2]s before now",
+	fmtMsgDtToleranceOtherMaxAfter:  "Value must not be more than %[1]d %[2]s after value of property '%[3]s'",
+	fmtMsgDtToleranceOtherMaxBefore: "Value must not be more than %[1]d %[2]s before value of property '%[3]s'",
+	fmtMsgDtToleranceOtherMinAfter:  "Value must be at least %[1]d %[2]s after value of property '%[3]s'",
+	fmtMsgDtToleranceOtherMinBefore: "Value must be at least %[1]d %[2]s before value of property '%[3]s'",
+}
+
+// unitsAmount is a format argument for an amount of units - formatted (see FormatUnits) as a single argument for
+// current formats, or as separate amount and unit token arguments for legacy formats (see legacyUnitsFormats)
+type unitsAmount struct {
+	amount    int64
+	unit      string
+	formatted string
+}
+
+func newUnitsAmount(tcx I18nContext, amount int64, unit string) unitsAmount {
+	return unitsAmount{
+		amount:    amount,
+		unit:      unit,
+		formatted: FormatUnits(tcx, amount, unit),
+	}
+}
+
+func (u unitsAmount) String() string {
+	return u.formatted
+}
+
+// legacyUnitsArgs expands any unitsAmount args into the amount and the translated unit token (using the plural
+// unit token, e.g. "day...", for amounts greater than one) - as used by the legacy formats
+func legacyUnitsArgs(translator Translator, lang string, region string, a []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(a)+1)
+	for _, arg := range a {
+		if u, ok := arg.(unitsAmount); ok {
+			unit := u.unit
+			if u.amount > 1 {
+				unit = unit + "..."
+			}
+			result = append(result, u.amount, translator.TranslateToken(lang, region, unit))
+		} else {
+			result = append(result, arg)
+		}
+	}
+	return result
+}
+
+// currentFormats is the reverse of legacyFormats (i.e. the current built-in format for each printf style format)
+var currentFormats = func() map[string]string {
+	result := make(map[string]string, len(legacyFormats))
+	for current, legacy := range legacyFormats {
+		result[legacy] = current
+	}
+	return result
+}()
+
+// currentFormat returns the current built-in format for a printf style format (or the format as-is)
+func currentFormat(format string) string {
+	if current, ok := currentFormats[format]; ok {
+		return current
+	}
+	return format
+}
+
+func supportsMessageFormat(translator Translator) bool {
+	if mft, ok := translator.(MessageFormatTranslator); ok {
+		return mft.SupportsMessageFormat()
+	}
+	return false
+}
+
+// translateFormat translates (and formats) a format using the translator - where the translator does not support
+// message formats (see MessageFormatTranslator), built-in formats are passed as their printf style equivalents
+func translateFormat(translator Translator, lang string, region string, format string, a ...interface{}) string {
+	if !supportsMessageFormat(translator) {
+		if legacy, ok := legacyFormats[format]; ok {
+			return translator.TranslateFormat(lang, region, legacy, a...)
+		} else if legacy, ok := legacyUnitsFormats[format]; ok {
+			return translator.TranslateFormat(lang, region, legacy, legacyUnitsArgs(translator, lang, region, a)...)
+		} else if unit, ok := unitsByFormat[format]; ok && len(a) == 1 {
+			// use the singular/plural unit tokens (e.g. "day" and "day...")...
+			if n, ok, _ := coerceToInt(a[0]); !ok || n != 1 {
+				unit = unit + "..."
+			}
+			return fmt.Sprintf("%v %s", a[0], translator.TranslateToken(lang, region, unit))
+		}
+	}
+	return translator.TranslateFormat(lang, region, format, a...)
+}
+
+const (
+	argTypePlural   = "plural"
+	argTypeNumber   = "number"
+	argTypeDate     = "date"
+	argTypeDatetime = "datetime"
+	argTypeTime     = "time"
+	argTypeDuration = "duration"
+)
+
+// expandMessageFormat expands the ICU MessageFormat style arguments in the pattern - argument values are obtained
+// from the lookup and are formatted using the formatter (the escape func, if non-nil, is applied to inserted text)
+func expandMessageFormat(f I18nFormatter, pattern string, lookup func(name string) (interface{}, bool), escape func(string) string) string {
+	if !strings.Contains(pattern, ",") || !strings.Contains(pattern, "{") {
+		return pattern
+	}
+	if escape == nil {
+		escape = func(s string) string {
+			return s
+		}
+	}
+	var sb strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		ch := pattern[i]
+		if ch == '{' {
+			if i+1 < len(pattern) && pattern[i+1] == '{' {
+				sb.WriteString("{{")
+				i++
+				continue
+			}
+			if end := matchingBrace(pattern, i); end != -1 {
+				if expanded, ok := expandMessageArgument(f, pattern[i+1:end], lookup, escape); ok {
+					sb.WriteString(expanded)
+					i = end
+					continue
+				}
+			}
+		}
+		sb.WriteByte(ch)
+	}
+	return sb.String()
+}
+
+// matchingBrace returns the index of the brace that closes the brace at start (or -1 if unbalanced)
+func matchingBrace(s string, start int) int {
+	depth := 0
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func expandMessageArgument(f I18nFormatter, arg string, lookup func(name string) (interface{}, bool), escape func(string) string) (string, bool) {
+	parts := strings.SplitN(arg, ",", 3)
+	if len(parts) < 2 {
+		return "", false
+	}
+	value, ok := lookup(strings.TrimSpace(parts[0]))
+	if !ok {
+		return "", false
+	}
+	switch strings.TrimSpace(parts[1]) {
+	case argTypePlural:
+		if len(parts) == 3 {
+			return expandPlural(f, value, parts[2], lookup, escape)
+		}
+	case argTypeNumber:
+		if _, isNum := messageNumber(value); isNum {
+			return escape(f.FormatNumber(value)), true
+		}
+	case argTypeDate, argTypeDatetime, argTypeTime:
+		if dt, dateOnly, isDt := messageDatetime(value); isDt {
+			switch argType := strings.TrimSpace(parts[1]); {
+			case argType == argTypeTime:
+				return escape(f.FormatTime(dt)), true
+			case dateOnly || argType == argTypeDate:
+				return escape(f.FormatDate(dt)), true
+			}
+			return escape(f.FormatDatetime(dt)), true
+		}
+	case argTypeDuration:
+		if d, isDuration := value.(time.Duration); isDuration {
+			return escape(f.FormatDuration(d)), true
+		}
+	}
+	return "", false
+}
+
+func expandPlural(f I18nFormatter, value interface{}, options string, lookup func(name string) (interface{}, bool), escape func(string) string) (string, bool) {
+	n, ok := messageNumber(value)
+	if !ok {
+		return "", false
+	}
+	selectors := map[string]string{}
+	for i := 0; i < len(options); {
+		open := strings.IndexByte(options[i:], '{')
+		if open == -1 {
+			break
+		}
+		open += i
+		end := matchingBrace(options, open)
+		if end == -1 {
+			return "", false
+		}
+		selectors[strings.TrimSpace(options[i:open])] = options[open+1 : end]
+		i = end + 1
+	}
+	body, found := selectors["="+exactPluralValue(n)]
+	if !found {
+		if body, found = selectors[f.PluralCategory(n)]; !found {
+			if body, found = selectors[pluralOther]; !found {
+				return "", false
+			}
+		}
+	}
+	return expandMessageFormat(f, replacePluralHash(body, escape(f.FormatNumber(n))), lookup, escape), true
+}
+
+// replacePluralHash replaces "#" (not within nested arguments) in a plural body with the formatted number
+func replacePluralHash(body string, number string) string {
+	if !strings.Contains(body, "#") {
+		return body
+	}
+	var sb strings.Builder
+	depth := 0
+	for i := 0; i < len(body); i++ {
+		ch := body[i]
+		switch {
+		case ch == '{':
+			depth++
+		case ch == '}':
+			depth--
+		case ch == '#' && depth == 0:
+			sb.WriteString(number)
+			continue
+		}
+		sb.WriteByte(ch)
+	}
+	return sb.String()
+}
+
+func exactPluralValue(n interface{}) string {
+	switch v := n.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return ""
+}
+
+// messageNumber returns the number value of a message argument (numeric strings are also treated as numbers)
+func messageNumber(value interface{}) (interface{}, bool) {
+	if n, ok := numericValue(value); ok {
+		return n, true
+	}
+	if s, ok := value.(string); ok {
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i, true
+		} else if fv, err := strconv.ParseFloat(s, 64); err == nil {
+			return fv, true
+		}
+	}
+	return nil, false
+}
+
+// messageDatetime returns the time value of a message argument (ISO-8601 strings are also treated as datetimes)
+func messageDatetime(value interface{}) (time.Time, bool, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, false, true
+	case *time.Time:
+		if v != nil {
+			return *v, false, true
+		}
+	case Time:
+		return v.Time, iso8601DateOnlyRegex.MatchString(v.Original), true
+	case *Time:
+		if v != nil {
+			return v.Time, iso8601DateOnlyRegex.MatchString(v.Original), true
+		}
+	case string:
+		if dt, ok := stringToDatetime(v, false); ok {
+			return *dt, iso8601DateOnlyRegex.MatchString(v), true
+		}
+	}
+	return time.Time{}, false, false
+}
+
+// formatArgsLookup returns a message format argument lookup for format arguments (named by 1-based index)
+func formatArgsLookup(a []interface{}) func(name string) (interface{}, bool) {
+	return func(name string) (interface{}, bool) {
+		if idx, err := strconv.Atoi(name); err == nil && idx > 0 && idx <= len(a) {
+			return a[idx-1], true
+		}
+		return nil, false
+	}
+}
+
+// valuesLookup returns a message format argument lookup for named placeholder values
+func valuesLookup(values map[string]interface{}) func(name string) (interface{}, bool) {
+	return func(name string) (interface{}, bool) {
+		v, ok := values[name]
+		return v, ok
+	}
+}
+
+func escapeFormatPercent(s string) string {
+	return strings.ReplaceAll(s, "%", "%%")
+}
+
+func escapeTemplateBrace(s string) string {
+	return strings.ReplaceAll(s, "{", "{{")
+}
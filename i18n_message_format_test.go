@@ -0,0 +1,201 @@
+package valix
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandMessageFormat(t *testing.T) {
+	f := newLocaleFormatter("en", "", nil)
+	values := map[string]interface{}{
+		"count":    2,
+		"one":      1,
+		"zero":     0,
+		"big":      1234,
+		"str":      "3",
+		"notNum":   "abc",
+		"dt":       "2022-04-05T18:19:20Z",
+		"date":     "2022-04-05",
+		"tm":       time.Date(2022, 4, 5, 18, 19, 20, 0, time.UTC),
+		"duration": 90 * time.Second,
+		"pct":      12.5,
+	}
+	testCases := []struct {
+		pattern string
+		expect  string
+	}{
+		{"no args", "no args"},
+		{"{count}", "{count}"},
+		{"{count, plural, one{# item} other{# items}}", "2 items"},
+		{"{one, plural, one{# item} other{# items}}", "1 item"},
+		{"{zero, plural, =0{no items} one{# item} other{# items}}", "no items"},
+		{"{big, plural, one{# item} other{# items}}", "1,234 items"},
+		{"{str, plural, one{# item} other{# items}}", "3 items"},
+		{"{notNum, plural, one{# item} other{# items}}", "{notNum, plural, one{# item} other{# items}}"},
+		{"{missing, plural, one{# item} other{# items}}", "{missing, plural, one{# item} other{# items}}"},
+		{"{count, plural, one{# item}}", "{count, plural, one{# item}}"},
+		{"{count, plural, one{# item} other{# items}", "{count, plural, one{# item} other{# items}"},
+		{"{count, plural, other{# of {one, plural, one{# thing} other{# things}}}}", "2 of 1 thing"},
+		{"{count, plural, other{{big, number} (#)}}", "1,234 (2)"},
+		{"{{count, plural, other{#}}", "{{count, plural, other{#}}"},
+		{"{big, number}", "1,234"},
+		{"{pct, number}%", "12.5%"},
+		{"{notNum, number}", "{notNum, number}"},
+		{"{dt, datetime}", "Apr 5, 2022 6:19:20 PM"},
+		{"{dt, date}", "Apr 5, 2022"},
+		{"{dt, time}", "6:19:20 PM"},
+		{"{date, datetime}", "Apr 5, 2022"},
+		{"{tm, datetime}", "Apr 5, 2022 6:19:20 PM"},
+		{"{notNum, date}", "{notNum, date}"},
+		{"{count, unknown}", "{count, unknown}"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.pattern, func(t *testing.T) {
+			require.Equal(t, tc.expect, expandMessageFormat(f, tc.pattern, valuesLookup(values), nil))
+		})
+	}
+}
+
+func TestExpandMessageFormat_Duration(t *testing.T) {
+	tcx := newDefaultI18nContext("en", "")
+	msg := RenderMessageTemplate(tcx, "took {duration, duration}", map[string]interface{}{"duration": 90 * time.Second})
+	require.Equal(t, "took 1 minute 30 seconds", msg)
+	msg = RenderMessageTemplate(tcx, "took {duration, duration}", map[string]interface{}{"duration": "90s"})
+	require.Equal(t, "took {duration, duration}", msg)
+}
+
+func TestFormatMessage(t *testing.T) {
+	msg := FormatMessage(nil, "en", "", "%[2]s has {1, plural, one{# item} other{# items}}", 1, "foo")
+	require.Equal(t, "foo has 1 item", msg)
+	msg = FormatMessage(nil, "en", "", "%[2]s has {1, plural, one{# item} other{# items}}", 1000, "foo")
+	require.Equal(t, "foo has 1,000 items", msg)
+	msg = FormatMessage(nil, "de", "", "%[1]d Elemente", 1000)
+	require.Equal(t, "1.000 Elemente", msg)
+	// percent in inserted text is escaped...
+	msg = FormatMessage(nil, "en", "", "{1, plural, other{#}} of %[2]s", 2, "100%")
+	require.Equal(t, "2 of 100%", msg)
+	msg = FormatMessage(nil, "en", "", "{1, duration} remaining", 90*time.Minute)
+	require.Equal(t, "1 hour 30 minutes remaining", msg)
+}
+
+func TestTranslateFormat_Plurals(t *testing.T) {
+	testCases := []struct {
+		lang   string
+		format string
+		value  int
+		expect string
+	}{
+		{"en", fmtMsgStringMinLen, 1, "String value length must be at least 1 character"},
+		{"en", fmtMsgStringMinLen, 2, "String value length must be at least 2 characters"},
+		{"en", fmtMsgStringMinLen, 1000, "String value length must be at least 1,000 characters"},
+		{"fr", fmtMsgStringMinLen, 1, "La longueur de la valeur de la chaîne doit être d'au moins 1 caractère"},
+		{"fr", fmtMsgStringMinLen, 2, "La longueur de la valeur de la chaîne doit être d'au moins 2 caractères"},
+		{"pl", fmtMsgStringMinLen, 1, "Długość wartości ciągu musi wynosić co najmniej 1 znak"},
+		{"pl", fmtMsgStringMinLen, 3, "Długość wartości ciągu musi wynosić co najmniej 3 znaki"},
+		{"pl", fmtMsgStringMinLen, 5, "Długość wartości ciągu musi wynosić co najmniej 5 znaków"},
+		{"ja", fmtMsgStringMinLen, 5, "文字列値の長さは少なくとも5文字でなければなりません"},
+		{"en", fmtMsgDtAgeMin, 1, "Age must be over 1 year old"},
+		{"en", fmtMsgDtAgeMin, 18, "Age must be over 18 years old"},
+		{"de", fmtMsgDtAgeMin, 1, "Das Alter muss über 1 Jahr alt sein"},
+		{"pl", fmtMsgDtAgeMin, 22, "Wiek musi być większy niż 22 lata"},
+		{"en", fmtMsgConstraintSetDefaultAllOf, 1, "Constraint set must pass all of 1 undisclosed validation"},
+		{"es", fmtMsgConstraintSetDefaultAllOf, 3, "El conjunto de restricciones debe pasar todas las 3 validaciones no reveladas"},
+	}
+	for _, tc := range testCases {
+		tcx := newDefaultI18nContext(tc.lang, "")
+		require.Equal(t, tc.expect, tcx.TranslateFormat(tc.format, tc.value))
+	}
+}
+
+func TestDatetimeTolerance_GetMessage_Plurals(t *testing.T) {
+	c := &DatetimeToleranceToNow{Duration: 3, Unit: "min"}
+	require.Equal(t, "Value must not be more than 3 minutes after now", c.GetMessage(newDefaultI18nContext("en", "")))
+	require.Equal(t, "Wartość nie może być późniejsza o więcej niż 3 minuty od teraz", c.GetMessage(newDefaultI18nContext("pl", "")))
+	c = &DatetimeToleranceToNow{Duration: -1, Unit: "sec", MinCheck: true}
+	require.Equal(t, "Value must be at least 1 second before now", c.GetMessage(newDefaultI18nContext("en", "")))
+	require.Equal(t, "Wert muss vorher mindestens 1 Sekunde betragen", c.GetMessage(newDefaultI18nContext("de", "")))
+	c = &DatetimeToleranceToNow{Unit: "min"}
+	require.Equal(t, "Value must be same minute as now", c.GetMessage(newDefaultI18nContext("en", "")))
+}
+
+type testSprintfTranslator struct {
+	Translator
+	formats map[string]string
+}
+
+func (t *testSprintfTranslator) TranslateToken(lang string, region string, token string) string {
+	return "[" + token + "]"
+}
+
+func (t *testSprintfTranslator) TranslateFormat(lang string, region string, format string, a ...interface{}) string {
+	if tr, ok := t.formats[format]; ok {
+		format = tr
+	}
+	return fmt.Sprintf(format, a...)
+}
+
+func TestTranslateFormat_LegacyTranslator(t *testing.T) {
+	tr := &testSprintfTranslator{formats: map[string]string{
+		"String value length must not exceed %[1]d characters": "La longueur ne doit pas dépasser %[1]d caractères",
+	}}
+	tcx := newTranslatorI18nContext("fr", "", tr)
+	require.Equal(t, "La longueur ne doit pas dépasser 3 caractères", tcx.TranslateFormat(fmtMsgStringMaxLen, 3))
+	require.Equal(t, "String value length must be at least 3 characters", tcx.TranslateFormat(fmtMsgStringMinLen, 3))
+	require.Equal(t, "Age must be over 18 years old", tcx.TranslateFormat(fmtMsgDtAgeMin, 18))
+	require.Equal(t, "1 [day]", FormatUnits(tcx, 1, "day"))
+	require.Equal(t, "2 [day...]", FormatUnits(tcx, 2, "day"))
+
+	msg := (&StringMaxLength{Value: 3}).GetMessage(tcx)
+	require.Equal(t, "La longueur ne doit pas dépasser 3 caractères", msg)
+	require.Equal(t, "Value length must be at least 2", (&Length{Minimum: 2}).GetMessage(tcx))
+	require.Equal(t, "Value must be a multiple of 5", (&MultipleOf{Value: 5}).GetMessage(tcx))
+
+	require.False(t, supportsMessageFormat(tr))
+	require.True(t, supportsMessageFormat(defaultInternalTranslator))
+}
+
+func TestTranslateFormat_LegacyTranslatorDatetimeTolerance(t *testing.T) {
+	tr := &testSprintfTranslator{formats: map[string]string{
+		"Value must not be more than %[1]d %[2]s after now": "La valeur ne doit pas dépasser %[1]d %[2]s après maintenant",
+	}}
+	tcx := newTranslatorI18nContext("fr", "", tr)
+	c := &DatetimeToleranceToNow{Duration: 3, Unit: "day"}
+	require.Equal(t, "La valeur ne doit pas dépasser 3 [day...] après maintenant", c.GetMessage(tcx))
+	c = &DatetimeToleranceToNow{Duration: 1, Unit: "day"}
+	require.Equal(t, "La valeur ne doit pas dépasser 1 [day] après maintenant", c.GetMessage(tcx))
+	c = &DatetimeToleranceToNow{Duration: -2, Unit: "hour", MinCheck: true}
+	require.Equal(t, "Value must be at least 2 [hour...] before now", c.GetMessage(tcx))
+}
+
+func TestInternalTranslator_LegacyFormatKeys(t *testing.T) {
+	tr := &internalTranslator{
+		Tokens:   map[string]map[string]string{},
+		Messages: map[string]map[string]string{},
+		Formats:  map[string]map[string]string{},
+	}
+	tr.AddFormatLanguageTranslation("sv", "String value length must not exceed %[1]d characters", "Längden får inte överstiga %[1]d tecken")
+	tr.AddFormatRegionTranslation("sv", "FI", "String value length must be %[1]d characters", "Längden måste vara %[1]d tecken")
+	require.Equal(t, "Längden får inte överstiga 3 tecken", tr.TranslateFormat("sv", "", fmtMsgStringMaxLen, 3))
+	require.Equal(t, "Längden får inte överstiga 3 tecken", tr.TranslateFormat("sv", "", "String value length must not exceed %[1]d characters", 3))
+	require.Equal(t, "Längden måste vara 2 tecken", tr.TranslateFormat("sv", "FI", fmtMsgStringExactLen, 2))
+
+	(&TranslationCatalogue{
+		Language: "da",
+		TranslationCatalogueEntries: TranslationCatalogueEntries{
+			Formats: map[string]string{
+				"Age must be over %[1]d years old": "Alderen skal være over %[1]d år",
+			},
+		},
+	}).AddTo(tr)
+	require.Equal(t, "Alderen skal være over 18 år", tr.TranslateFormat("da", "", fmtMsgDtAgeMin, 18))
+	tr.AddTokenLanguageTranslation("da", "day...", "dage")
+	tr.AddFormatLanguageTranslation("da", "Value must not be more than %[1]d %[2]s after now", "Værdien må ikke være mere end %[1]d %[2]s efter nu")
+	require.Equal(t, "Værdien må ikke være mere end 3 dage efter nu", (&DatetimeToleranceToNow{Duration: 3, Unit: "day"}).GetMessage(newTranslatorI18nContext("da", "", tr)))
+	tr.AddFormatLanguageTranslation("da", "Value length must be at least %[1]d", "Længden skal være mindst %[1]d")
+	require.Equal(t, "Længden skal være mindst 2", tr.TranslateFormat("da", "", fmtMsgMinLen, 2))
+	// untranslated languages still use the plural aware format...
+	require.Equal(t, "String value length must not exceed 1 character", tr.TranslateFormat("en", "", fmtMsgStringMaxLen, 1))
+}
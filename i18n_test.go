@@ -270,11 +270,41 @@ func TestRequestLanguage(t *testing.T) {
 		},
 		{
 			"gsw, en;q=0.7, en-US;q=0.8",
+			"de",
+			"",
+		},
+		{
+			"da, en;q=0.7, en-US;q=0.8",
 			"en",
 			"US",
 		},
 		{
-			"gsw, nl, da",
+			"da, nl",
+			"nl",
+			"",
+		},
+		{
+			"da, nn",
+			"en",
+			"",
+		},
+		{
+			"pt-BR, en;q=0.5",
+			"pt",
+			"BR",
+		},
+		{
+			"zh-CN",
+			"zh",
+			"CN",
+		},
+		{
+			"ja-JP;q=0.5, pl;q=0.6",
+			"pl",
+			"",
+		},
+		{
+			"sr-Latn",
 			"en",
 			"",
 		},
@@ -1,7 +1,6 @@
 package valix
 
 import (
-	"fmt"
 	"strings"
 )
 
@@ -55,6 +54,16 @@ func lookupTranslation(trs map[string]map[string]string, str string, lang string
 	return result
 }
 
+func hasLanguageTranslation(trs map[string]map[string]string, str string, lang string, rgn string) bool {
+	if ts, ok := trs[str]; ok {
+		if _, ok = ts[lang]; !ok && rgn != "" {
+			_, ok = ts[lang+"-"+rgn]
+		}
+		return ok
+	}
+	return false
+}
+
 func (t *internalTranslator) TranslateToken(lang string, region string, token string) string {
 	return lookupTranslation(t.Tokens, token, lang, region)
 }
@@ -64,38 +73,48 @@ func (t *internalTranslator) TranslateMessage(lang string, region string, messag
 }
 
 func (t *internalTranslator) TranslateFormat(lang string, region string, format string, a ...interface{}) string {
-	return fmt.Sprintf(lookupTranslation(t.Formats, format, lang, region), a...)
+	if legacy, ok := legacyUnitsFormats[format]; ok && !hasLanguageTranslation(t.Formats, format, lang, region) && hasLanguageTranslation(t.Formats, legacy, lang, region) {
+		return FormatMessage(t, lang, region, lookupTranslation(t.Formats, legacy, lang, region), legacyUnitsArgs(t, lang, region, a)...)
+	}
+	return FormatMessage(t, lang, region, lookupTranslation(t.Formats, currentFormat(format), lang, region), a...)
+}
+
+// SupportsMessageFormat implements MessageFormatTranslator.SupportsMessageFormat
+func (t *internalTranslator) SupportsMessageFormat() bool {
+	return true
 }
 
 func (t *internalTranslator) AddTokenLanguageTranslation(lang string, token string, translation string, regionals ...RegionalVariantTranslation) {
+	defer invalidateSupportedLanguages()
 	tr, present := t.Tokens[token]
 	if !present {
 		tr = map[string]string{
-			strings.ToLower(lang): translation,
+			normalizeLanguage(lang): translation,
 		}
 		t.Tokens[token] = tr
 	}
 	for _, regional := range regionals {
 		if regional.Region != "" {
 			if regional.Translation != "" {
-				tr[strings.ToLower(lang)+"-"+strings.ToUpper(regional.Region)] = regional.Translation
+				tr[normalizeLanguage(lang)+"-"+strings.ToUpper(regional.Region)] = regional.Translation
 			} else {
-				tr[strings.ToLower(lang)+"-"+strings.ToUpper(regional.Region)] = translation
+				tr[normalizeLanguage(lang)+"-"+strings.ToUpper(regional.Region)] = translation
 			}
 		}
 	}
 }
 
 func (t *internalTranslator) AddTokenRegionTranslation(lang string, region string, token string, translation string) {
+	defer invalidateSupportedLanguages()
 	if region != "" {
 		if tr, ok := t.Tokens[token]; ok {
-			tr[strings.ToLower(lang)+"-"+strings.ToUpper(region)] = translation
+			tr[normalizeLanguage(lang)+"-"+strings.ToUpper(region)] = translation
 		} else {
 			newTr := map[string]string{
-				strings.ToLower(lang): translation,
+				normalizeLanguage(lang): translation,
 			}
 			if region != "" {
-				newTr[strings.ToLower(lang)+"-"+strings.ToUpper(region)] = translation
+				newTr[normalizeLanguage(lang)+"-"+strings.ToUpper(region)] = translation
 			}
 			t.Tokens[token] = newTr
 		}
@@ -103,34 +122,36 @@ func (t *internalTranslator) AddTokenRegionTranslation(lang string, region strin
 }
 
 func (t *internalTranslator) AddMessageLanguageTranslation(lang string, message string, translation string, regionals ...RegionalVariantTranslation) {
+	defer invalidateSupportedLanguages()
 	tr, present := t.Messages[message]
 	if !present {
 		tr = map[string]string{
-			strings.ToLower(lang): translation,
+			normalizeLanguage(lang): translation,
 		}
 		t.Messages[message] = tr
 	}
 	for _, regional := range regionals {
 		if regional.Region != "" {
 			if regional.Translation != "" {
-				tr[strings.ToLower(lang)+"-"+strings.ToUpper(regional.Region)] = regional.Translation
+				tr[normalizeLanguage(lang)+"-"+strings.ToUpper(regional.Region)] = regional.Translation
 			} else {
-				tr[strings.ToLower(lang)+"-"+strings.ToUpper(regional.Region)] = translation
+				tr[normalizeLanguage(lang)+"-"+strings.ToUpper(regional.Region)] = translation
 			}
 		}
 	}
 }
 
 func (t *internalTranslator) AddMessageRegionTranslation(lang string, region string, message string, translation string) {
+	defer invalidateSupportedLanguages()
 	if region != "" {
 		if tr, ok := t.Messages[message]; ok {
-			tr[strings.ToLower(lang)+"-"+strings.ToUpper(region)] = translation
+			tr[normalizeLanguage(lang)+"-"+strings.ToUpper(region)] = translation
 		} else {
 			newTr := map[string]string{
-				strings.ToLower(lang): translation,
+				normalizeLanguage(lang): translation,
 			}
 			if region != "" {
-				newTr[strings.ToLower(lang)+"-"+strings.ToUpper(region)] = translation
+				newTr[normalizeLanguage(lang)+"-"+strings.ToUpper(region)] = translation
 			}
 			t.Messages[message] = newTr
 		}
@@ -138,34 +159,38 @@ func (t *internalTranslator) AddMessageRegionTranslation(lang string, region str
 }
 
 func (t *internalTranslator) AddFormatLanguageTranslation(lang string, format string, translation string, regionals ...RegionalVariantTranslation) {
+	defer invalidateSupportedLanguages()
+	format = currentFormat(format)
 	tr, present := t.Formats[format]
 	if !present {
 		tr = map[string]string{
-			strings.ToLower(lang): translation,
+			normalizeLanguage(lang): translation,
 		}
 		t.Formats[format] = tr
 	}
 	for _, regional := range regionals {
 		if regional.Region != "" {
 			if regional.Translation != "" {
-				tr[strings.ToLower(lang)+"-"+strings.ToUpper(regional.Region)] = regional.Translation
+				tr[normalizeLanguage(lang)+"-"+strings.ToUpper(regional.Region)] = regional.Translation
 			} else {
-				tr[strings.ToLower(lang)+"-"+strings.ToUpper(regional.Region)] = translation
+				tr[normalizeLanguage(lang)+"-"+strings.ToUpper(regional.Region)] = translation
 			}
 		}
 	}
 }
 
 func (t *internalTranslator) AddFormatRegionTranslation(lang string, region string, format string, translation string) {
+	defer invalidateSupportedLanguages()
+	format = currentFormat(format)
 	if region != "" {
 		if tr, ok := t.Formats[format]; ok {
-			tr[strings.ToLower(lang)+"-"+strings.ToUpper(region)] = translation
+			tr[normalizeLanguage(lang)+"-"+strings.ToUpper(region)] = translation
 		} else {
 			newTr := map[string]string{
-				strings.ToLower(lang): translation,
+				normalizeLanguage(lang): translation,
 			}
 			if region != "" {
-				newTr[strings.ToLower(lang)+"-"+strings.ToUpper(region)] = translation
+				newTr[normalizeLanguage(lang)+"-"+strings.ToUpper(region)] = translation
 			}
 			t.Formats[format] = newTr
 		}
@@ -178,6 +203,11 @@ const (
 	langEs = "es"
 	langIt = "it"
 	langDe = "de"
+	langNl = "nl"
+	langPt = "pt"
+	langPl = "pl"
+	langJa = "ja"
+	langZh = "zh"
 )
 
 var defaultInternalTranslator = &internalTranslator{
@@ -188,6 +218,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "alguno",
 			langIt: "qualsiasi",
 			langDe: "beliebig",
+			langNl: "willekeurig",
+			langPt: "qualquer",
+			langPl: "dowolny",
+			langJa: "任意",
+			langZh: "任意",
 		},
 		jsonTypeTokenArray: {
 			langEn: jsonTypeTokenArray,
@@ -195,6 +230,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "matriz",
 			langIt: "array",
 			langDe: "Array",
+			langNl: "array",
+			langPt: "matriz",
+			langPl: "tablica",
+			langJa: "配列",
+			langZh: "数组",
 		},
 		jsonTypeTokenBoolean: {
 			langEn: jsonTypeTokenBoolean,
@@ -202,6 +242,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "booleano",
 			langIt: "booleano",
 			langDe: "boolesch",
+			langNl: "booleaans",
+			langPt: "booleano",
+			langPl: "logiczny",
+			langJa: "ブール値",
+			langZh: "布尔值",
 		},
 		jsonTypeTokenInteger: {
 			langEn: jsonTypeTokenInteger,
@@ -209,6 +254,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "entero",
 			langIt: "intero",
 			langDe: "Ganzzahl",
+			langNl: "geheel getal",
+			langPt: "inteiro",
+			langPl: "liczba całkowita",
+			langJa: "整数",
+			langZh: "整数",
 		},
 		jsonTypeTokenNumber: {
 			langEn: jsonTypeTokenNumber,
@@ -216,6 +266,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "número",
 			langIt: "numero",
 			langDe: "Nummer",
+			langNl: "getal",
+			langPt: "número",
+			langPl: "liczba",
+			langJa: "数値",
+			langZh: "数字",
 		},
 		jsonTypeTokenObject: {
 			langEn: jsonTypeTokenObject,
@@ -223,6 +278,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "objeto",
 			langIt: "oggetto",
 			langDe: "Objekt",
+			langNl: "object",
+			langPt: "objeto",
+			langPl: "obiekt",
+			langJa: "オブジェクト",
+			langZh: "对象",
 		},
 		jsonTypeTokenString: {
 			langEn: jsonTypeTokenString,
@@ -230,6 +290,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "cadena",
 			langIt: "stringa",
 			langDe: "Zeichenfolge",
+			langNl: "tekenreeks",
+			langPt: "string",
+			langPl: "ciąg znaków",
+			langJa: "文字列",
+			langZh: "字符串",
 		},
 		tokenExclusive: {
 			langEn: tokenExclusive,
@@ -237,6 +302,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "exclusivo",
 			langIt: "esclusivo",
 			langDe: "exklusiv",
+			langNl: "exclusief",
+			langPt: "exclusivo",
+			langPl: "wyłącznie",
+			langJa: "含まない",
+			langZh: "不含",
 		},
 		tokenInclusive: {
 			langEn: tokenInclusive,
@@ -244,6 +314,275 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "inclusivo",
 			langIt: "comprensivo",
 			langDe: "inklusive",
+			langNl: "inclusief",
+			langPt: "inclusivo",
+			langPl: "włącznie",
+			langJa: "含む",
+			langZh: "含",
+		},
+		docTokenProperty: {
+			langEn: docTokenProperty,
+			langFr: "Propriété",
+			langEs: "Propiedad",
+			langIt: "Proprietà",
+			langDe: "Eigenschaft",
+			langNl: "Eigenschap",
+			langPt: "Propriedade",
+			langPl: "Właściwość",
+			langJa: "プロパティ",
+			langZh: "属性",
+		},
+		docTokenType: {
+			langEn: docTokenType,
+			langFr: "Type",
+			langEs: "Tipo",
+			langIt: "Tipo",
+			langDe: "Typ",
+			langNl: "Type",
+			langPt: "Tipo",
+			langPl: "Typ",
+			langJa: "型",
+			langZh: "类型",
+		},
+		docTokenMandatory: {
+			langEn: docTokenMandatory,
+			langFr: "Obligatoire",
+			langEs: "Obligatorio",
+			langIt: "Obbligatorio",
+			langDe: "Pflicht",
+			langNl: "Verplicht",
+			langPt: "Obrigatório",
+			langPl: "Wymagane",
+			langJa: "必須",
+			langZh: "必填",
+		},
+		docTokenNullable: {
+			langEn: docTokenNullable,
+			langFr: "Nullable",
+			langEs: "Anulable",
+			langIt: "Annullabile",
+			langDe: "Nullbar",
+			langNl: "Nullable",
+			langPt: "Anulável",
+			langPl: "Dopuszcza null",
+			langJa: "null許容",
+			langZh: "可为空",
+		},
+		docTokenConditions: {
+			langEn: docTokenConditions,
+			langFr: "Conditions",
+			langEs: "Condiciones",
+			langIt: "Condizioni",
+			langDe: "Bedingungen",
+			langNl: "Voorwaarden",
+			langPt: "Condições",
+			langPl: "Warunki",
+			langJa: "条件",
+			langZh: "条件",
+		},
+		docTokenDescription: {
+			langEn: docTokenDescription,
+			langFr: "Description",
+			langEs: "Descripción",
+			langIt: "Descrizione",
+			langDe: "Beschreibung",
+			langNl: "Beschrijving",
+			langPt: "Descrição",
+			langPl: "Opis",
+			langJa: "説明",
+			langZh: "描述",
+		},
+		docTokenConstraints: {
+			langEn: docTokenConstraints,
+			langFr: "Contraintes",
+			langEs: "Restricciones",
+			langIt: "Vincoli",
+			langDe: "Einschränkungen",
+			langNl: "Beperkingen",
+			langPt: "Restrições",
+			langPl: "Ograniczenia",
+			langJa: "制約",
+			langZh: "约束",
+		},
+		docTokenYes: {
+			langEn: docTokenYes,
+			langFr: "Oui",
+			langEs: "Sí",
+			langIt: "Sì",
+			langDe: "Ja",
+			langNl: "Ja",
+			langPt: "Sim",
+			langPl: "Tak",
+			langJa: "はい",
+			langZh: "是",
+		},
+		docTokenNo: {
+			langEn: docTokenNo,
+			langFr: "Non",
+			langEs: "No",
+			langIt: "No",
+			langDe: "Nein",
+			langNl: "Nee",
+			langPt: "Não",
+			langPl: "Nie",
+			langJa: "いいえ",
+			langZh: "否",
+		},
+		docTokenWhen: {
+			langEn: docTokenWhen,
+			langFr: "quand",
+			langEs: "cuando",
+			langIt: "quando",
+			langDe: "wenn",
+			langNl: "wanneer",
+			langPt: "quando",
+			langPl: "gdy",
+			langJa: "条件",
+			langZh: "当",
+		},
+		docTokenMandatoryWhen: {
+			langEn: docTokenMandatoryWhen,
+			langFr: "obligatoire quand",
+			langEs: "obligatorio cuando",
+			langIt: "obbligatorio quando",
+			langDe: "Pflicht wenn",
+			langNl: "verplicht wanneer",
+			langPt: "obrigatório quando",
+			langPl: "wymagane gdy",
+			langJa: "必須条件",
+			langZh: "必填条件",
+		},
+		docTokenUnwantedWhen: {
+			langEn: docTokenUnwantedWhen,
+			langFr: "indésirable quand",
+			langEs: "no deseado cuando",
+			langIt: "indesiderato quando",
+			langDe: "unerwünscht wenn",
+			langNl: "ongewenst wanneer",
+			langPt: "indesejado quando",
+			langPl: "niepożądane gdy",
+			langJa: "不要条件",
+			langZh: "不需要条件",
+		},
+		docTokenRequiredWith: {
+			langEn: docTokenRequiredWith,
+			langFr: "requis avec",
+			langEs: "requerido con",
+			langIt: "richiesto con",
+			langDe: "erforderlich mit",
+			langNl: "vereist met",
+			langPt: "obrigatório com",
+			langPl: "wymagane z",
+			langJa: "必須の組み合わせ",
+			langZh: "必须同时存在",
+		},
+		docTokenUnwantedWith: {
+			langEn: docTokenUnwantedWith,
+			langFr: "indésirable avec",
+			langEs: "no deseado con",
+			langIt: "indesiderato con",
+			langDe: "unerwünscht mit",
+			langNl: "ongewenst met",
+			langPt: "indesejado com",
+			langPl: "niepożądane z",
+			langJa: "不要な組み合わせ",
+			langZh: "不能同时存在",
+		},
+		docTokenOnly: {
+			langEn: docTokenOnly,
+			langFr: "seul",
+			langEs: "único",
+			langIt: "unico",
+			langDe: "einzig",
+			langNl: "alleen",
+			langPt: "apenas",
+			langPl: "tylko",
+			langJa: "のみ",
+			langZh: "仅",
+		},
+		docTokenOnlyWhen: {
+			langEn: docTokenOnlyWhen,
+			langFr: "seul quand",
+			langEs: "único cuando",
+			langIt: "unico quando",
+			langDe: "einzig wenn",
+			langNl: "alleen wanneer",
+			langPt: "apenas quando",
+			langPl: "tylko gdy",
+			langJa: "のみ条件",
+			langZh: "仅当",
+		},
+		docTokenEachItem: {
+			langEn: docTokenEachItem,
+			langFr: "chaque élément",
+			langEs: "cada elemento",
+			langIt: "ogni elemento",
+			langDe: "jedes Element",
+			langNl: "elk item",
+			langPt: "cada item",
+			langPl: "każdy element",
+			langJa: "各項目",
+			langZh: "每一项",
+		},
+		docTokenConditionalVariant: {
+			langEn: docTokenConditionalVariant,
+			langFr: "Variante conditionnelle",
+			langEs: "Variante condicional",
+			langIt: "Variante condizionale",
+			langDe: "Bedingte Variante",
+			langNl: "Voorwaardelijke variant",
+			langPt: "Variante condicional",
+			langPl: "Wariant warunkowy",
+			langJa: "条件付きバリアント",
+			langZh: "条件变体",
+		},
+		docTokenObjectConstraints: {
+			langEn: docTokenObjectConstraints,
+			langFr: "Contraintes de l'objet",
+			langEs: "Restricciones del objeto",
+			langIt: "Vincoli dell'oggetto",
+			langDe: "Objekteinschränkungen",
+			langNl: "Objectbeperkingen",
+			langPt: "Restrições do objeto",
+			langPl: "Ograniczenia obiektu",
+			langJa: "オブジェクトの制約",
+			langZh: "对象约束",
+		},
+		docTokenUnknownAllowed: {
+			langEn: docTokenUnknownAllowed,
+			langFr: "Les propriétés inconnues sont autorisées",
+			langEs: "Se permiten propiedades desconocidas",
+			langIt: "Sono consentite proprietà sconosciute",
+			langDe: "Unbekannte Eigenschaften sind erlaubt",
+			langNl: "Onbekende eigenschappen zijn toegestaan",
+			langPt: "Propriedades desconhecidas são permitidas",
+			langPl: "Nieznane właściwości są dozwolone",
+			langJa: "不明なプロパティは許可されます",
+			langZh: "允许未知属性",
+		},
+		docTokenDeprecated: {
+			langEn: docTokenDeprecated,
+			langFr: "obsolète",
+			langEs: "obsoleto",
+			langIt: "deprecato",
+			langDe: "veraltet",
+			langNl: "verouderd",
+			langPt: "obsoleto",
+			langPl: "przestarzałe",
+			langJa: "非推奨",
+			langZh: "已弃用",
+		},
+		docTokenExample: {
+			langEn: docTokenExample,
+			langFr: "Exemple",
+			langEs: "Ejemplo",
+			langIt: "Esempio",
+			langDe: "Beispiel",
+			langNl: "Voorbeeld",
+			langPt: "Exemplo",
+			langPl: "Przykład",
+			langJa: "例",
+			langZh: "示例",
 		},
 		"century": {
 			langEn: "century",
@@ -251,6 +590,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "siglo",
 			langIt: "secolo",
 			langDe: "Jahrhundert",
+			langNl: "eeuw",
+			langPt: "século",
+			langPl: "wiek",
+			langJa: "世紀",
+			langZh: "世纪",
 		},
 		"century...": {
 			langEn: "centuries",
@@ -258,6 +602,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "siglos",
 			langIt: "secoli",
 			langDe: "Jahrhunderte",
+			langNl: "eeuwen",
+			langPt: "séculos",
+			langPl: "wieków",
+			langJa: "世紀",
+			langZh: "世纪",
 		},
 		"day": {
 			langEn: "day",
@@ -265,6 +614,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "día",
 			langIt: "giorno",
 			langDe: "Tag",
+			langNl: "dag",
+			langPt: "dia",
+			langPl: "dzień",
+			langJa: "日",
+			langZh: "天",
 		},
 		"day...": {
 			langEn: "days",
@@ -272,6 +626,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "días",
 			langIt: "giorni",
 			langDe: "Tage",
+			langNl: "dagen",
+			langPt: "dias",
+			langPl: "dni",
+			langJa: "日",
+			langZh: "天",
 		},
 		"decade": {
 			langEn: "decade",
@@ -279,6 +638,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "década",
 			langIt: "decennio",
 			langDe: "Jahrzehnt",
+			langNl: "decennium",
+			langPt: "década",
+			langPl: "dekada",
+			langJa: "十年",
+			langZh: "十年",
 		},
 		"decade...": {
 			langEn: "decades",
@@ -286,6 +650,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "décadas",
 			langIt: "decenni",
 			langDe: "Jahrzehnte",
+			langNl: "decennia",
+			langPt: "décadas",
+			langPl: "dekad",
+			langJa: "十年",
+			langZh: "十年",
 		},
 		"hour": {
 			langEn: "hour",
@@ -293,6 +662,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "hora",
 			langIt: "ora",
 			langDe: "Stunde",
+			langNl: "uur",
+			langPt: "hora",
+			langPl: "godzina",
+			langJa: "時間",
+			langZh: "小时",
 		},
 		"hour...": {
 			langEn: "hours",
@@ -300,6 +674,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "horas",
 			langIt: "ore",
 			langDe: "Stunden",
+			langNl: "uur",
+			langPt: "horas",
+			langPl: "godzin",
+			langJa: "時間",
+			langZh: "小时",
 		},
 		"microsecond": {
 			langEn: "microsecond",
@@ -307,6 +686,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "microsegundos",
 			langIt: "microsecondo",
 			langDe: "Mikrosekunde",
+			langNl: "microseconde",
+			langPt: "microssegundo",
+			langPl: "mikrosekunda",
+			langJa: "マイクロ秒",
+			langZh: "微秒",
 		},
 		"microsecond...": {
 			langEn: "microseconds",
@@ -314,6 +698,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "microsegundos",
 			langIt: "microsecondi",
 			langDe: "Mikrosekunden",
+			langNl: "microseconden",
+			langPt: "microssegundos",
+			langPl: "mikrosekund",
+			langJa: "マイクロ秒",
+			langZh: "微秒",
 		},
 		"millennium": {
 			langEn: "millennium",
@@ -321,6 +710,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "milenio",
 			langIt: "millennio",
 			langDe: "Jahrtausend",
+			langNl: "millennium",
+			langPt: "milênio",
+			langPl: "tysiąclecie",
+			langJa: "千年紀",
+			langZh: "千年",
 		},
 		"millennium...": {
 			langEn: "millennia",
@@ -328,6 +722,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "milenios",
 			langIt: "millenni",
 			langDe: "Jahrtausende",
+			langNl: "millennia",
+			langPt: "milênios",
+			langPl: "tysiącleci",
+			langJa: "千年紀",
+			langZh: "千年",
 		},
 		"millisecond": {
 			langEn: "millisecond",
@@ -335,6 +734,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "milisegundos",
 			langIt: "millisecondo",
 			langDe: "Millisekunde",
+			langNl: "milliseconde",
+			langPt: "milissegundo",
+			langPl: "milisekunda",
+			langJa: "ミリ秒",
+			langZh: "毫秒",
 		},
 		"millisecond...": {
 			langEn: "milliseconds",
@@ -342,6 +746,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "milisegundos",
 			langIt: "millisecondi",
 			langDe: "Millisekunden",
+			langNl: "milliseconden",
+			langPt: "milissegundos",
+			langPl: "milisekund",
+			langJa: "ミリ秒",
+			langZh: "毫秒",
 		},
 		"minute": {
 			langEn: "minute",
@@ -349,6 +758,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "minuto",
 			langIt: "minuto",
 			langDe: "Minute",
+			langNl: "minuut",
+			langPt: "minuto",
+			langPl: "minuta",
+			langJa: "分",
+			langZh: "分钟",
 		},
 		"minute...": {
 			langEn: "minutes",
@@ -356,6 +770,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "minutos",
 			langIt: "minuti",
 			langDe: "Minuten",
+			langNl: "minuten",
+			langPt: "minutos",
+			langPl: "minut",
+			langJa: "分",
+			langZh: "分钟",
 		},
 		"month": {
 			langEn: "month",
@@ -363,6 +782,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "mes",
 			langIt: "mese",
 			langDe: "Monat",
+			langNl: "maand",
+			langPt: "mês",
+			langPl: "miesiąc",
+			langJa: "か月",
+			langZh: "个月",
 		},
 		"month...": {
 			langEn: "months",
@@ -370,6 +794,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "meses",
 			langIt: "mesi",
 			langDe: "Monate",
+			langNl: "maanden",
+			langPt: "meses",
+			langPl: "miesięcy",
+			langJa: "か月",
+			langZh: "个月",
 		},
 		"nanosecond": {
 			langEn: "nanosecond",
@@ -377,6 +806,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "nanosegundo",
 			langIt: "nanosecondo",
 			langDe: "Nanosekunde",
+			langNl: "nanoseconde",
+			langPt: "nanossegundo",
+			langPl: "nanosekunda",
+			langJa: "ナノ秒",
+			langZh: "纳秒",
 		},
 		"nanosecond...": {
 			langEn: "nanoseconds",
@@ -384,6 +818,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "nanosegundos",
 			langIt: "nanosecondi",
 			langDe: "Nanosekunden",
+			langNl: "nanoseconden",
+			langPt: "nanossegundos",
+			langPl: "nanosekund",
+			langJa: "ナノ秒",
+			langZh: "纳秒",
 		},
 		"second": {
 			langEn: "second",
@@ -391,6 +830,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "segundo",
 			langIt: "secondo",
 			langDe: "Sekunde",
+			langNl: "seconde",
+			langPt: "segundo",
+			langPl: "sekunda",
+			langJa: "秒",
+			langZh: "秒",
 		},
 		"second...": {
 			langEn: "seconds",
@@ -398,6 +842,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "segundos",
 			langIt: "secondi",
 			langDe: "Sekunden",
+			langNl: "seconden",
+			langPt: "segundos",
+			langPl: "sekund",
+			langJa: "秒",
+			langZh: "秒",
 		},
 		"week": {
 			langEn: "week",
@@ -405,6 +854,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "semana",
 			langIt: "settimana",
 			langDe: "Woche",
+			langNl: "week",
+			langPt: "semana",
+			langPl: "tydzień",
+			langJa: "週間",
+			langZh: "周",
 		},
 		"week...": {
 			langEn: "weeks",
@@ -412,6 +866,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "semanas",
 			langIt: "settimane",
 			langDe: "Wochen",
+			langNl: "weken",
+			langPt: "semanas",
+			langPl: "tygodni",
+			langJa: "週間",
+			langZh: "周",
 		},
 		"year": {
 			langEn: "year",
@@ -419,6 +878,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "año",
 			langIt: "anno",
 			langDe: "Jahr",
+			langNl: "jaar",
+			langPt: "ano",
+			langPl: "rok",
+			langJa: "年",
+			langZh: "年",
 		},
 		"year...": {
 			langEn: "years",
@@ -426,6 +890,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "años",
 			langIt: "anni",
 			langDe: "Jahre",
+			langNl: "jaar",
+			langPt: "anos",
+			langPl: "lat",
+			langJa: "年",
+			langZh: "年",
 		},
 	},
 	Messages: map[string]map[string]string{
@@ -435,6 +904,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El elemento de matriz JSON debe ser un objeto",
 			langIt: "L'elemento dell'array JSON deve essere un oggetto",
 			langDe: "JSON-Array-Element muss ein Objekt sein",
+			langNl: "JSON-array-element moet een object zijn",
+			langPt: "O elemento da matriz JSON deve ser um objeto",
+			langPl: "Element tablicy JSON musi być obiektem",
+			langJa: "JSON配列の要素はオブジェクトでなければなりません",
+			langZh: "JSON数组元素必须是对象",
 		},
 		msgArrayElementMustNotBeNull: {
 			langEn: msgArrayElementMustNotBeNull,
@@ -442,6 +916,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El elemento de matriz JSON no debe ser nulo",
 			langIt: "L'elemento dell'array JSON non deve essere nullo",
 			langDe: "JSON-Array-Element darf nicht null sein",
+			langNl: "JSON-array-element mag niet null zijn",
+			langPt: "O elemento da matriz JSON não deve ser nulo",
+			langPl: "Element tablicy JSON nie może być null",
+			langJa: "JSON配列の要素はnullであってはなりません",
+			langZh: "JSON数组元素不能为null",
 		},
 		msgArrayUnique: {
 			langEn: msgArrayUnique,
@@ -449,6 +928,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Los elementos del arreglo deben ser únicos",
 			langIt: "Gli elementi dell'array devono essere univoci",
 			langDe: "Array-Elemente müssen eindeutig sein",
+			langNl: "Array-elementen moeten uniek zijn",
+			langPt: "Os elementos da matriz devem ser únicos",
+			langPl: "Elementy tablicy muszą być unikalne",
+			langJa: "配列の要素は一意でなければなりません",
+			langZh: "数组元素必须唯一",
 		},
 		msgDatetimeFuture: {
 			langEn: msgDatetimeFuture,
@@ -456,6 +940,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una fecha/hora válida en el futuro",
 			langIt: "Il valore deve essere una data/ora valida nel futuro",
 			langDe: "Wert muss ein gültiges Datum/Zeit in der Zukunft sein",
+			langNl: "Waarde moet een geldige datum/tijd in de toekomst zijn",
+			langPt: "O valor deve ser uma data/hora válida no futuro",
+			langPl: "Wartość musi być prawidłową datą/godziną w przyszłości",
+			langJa: "値は未来の有効な日時でなければなりません",
+			langZh: "值必须是将来的有效日期/时间",
 		},
 		msgDatetimeFutureOrPresent: {
 			langEn: msgDatetimeFutureOrPresent,
@@ -463,6 +952,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una fecha/hora válida en el futuro o presente",
 			langIt: "Il valore deve essere una data/ora valida futura o presente",
 			langDe: "Wert muss ein gültiges Datum/Zeit in der Zukunft oder Gegenwart sein",
+			langNl: "Waarde moet een geldige datum/tijd in de toekomst of het heden zijn",
+			langPt: "O valor deve ser uma data/hora válida no futuro ou presente",
+			langPl: "Wartość musi być prawidłową datą/godziną w przyszłości lub teraźniejszości",
+			langJa: "値は未来または現在の有効な日時でなければなりません",
+			langZh: "值必须是将来或现在的有效日期/时间",
 		},
 		msgDatetimePast: {
 			langEn: msgDatetimePast,
@@ -470,6 +964,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una fecha/hora válida en el pasado",
 			langIt: "Il valore deve essere una data/ora valida nel passato",
 			langDe: "Wert muss ein gültiges Datum/Zeit in der Vergangenheit sein",
+			langNl: "Waarde moet een geldige datum/tijd in het verleden zijn",
+			langPt: "O valor deve ser uma data/hora válida no passado",
+			langPl: "Wartość musi być prawidłową datą/godziną w przeszłości",
+			langJa: "値は過去の有効な日時でなければなりません",
+			langZh: "值必须是过去的有效日期/时间",
 		},
 		msgDatetimePastOrPresent: {
 			langEn: msgDatetimePastOrPresent,
@@ -477,6 +976,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una fecha/hora válida en el pasado o presente",
 			langIt: "Il valore deve essere una data/ora valida nel passato o nel presente",
 			langDe: "Wert muss ein gültiges Datum/Zeit in der Vergangenheit oder Gegenwart sein",
+			langNl: "Waarde moet een geldige datum/tijd in het verleden of het heden zijn",
+			langPt: "O valor deve ser uma data/hora válida no passado ou presente",
+			langPl: "Wartość musi być prawidłową datą/godziną w przeszłości lub teraźniejszości",
+			langJa: "値は過去または現在の有効な日時でなければなりません",
+			langZh: "值必须是过去或现在的有效日期/时间",
 		},
 		msgErrorReading: {
 			langEn: msgErrorReading,
@@ -484,6 +988,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Error inesperado al leer el lector",
 			langIt: "Errore imprevisto durante la lettura del lettore",
 			langDe: "Unerwarteter Fehler beim Lesen des Lesegeräts",
+			langNl: "Onverwachte fout bij het lezen van de reader",
+			langPt: "Erro inesperado ao ler o leitor",
+			langPl: "Nieoczekiwany błąd podczas odczytu",
+			langJa: "リーダーの読み取り中に予期しないエラーが発生しました",
+			langZh: "读取时发生意外错误",
 		},
 		msgErrorUnmarshall: {
 			langEn: msgErrorUnmarshall,
@@ -491,6 +1000,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Error inesperado durante la desorganización",
 			langIt: "Errore imprevisto durante l'annullamento del marshalling",
 			langDe: "Unerwarteter Fehler beim Unmarshalling",
+			langNl: "Onverwachte fout tijdens het unmarshallen",
+			langPt: "Erro inesperado durante a desserialização",
+			langPl: "Nieoczekiwany błąd podczas deserializacji",
+			langJa: "アンマーシャリング中に予期しないエラーが発生しました",
+			langZh: "反序列化时发生意外错误",
 		},
 		msgExpectedJsonArray: {
 			langEn: msgExpectedJsonArray,
@@ -498,6 +1012,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Se esperaba que JSON fuera una matriz JSON",
 			langIt: "JSON dovrebbe essere un array JSON",
 			langDe: "JSON soll JSON-Array sein",
+			langNl: "JSON moet een JSON-array zijn",
+			langPt: "Esperava-se que o JSON fosse uma matriz JSON",
+			langPl: "Oczekiwano, że JSON będzie tablicą JSON",
+			langJa: "JSONはJSON配列である必要があります",
+			langZh: "JSON应为JSON数组",
 		},
 		msgExpectedJsonObject: {
 			langEn: msgExpectedJsonObject,
@@ -505,6 +1024,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Se esperaba que JSON fuera un objeto JSON",
 			langIt: "JSON dovrebbe essere un oggetto JSON",
 			langDe: "JSON soll JSON-Objekt sein",
+			langNl: "JSON moet een JSON-object zijn",
+			langPt: "Esperava-se que o JSON fosse um objeto JSON",
+			langPl: "Oczekiwano, że JSON będzie obiektem JSON",
+			langJa: "JSONはJSONオブジェクトである必要があります",
+			langZh: "JSON应为JSON对象",
 		},
 		msgFailure: {
 			langEn: msgFailure,
@@ -512,6 +1036,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Validación fallida",
 			langIt: "Convalida non riuscita",
 			langDe: "Validierung fehlgeschlagen",
+			langNl: "Validatie mislukt",
+			langPt: "Falha na validação",
+			langPl: "Walidacja nie powiodła się",
+			langJa: "検証に失敗しました",
+			langZh: "验证失败",
 		},
 		msgInvalidCharacters: {
 			langEn: msgInvalidCharacters,
@@ -519,6 +1048,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de la cadena no debe tener caracteres inválidos",
 			langIt: "Il valore della stringa non deve contenere caratteri non validi",
 			langDe: "String-Wert darf keine ungültigen Zeichen enthalten",
+			langNl: "Tekenreekswaarde mag geen ongeldige tekens bevatten",
+			langPt: "O valor da string não deve ter caracteres inválidos",
+			langPl: "Wartość ciągu nie może zawierać nieprawidłowych znaków",
+			langJa: "文字列値に無効な文字を含めることはできません",
+			langZh: "字符串值不能包含无效字符",
 		},
 		msgInvalidProperty: {
 			langEn: msgInvalidProperty,
@@ -526,6 +1060,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Propiedad no válida",
 			langIt: "Proprietà non valida",
 			langDe: "Ungültige Eigenschaft",
+			langNl: "Ongeldige eigenschap",
+			langPt: "Propriedade inválida",
+			langPl: "Nieprawidłowa właściwość",
+			langJa: "無効なプロパティ",
+			langZh: "无效属性",
 		},
 		msgInvalidPropertyName: {
 			langEn: msgInvalidPropertyName,
@@ -533,6 +1072,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Nombre de propiedad inválido",
 			langIt: "Nome proprietà non valido",
 			langDe: "Ungültiger Eigenschaftsname",
+			langNl: "Ongeldige eigenschapsnaam",
+			langPt: "Nome de propriedade inválido",
+			langPl: "Nieprawidłowa nazwa właściwości",
+			langJa: "無効なプロパティ名",
+			langZh: "无效的属性名称",
 		},
 		msgMissingProperty: {
 			langEn: msgMissingProperty,
@@ -540,6 +1084,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Propiedad faltante",
 			langIt: "Proprietà mancante",
 			langDe: "Fehlende Eigenschaft",
+			langNl: "Ontbrekende eigenschap",
+			langPt: "Propriedade ausente",
+			langPl: "Brakująca właściwość",
+			langJa: "プロパティがありません",
+			langZh: "缺少属性",
 		},
 		msgNegative: {
 			langEn: msgNegative,
@@ -547,6 +1096,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser negativo",
 			langIt: "Il valore deve essere negativo",
 			langDe: "Wert muss negativ sein",
+			langNl: "Waarde moet negatief zijn",
+			langPt: "O valor deve ser negativo",
+			langPl: "Wartość musi być ujemna",
+			langJa: "値は負でなければなりません",
+			langZh: "值必须为负数",
 		},
 		msgNegativeOrZero: {
 			langEn: msgNegativeOrZero,
@@ -554,6 +1108,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser negativo o cero",
 			langIt: "Il valore deve essere negativo o zero",
 			langDe: "Wert muss negativ oder Null sein",
+			langNl: "Waarde moet negatief of nul zijn",
+			langPt: "O valor deve ser negativo ou zero",
+			langPl: "Wartość musi być ujemna lub równa zero",
+			langJa: "値は負またはゼロでなければなりません",
+			langZh: "值必须为负数或零",
 		},
 		msgNoControlChars: {
 			langEn: msgNoControlChars,
@@ -561,6 +1120,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de la cadena no debe contener caracteres de control",
 			langIt: "Il valore della stringa non deve contenere caratteri di controllo",
 			langDe: "Stringwert darf keine Steuerzeichen enthalten",
+			langNl: "Tekenreekswaarde mag geen besturingstekens bevatten",
+			langPt: "O valor da string não deve conter caracteres de controle",
+			langPl: "Wartość ciągu nie może zawierać znaków sterujących",
+			langJa: "文字列値に制御文字を含めることはできません",
+			langZh: "字符串值不能包含控制字符",
 		},
 		msgNotBlankString: {
 			langEn: msgNotBlankString,
@@ -568,6 +1132,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de la cadena no debe ser una cadena en blanco",
 			langIt: "Il valore della stringa non deve essere una stringa vuota",
 			langDe: "String-Wert darf kein leerer String sein",
+			langNl: "Tekenreekswaarde mag geen lege tekenreeks zijn",
+			langPt: "O valor da string não deve ser uma string em branco",
+			langPl: "Wartość ciągu nie może być pustym ciągiem",
+			langJa: "文字列値は空白文字列であってはなりません",
+			langZh: "字符串值不能为空白字符串",
 		},
 		msgNotEmpty: {
 			langEn: msgNotEmpty,
@@ -575,6 +1144,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor no debe estar vacío",
 			langIt: "Il valore non deve essere vuoto",
 			langDe: "Wert darf nicht leer sein",
+			langNl: "Waarde mag niet leeg zijn",
+			langPt: "O valor não deve estar vazio",
+			langPl: "Wartość nie może być pusta",
+			langJa: "値は空であってはなりません",
+			langZh: "值不能为空",
 		},
 		msgNotEmptyString: {
 			langEn: msgNotEmptyString,
@@ -582,6 +1156,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de la cadena no debe ser una cadena vacía",
 			langIt: "Il valore della stringa non deve essere una stringa vuota",
 			langDe: "Stringwert darf kein leerer String sein",
+			langNl: "Tekenreekswaarde mag geen lege tekenreeks zijn",
+			langPt: "O valor da string não deve ser uma string vazia",
+			langPl: "Wartość ciągu nie może być pustym ciągiem znaków",
+			langJa: "文字列値は空文字列であってはなりません",
+			langZh: "字符串值不能为空字符串",
 		},
 		msgNotJsonArray: {
 			langEn: msgNotJsonArray,
@@ -589,6 +1168,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "JSON no debe ser una matriz JSON",
 			langIt: "JSON non deve essere un array JSON",
 			langDe: "JSON darf kein JSON-Array sein",
+			langNl: "JSON mag geen JSON-array zijn",
+			langPt: "O JSON não deve ser uma matriz JSON",
+			langPl: "JSON nie może być tablicą JSON",
+			langJa: "JSONはJSON配列であってはなりません",
+			langZh: "JSON不能是JSON数组",
 		},
 		msgNotJsonNull: {
 			langEn: msgNotJsonNull,
@@ -596,6 +1180,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "JSON no debe ser JSON nulo",
 			langIt: "JSON non deve essere JSON null",
 			langDe: "JSON darf nicht JSON null sein",
+			langNl: "JSON mag geen JSON null zijn",
+			langPt: "O JSON não deve ser JSON null",
+			langPl: "JSON nie może być wartością null JSON",
+			langJa: "JSONはJSON nullであってはなりません",
+			langZh: "JSON不能是JSON null",
 		},
 		msgNotJsonObject: {
 			langEn: msgNotJsonObject,
@@ -603,6 +1192,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "JSON no debe ser un objeto JSON",
 			langIt: "JSON non deve essere un oggetto JSON",
 			langDe: "JSON darf kein JSON-Objekt sein",
+			langNl: "JSON mag geen JSON-object zijn",
+			langPt: "O JSON não deve ser um objeto JSON",
+			langPl: "JSON nie może być obiektem JSON",
+			langJa: "JSONはJSONオブジェクトであってはなりません",
+			langZh: "JSON不能是JSON对象",
 		},
 		msgPositive: {
 			langEn: msgPositive,
@@ -610,6 +1204,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser positivo",
 			langIt: "Il valore deve essere positivo",
 			langDe: "Wert muss positiv sein",
+			langNl: "Waarde moet positief zijn",
+			langPt: "O valor deve ser positivo",
+			langPl: "Wartość musi być dodatnia",
+			langJa: "値は正でなければなりません",
+			langZh: "值必须为正数",
 		},
 		msgPositiveOrZero: {
 			langEn: msgPositiveOrZero,
@@ -617,6 +1216,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser positivo o cero",
 			langIt: "Il valore deve essere positivo o zero",
 			langDe: "Wert muss positiv oder Null sein",
+			langNl: "Waarde moet positief of nul zijn",
+			langPt: "O valor deve ser positivo ou zero",
+			langPl: "Wartość musi być dodatnia lub równa zero",
+			langJa: "値は正またはゼロでなければなりません",
+			langZh: "值必须为正数或零",
 		},
 		msgPropertyObjectValidatorError: {
 			langEn: msgPropertyObjectValidatorError,
@@ -624,6 +1228,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Error del validador de objetos: ¡no permite el objeto o la matriz!",
 			langIt: "Errore del validatore di oggetti - non consente l'oggetto o l'array!",
 			langDe: "Objekt-Validator-Fehler - erlaubt kein Objekt oder Array!",
+			langNl: "Objectvalidatorfout - staat geen object of array toe!",
+			langPt: "Erro do validador de objeto - não permite objeto ou matriz!",
+			langPl: "Błąd walidatora obiektu - nie zezwala na obiekt ani tablicę!",
+			langJa: "オブジェクトバリデーターエラー - オブジェクトまたは配列は許可されません!",
+			langZh: "对象验证器错误 - 不允许对象或数组！",
 		},
 		msgPropertyValueMustBeObject: {
 			langEn: msgPropertyValueMustBeObject,
@@ -631,6 +1240,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de la propiedad debe ser un objeto",
 			langIt: "Il valore della proprietà deve essere un oggetto",
 			langDe: "Eigenschaftswert muss ein Objekt sein",
+			langNl: "Eigenschapswaarde moet een object zijn",
+			langPt: "O valor da propriedade deve ser um objeto",
+			langPl: "Wartość właściwości musi być obiektem",
+			langJa: "プロパティ値はオブジェクトでなければなりません",
+			langZh: "属性值必须是对象",
 		},
 		msgPropertyRequiredWhen: {
 			langEn: msgPropertyRequiredWhen,
@@ -638,6 +1252,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Se requiere propiedad bajo ciertos criterios",
 			langIt: "L'immobile è richiesto secondo determinati criteri",
 			langDe: "Eigentum wird unter bestimmten Kriterien benötigt",
+			langNl: "Eigenschap is onder bepaalde voorwaarden vereist",
+			langPt: "A propriedade é obrigatória sob certos critérios",
+			langPl: "Właściwość jest wymagana w określonych warunkach",
+			langJa: "特定の条件下ではプロパティが必須です",
+			langZh: "在某些条件下属性是必需的",
 		},
 		msgPropertyUnwantedWhen: {
 			langEn: msgPropertyUnwantedWhen,
@@ -645,6 +1264,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La propiedad no debe estar presente bajo ciertas condiciones",
 			langIt: "L'immobile non deve essere presente in determinate condizioni",
 			langDe: "Die Immobilie darf unter bestimmten Voraussetzungen nicht vorhanden sein",
+			langNl: "Eigenschap mag onder bepaalde voorwaarden niet aanwezig zijn",
+			langPt: "A propriedade não deve estar presente sob certos critérios",
+			langPl: "Właściwość nie może występować w określonych warunkach",
+			langJa: "特定の条件下ではプロパティが存在してはなりません",
+			langZh: "在某些条件下属性不得存在",
 		},
 		msgRequestBodyEmpty: {
 			langEn: msgRequestBodyEmpty,
@@ -652,6 +1276,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El cuerpo de la solicitud está vacío",
 			langIt: "Il corpo della richiesta è vuoto",
 			langDe: "Anfragetext ist leer",
+			langNl: "Request body is leeg",
+			langPt: "O corpo da requisição está vazio",
+			langPl: "Treść żądania jest pusta",
+			langJa: "リクエスト本文が空です",
+			langZh: "请求正文为空",
 		},
 		msgRequestBodyExpectedJsonArray: {
 			langEn: msgRequestBodyExpectedJsonArray,
@@ -659,6 +1288,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Se espera que el cuerpo de la solicitud sea una matriz JSON",
 			langIt: "Il corpo della richiesta dovrebbe essere un array JSON",
 			langDe: "Anforderungstext soll JSON-Array sein",
+			langNl: "Request body moet een JSON-array zijn",
+			langPt: "Esperava-se que o corpo da requisição fosse uma matriz JSON",
+			langPl: "Oczekiwano, że treść żądania będzie tablicą JSON",
+			langJa: "リクエスト本文はJSON配列である必要があります",
+			langZh: "请求正文应为JSON数组",
 		},
 		msgRequestBodyExpectedJsonObject: {
 			langEn: msgRequestBodyExpectedJsonObject,
@@ -666,6 +1300,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Se espera que el cuerpo de la solicitud sea un objeto JSON",
 			langIt: "Il corpo della richiesta dovrebbe essere un oggetto JSON",
 			langDe: "Anfragetext soll JSON-Objekt sein",
+			langNl: "Request body moet een JSON-object zijn",
+			langPt: "Esperava-se que o corpo da requisição fosse um objeto JSON",
+			langPl: "Oczekiwano, że treść żądania będzie obiektem JSON",
+			langJa: "リクエスト本文はJSONオブジェクトである必要があります",
+			langZh: "请求正文应为JSON对象",
 		},
 		msgRequestBodyNotJsonArray: {
 			langEn: msgRequestBodyNotJsonArray,
@@ -673,6 +1312,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El cuerpo de la solicitud no debe ser una matriz JSON",
 			langIt: "Il corpo della richiesta non deve essere un array JSON",
 			langDe: "Anfragetext darf kein JSON-Array sein",
+			langNl: "Request body mag geen JSON-array zijn",
+			langPt: "O corpo da requisição não deve ser uma matriz JSON",
+			langPl: "Treść żądania nie może być tablicą JSON",
+			langJa: "リクエスト本文はJSON配列であってはなりません",
+			langZh: "请求正文不能是JSON数组",
 		},
 		msgRequestBodyNotJsonNull: {
 			langEn: msgRequestBodyNotJsonNull,
@@ -680,6 +1324,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El cuerpo de la solicitud no debe ser JSON nulo",
 			langIt: "Il corpo della richiesta non deve essere JSON null",
 			langDe: "Anfragetext darf nicht JSON null sein",
+			langNl: "Request body mag geen JSON null zijn",
+			langPt: "O corpo da requisição não deve ser JSON null",
+			langPl: "Treść żądania nie może być wartością null JSON",
+			langJa: "リクエスト本文はJSON nullであってはなりません",
+			langZh: "请求正文不能是JSON null",
 		},
 		msgRequestBodyNotJsonObject: {
 			langEn: msgRequestBodyNotJsonObject,
@@ -687,6 +1336,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El cuerpo de la solicitud no debe ser un objeto JSON",
 			langIt: "Il corpo della richiesta non deve essere un oggetto JSON",
 			langDe: "Anfragetext darf kein JSON-Objekt sein",
+			langNl: "Request body mag geen JSON-object zijn",
+			langPt: "O corpo da requisição não deve ser um objeto JSON",
+			langPl: "Treść żądania nie może być obiektem JSON",
+			langJa: "リクエスト本文はJSONオブジェクトであってはなりません",
+			langZh: "请求正文不能是JSON对象",
 		},
 		msgStringValidJson: {
 			langEn: msgStringValidJson,
@@ -694,6 +1348,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de cadena debe ser JSON válido",
 			langIt: "Il valore della stringa deve essere JSON valido",
 			langDe: "Zeichenfolgenwert muss gültiges JSON sein",
+			langNl: "Tekenreekswaarde moet geldige JSON zijn",
+			langPt: "O valor da string deve ser um JSON válido",
+			langPl: "Wartość ciągu musi być prawidłowym JSON",
+			langJa: "文字列値は有効なJSONでなければなりません",
+			langZh: "字符串值必须是有效的JSON",
 		},
 		msgStringLowercase: {
 			langEn: msgStringLowercase,
@@ -701,6 +1360,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de la cadena debe contener solo letras minúsculas",
 			langIt: "Il valore della stringa deve contenere solo lettere minuscole",
 			langDe: "Stringwert darf nur Kleinbuchstaben enthalten",
+			langNl: "Tekenreekswaarde mag alleen kleine letters bevatten",
+			langPt: "O valor da string deve conter apenas letras minúsculas",
+			langPl: "Wartość ciągu może zawierać tylko małe litery",
+			langJa: "文字列値には小文字のみを含める必要があります",
+			langZh: "字符串值只能包含小写字母",
 		},
 		msgStringUppercase: {
 			langEn: msgStringUppercase,
@@ -708,6 +1372,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de la cadena debe contener solo letras mayúsculas",
 			langIt: "Il valore della stringa deve contenere solo lettere maiuscole",
 			langDe: "String-Wert darf nur Großbuchstaben enthalten",
+			langNl: "Tekenreekswaarde mag alleen hoofdletters bevatten",
+			langPt: "O valor da string deve conter apenas letras maiúsculas",
+			langPl: "Wartość ciągu może zawierać tylko wielkie litery",
+			langJa: "文字列値には大文字のみを含める必要があります",
+			langZh: "字符串值只能包含大写字母",
 		},
 		msgUnableToDecode: {
 			langEn: msgUnableToDecode,
@@ -715,6 +1384,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "No se puede decodificar como JSON",
 			langIt: "Impossibile decodificare come JSON",
 			langDe: "Als JSON kann nicht dekodiert werden",
+			langNl: "Kan niet decoderen als JSON",
+			langPt: "Não foi possível decodificar como JSON",
+			langPl: "Nie można zdekodować jako JSON",
+			langJa: "JSONとしてデコードできません",
+			langZh: "无法解码为JSON",
 		},
 		msgUnableToDecodeRequest: {
 			langEn: msgUnableToDecodeRequest,
@@ -722,6 +1396,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "No se puede decodificar el cuerpo de la solicitud como JSON",
 			langIt: "Impossibile decodificare il corpo della richiesta come JSON",
 			langDe: "Anforderungstext konnte nicht als JSON entschlüsselt werden",
+			langNl: "Kan request body niet decoderen als JSON",
+			langPt: "Não foi possível decodificar o corpo da requisição como JSON",
+			langPl: "Nie można zdekodować treści żądania jako JSON",
+			langJa: "リクエスト本文をJSONとしてデコードできません",
+			langZh: "无法将请求正文解码为JSON",
 		},
 		msgUnicodeNormalization: {
 			langEn: msgUnicodeNormalization,
@@ -729,6 +1408,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de la cadena debe ser la forma de normalización correcta",
 			langIt: "Il valore della stringa deve essere un modulo di normalizzazione corretto",
 			langDe: "String-Wert muss korrekte Normalisierungsform sein",
+			langNl: "Tekenreekswaarde moet de juiste normalisatievorm hebben",
+			langPt: "O valor da string deve estar na forma de normalização correta",
+			langPl: "Wartość ciągu musi mieć prawidłową formę normalizacji",
+			langJa: "文字列値は正しい正規化形式でなければなりません",
+			langZh: "字符串值必须是正确的规范化形式",
 		},
 		msgUnicodeNormalizationNFC: {
 			langEn: msgUnicodeNormalizationNFC,
@@ -736,6 +1420,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de la cadena debe ser la normalización correcta de NFC",
 			langIt: "Il valore della stringa deve essere la normalizzazione corretta da NFC",
 			langDe: "Stringwert muss korrekte Normalisierung von NFC sein",
+			langNl: "Tekenreekswaarde moet de juiste normalisatievorm NFC hebben",
+			langPt: "O valor da string deve estar na forma de normalização correta NFC",
+			langPl: "Wartość ciągu musi mieć prawidłową formę normalizacji NFC",
+			langJa: "文字列値は正しい正規化形式NFCでなければなりません",
+			langZh: "字符串值必须是正确的规范化形式NFC",
 		},
 		msgUnicodeNormalizationNFD: {
 			langEn: msgUnicodeNormalizationNFD,
@@ -743,6 +1432,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de la cadena debe ser el formulario de normalización correcto NFD",
 			langIt: "Il valore della stringa deve essere la normalizzazione corretta dal modulo NFD",
 			langDe: "String-Wert muss korrekte Normalisierung von NFD sein",
+			langNl: "Tekenreekswaarde moet de juiste normalisatievorm NFD hebben",
+			langPt: "O valor da string deve estar na forma de normalização correta NFD",
+			langPl: "Wartość ciągu musi mieć prawidłową formę normalizacji NFD",
+			langJa: "文字列値は正しい正規化形式NFDでなければなりません",
+			langZh: "字符串值必须是正确的规范化形式NFD",
 		},
 		msgUnicodeNormalizationNFKC: {
 			langEn: msgUnicodeNormalizationNFKC,
@@ -750,6 +1444,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de la cadena debe ser el formulario de normalización correcto NFKC",
 			langIt: "Il valore della stringa deve essere la normalizzazione corretta da NFKC",
 			langDe: "String-Wert muss korrekte Normalisierung von NFKC sein",
+			langNl: "Tekenreekswaarde moet de juiste normalisatievorm NFKC hebben",
+			langPt: "O valor da string deve estar na forma de normalização correta NFKC",
+			langPl: "Wartość ciągu musi mieć prawidłową formę normalizacji NFKC",
+			langJa: "文字列値は正しい正規化形式NFKCでなければなりません",
+			langZh: "字符串值必须是正确的规范化形式NFKC",
 		},
 		msgUnicodeNormalizationNFKD: {
 			langEn: msgUnicodeNormalizationNFKD,
@@ -757,6 +1456,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de la cadena debe ser el formulario de normalización correcto NFKD",
 			langIt: "Il valore della stringa deve essere la normalizzazione corretta da NFKD",
 			langDe: "String-Wert muss korrekte Normalisierung von NFKD sein",
+			langNl: "Tekenreekswaarde moet de juiste normalisatievorm NFKD hebben",
+			langPt: "O valor da string deve estar na forma de normalização correta NFKD",
+			langPl: "Wartość ciągu musi mieć prawidłową formę normalizacji NFKD",
+			langJa: "文字列値は正しい正規化形式NFKDでなければなりません",
+			langZh: "字符串值必须是正确的规范化形式NFKD",
 		},
 		msgUnknownProperty: {
 			langEn: msgUnknownProperty,
@@ -764,6 +1468,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Propiedad desconocida",
 			langIt: "Proprietà sconosciuta",
 			langDe: "Unbekanntes Eigentum",
+			langNl: "Onbekende eigenschap",
+			langPt: "Propriedade desconhecida",
+			langPl: "Nieznana właściwość",
+			langJa: "不明なプロパティ",
+			langZh: "未知属性",
 		},
 		msgOnlyProperty: {
 			langEn: msgOnlyProperty,
@@ -771,6 +1480,23 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La propiedad no puede estar presente con otras propiedades",
 			langIt: "L'immobile non può essere presente con altri immobili",
 			langDe: "Eigenschaft kann nicht mit anderen Eigenschaften vorhanden sein",
+			langNl: "Eigenschap kan niet samen met andere eigenschappen aanwezig zijn",
+			langPt: "A propriedade não pode estar presente com outras propriedades",
+			langPl: "Właściwość nie może występować razem z innymi właściwościami",
+			langJa: "プロパティは他のプロパティと一緒に存在できません",
+			langZh: "属性不能与其他属性同时存在",
+		},
+		msgDeprecatedProperty: {
+			langEn: msgDeprecatedProperty,
+			langFr: "La propriété est obsolète",
+			langEs: "La propiedad está obsoleta",
+			langIt: "La proprietà è deprecata",
+			langDe: "Eigenschaft ist veraltet",
+			langNl: "Eigenschap is verouderd",
+			langPt: "A propriedade está obsoleta",
+			langPl: "Właściwość jest przestarzała",
+			langJa: "プロパティは非推奨です",
+			langZh: "属性已弃用",
 		},
 		msgUnwantedProperty: {
 			langEn: msgUnwantedProperty,
@@ -778,6 +1504,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La propiedad no debe estar presente",
 			langIt: "L'immobile non deve essere presente",
 			langDe: "Eigenschaft darf nicht vorhanden sein",
+			langNl: "Eigenschap mag niet aanwezig zijn",
+			langPt: "A propriedade não deve estar presente",
+			langPl: "Właściwość nie może występować",
+			langJa: "プロパティが存在してはなりません",
+			langZh: "属性不得存在",
 		},
 		msgValueCannotBeNull: {
 			langEn: msgValueCannotBeNull,
@@ -785,6 +1516,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor no puede ser nulo",
 			langIt: "Il valore non può essere nullo",
 			langDe: "Wert darf nicht null sein",
+			langNl: "Waarde mag niet null zijn",
+			langPt: "O valor não pode ser nulo",
+			langPl: "Wartość nie może być null",
+			langJa: "値はnullにできません",
+			langZh: "值不能为null",
 		},
 		msgNull: {
 			langEn: msgNull,
@@ -792,6 +1528,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser nulo",
 			langIt: "Il valore deve essere nullo",
 			langDe: "Wert muss null sein",
+			langNl: "Waarde moet null zijn",
+			langPt: "O valor deve ser nulo",
+			langPl: "Wartość musi być null",
+			langJa: "値はnullでなければなりません",
+			langZh: "值必须为null",
 		},
 		msgValueMustBeArray: {
 			langEn: msgValueMustBeArray,
@@ -799,6 +1540,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una matriz",
 			langIt: "Il valore deve essere un array",
 			langDe: "Wert muss ein Array sein",
+			langNl: "Waarde moet een array zijn",
+			langPt: "O valor deve ser uma matriz",
+			langPl: "Wartość musi być tablicą",
+			langJa: "値は配列でなければなりません",
+			langZh: "值必须是数组",
 		},
 		msgValueMustBeObject: {
 			langEn: msgValueMustBeObject,
@@ -806,6 +1552,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un objeto",
 			langIt: "Il valore deve essere un oggetto",
 			langDe: "Wert muss ein Objekt sein",
+			langNl: "Waarde moet een object zijn",
+			langPt: "O valor deve ser um objeto",
+			langPl: "Wartość musi być obiektem",
+			langJa: "値はオブジェクトでなければなりません",
+			langZh: "值必须是对象",
 		},
 		msgValueMustBeObjectOrArray: {
 			langEn: msgValueMustBeObjectOrArray,
@@ -813,6 +1564,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un objeto o matriz",
 			langIt: "Il valore deve essere un oggetto o un array",
 			langDe: "Wert muss ein Objekt oder Array sein",
+			langNl: "Waarde moet een object of array zijn",
+			langPt: "O valor deve ser um objeto ou matriz",
+			langPl: "Wartość musi być obiektem lub tablicą",
+			langJa: "値はオブジェクトまたは配列でなければなりません",
+			langZh: "值必须是对象或数组",
 		},
 		msgValidCardNumber: {
 			langEn: msgValidCardNumber,
@@ -820,6 +1576,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un número de tarjeta válido",
 			langIt: "Il valore deve essere un numero di carta valido",
 			langDe: "Wert muss eine gültige Kartennummer sein",
+			langNl: "Waarde moet een geldig kaartnummer zijn",
+			langPt: "O valor deve ser um número de cartão válido",
+			langPl: "Wartość musi być prawidłowym numerem karty",
+			langJa: "値は有効なカード番号でなければなりません",
+			langZh: "值必须是有效的卡号",
 		},
 		msgValidCountryCode: {
 			langEn: msgValidCountryCode,
@@ -827,6 +1588,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código de país ISO-3166 válido",
 			langIt: "Il valore deve essere un codice paese ISO-3166 valido",
 			langDe: "Wert muss ein gültiger ISO-3166-Ländercode sein",
+			langNl: "Waarde moet een geldige ISO-3166-landcode zijn",
+			langPt: "O valor deve ser um código de país ISO-3166 válido",
+			langPl: "Wartość musi być prawidłowym kodem kraju ISO-3166",
+			langJa: "値は有効なISO-3166国コードでなければなりません",
+			langZh: "值必须是有效的ISO-3166国家代码",
 		},
 		msgValidCurrencyCode: {
 			langEn: msgValidCurrencyCode,
@@ -834,6 +1600,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código de moneda ISO-4217 válido",
 			langIt: "Il valore deve essere un codice valuta ISO-4217 valido",
 			langDe: "Wert muss ein gültiger ISO-4217-Währungscode sein",
+			langNl: "Waarde moet een geldige ISO-4217-valutacode zijn",
+			langPt: "O valor deve ser um código de moeda ISO-4217 válido",
+			langPl: "Wartość musi być prawidłowym kodem waluty ISO-4217",
+			langJa: "値は有効なISO-4217通貨コードでなければなりません",
+			langZh: "值必须是有效的ISO-4217货币代码",
 		},
 		msgValidEmail: {
 			langEn: msgValidEmail,
@@ -841,6 +1612,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una dirección de correo electrónico",
 			langIt: "Il valore deve essere un indirizzo email",
 			langDe: "Wert muss eine E-Mail-Adresse sein",
+			langNl: "Waarde moet een e-mailadres zijn",
+			langPt: "O valor deve ser um endereço de e-mail",
+			langPl: "Wartość musi być adresem e-mail",
+			langJa: "値はメールアドレスでなければなりません",
+			langZh: "值必须是电子邮件地址",
 		},
 		msgValidLanguageCode: {
 			langEn: msgValidLanguageCode,
@@ -848,6 +1624,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código de idioma válido",
 			langIt: "Il valore deve essere un codice lingua valido",
 			langDe: "Wert muss ein gültiger Sprachcode sein",
+			langNl: "Waarde moet een geldige taalcode zijn",
+			langPt: "O valor deve ser um código de idioma válido",
+			langPl: "Wartość musi być prawidłowym kodem języka",
+			langJa: "値は有効な言語コードでなければなりません",
+			langZh: "值必须是有效的语言代码",
 		},
 		msgValidISODate: {
 			langEn: msgValidISODate,
@@ -855,6 +1636,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una cadena de fecha válida (formato: AAAA-MM-DD)",
 			langIt: "Il valore deve essere una stringa di data valida (formato: AAAA-MM-GG)",
 			langDe: "Wert muss eine gültige Datumszeichenfolge sein (Format: JJJJ-MM-TT)",
+			langNl: "Waarde moet een geldige datumtekenreeks zijn (formaat: JJJJ-MM-DD)",
+			langPt: "O valor deve ser uma string de data válida (formato: AAAA-MM-DD)",
+			langPl: "Wartość musi być prawidłowym ciągiem daty (format: RRRR-MM-DD)",
+			langJa: "値は有効な日付文字列でなければなりません (形式: YYYY-MM-DD)",
+			langZh: "值必须是有效的日期字符串（格式：YYYY-MM-DD）",
 		},
 		msgValidISODatetimeFormatFull: {
 			langEn: msgValidISODatetimeFormatFull,
@@ -862,6 +1648,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una cadena de fecha/hora válida (formato: AAAA-MM-DDThh: mm:ss.sss [Z|+- hh:mm ])",
 			langIt: "Il valore deve essere una stringa di data/ora valida (formato: AAAA-MM-GGThh: mm:ss.sss [Z|+- hh:mm ])",
 			langDe: "Wert muss ein gültiger Datums-/Uhrzeit-String sein (Format: YYYY-MM-DDThh: mm:ss.sss [Z|+- hh:mm ])",
+			langNl: "Waarde moet een geldige datum/tijd-tekenreeks zijn (formaat: JJJJ-MM-DDThh:mm:ss.sss[Z|+-hh:mm])",
+			langPt: "O valor deve ser uma string de data/hora válida (formato: AAAA-MM-DDThh:mm:ss.sss[Z|+-hh:mm])",
+			langPl: "Wartość musi być prawidłowym ciągiem daty/godziny (format: RRRR-MM-DDThh:mm:ss.sss[Z|+-hh:mm])",
+			langJa: "値は有効な日時文字列でなければなりません (形式: YYYY-MM-DDThh:mm:ss.sss[Z|+-hh:mm])",
+			langZh: "值必须是有效的日期/时间字符串（格式：YYYY-MM-DDThh:mm:ss.sss[Z|+-hh:mm]）",
 		},
 		msgValidISODatetimeFormatMin: {
 			langEn: msgValidISODatetimeFormatMin,
@@ -869,6 +1660,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una cadena de fecha/hora válida (formato: AAAA-MM-DDThh: mm:ss)",
 			langIt: "Il valore deve essere una stringa di data/ora valida (formato: AAAA-MM-GGThh: mm:ss)",
 			langDe: "Wert muss ein gültiger Datums-/Uhrzeit-String sein (Format: YYYY-MM-DDThh: mm:ss )",
+			langNl: "Waarde moet een geldige datum/tijd-tekenreeks zijn (formaat: JJJJ-MM-DDThh:mm:ss)",
+			langPt: "O valor deve ser uma string de data/hora válida (formato: AAAA-MM-DDThh:mm:ss)",
+			langPl: "Wartość musi być prawidłowym ciągiem daty/godziny (format: RRRR-MM-DDThh:mm:ss)",
+			langJa: "値は有効な日時文字列でなければなりません (形式: YYYY-MM-DDThh:mm:ss)",
+			langZh: "值必须是有效的日期/时间字符串（格式：YYYY-MM-DDThh:mm:ss）",
 		},
 		msgValidISODatetimeFormatNoOffs: {
 			langEn: msgValidISODatetimeFormatNoOffs,
@@ -876,6 +1672,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una cadena de fecha/hora válida (formato: AAAA-MM-DDThh: mm:ss.sss)",
 			langIt: "Il valore deve essere una stringa di data/ora valida (formato: AAAA-MM-GGThh: mm:ss.sss)",
 			langDe: "Wert muss ein gültiger Datums-/Uhrzeit-String sein (Format: YYYY-MM-DDThh: mm:ss.sss )",
+			langNl: "Waarde moet een geldige datum/tijd-tekenreeks zijn (formaat: JJJJ-MM-DDThh:mm:ss.sss)",
+			langPt: "O valor deve ser uma string de data/hora válida (formato: AAAA-MM-DDThh:mm:ss.sss)",
+			langPl: "Wartość musi być prawidłowym ciągiem daty/godziny (format: RRRR-MM-DDThh:mm:ss.sss)",
+			langJa: "値は有効な日時文字列でなければなりません (形式: YYYY-MM-DDThh:mm:ss.sss)",
+			langZh: "值必须是有效的日期/时间字符串（格式：YYYY-MM-DDThh:mm:ss.sss）",
 		},
 		msgValidISODatetimeFormatNoMillis: {
 			langEn: msgValidISODatetimeFormatNoMillis,
@@ -883,6 +1684,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una cadena de fecha/hora válida (formato: AAAA-MM-DDThh: mm:ss [Z|+- hh:mm ])",
 			langIt: "Il valore deve essere una stringa di data/ora valida (formato: AAAA-MM-GGThh: mm:ss [Z|+- hh:mm ])",
 			langDe: "Wert muss ein gültiger Datums-/Uhrzeit-String sein (Format: YYYY-MM-DDThh: mm:ss [Z|+- hh:mm ])",
+			langNl: "Waarde moet een geldige datum/tijd-tekenreeks zijn (formaat: JJJJ-MM-DDThh:mm:ss[Z|+-hh:mm])",
+			langPt: "O valor deve ser uma string de data/hora válida (formato: AAAA-MM-DDThh:mm:ss[Z|+-hh:mm])",
+			langPl: "Wartość musi być prawidłowym ciągiem daty/godziny (format: RRRR-MM-DDThh:mm:ss[Z|+-hh:mm])",
+			langJa: "値は有効な日時文字列でなければなりません (形式: YYYY-MM-DDThh:mm:ss[Z|+-hh:mm])",
+			langZh: "值必须是有效的日期/时间字符串（格式：YYYY-MM-DDThh:mm:ss[Z|+-hh:mm]）",
 		},
 		msgDatetimeDayOfWeek: {
 			langEn: msgDatetimeDayOfWeek,
@@ -890,6 +1696,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un día válido de la semana",
 			langIt: "Il valore deve essere un giorno valido della settimana",
 			langDe: "Wert muss ein gültiger Wochentag sein",
+			langNl: "Waarde moet een geldige dag van de week zijn",
+			langPt: "O valor deve ser um dia da semana válido",
+			langPl: "Wartość musi być prawidłowym dniem tygodnia",
+			langJa: "値は有効な曜日でなければなりません",
+			langZh: "值必须是有效的星期几",
 		},
 		msgValidISODuration: {
 			langEn: msgValidISODuration,
@@ -897,6 +1708,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una duración ISO 8601 válida",
 			langIt: "Il valore deve essere una durata ISO 8601 valida",
 			langDe: "Wert muss eine gültige Dauer nach ISO 8601 sein",
+			langNl: "Waarde moet een geldige ISO 8601-duur zijn",
+			langPt: "O valor deve ser uma duração ISO 8601 válida",
+			langPl: "Wartość musi być prawidłowym czasem trwania ISO 8601",
+			langJa: "値は有効なISO 8601期間でなければなりません",
+			langZh: "值必须是有效的ISO 8601持续时间",
 		},
 		msgValidTimezone: {
 			langEn: msgValidTimezone,
@@ -904,6 +1720,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una zona horaria válida",
 			langIt: "Il valore deve essere un fuso orario valido",
 			langDe: "Wert muss eine gültige Zeitzone sein",
+			langNl: "Waarde moet een geldige tijdzone zijn",
+			langPt: "O valor deve ser um fuso horário válido",
+			langPl: "Wartość musi być prawidłową strefą czasową",
+			langJa: "値は有効なタイムゾーンでなければなりません",
+			langZh: "值必须是有效的时区",
 		},
 		msgValidPattern: {
 			langEn: msgValidPattern,
@@ -911,6 +1732,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de la cadena debe tener un patrón válido",
 			langIt: "Il valore della stringa deve avere un modello valido",
 			langDe: "String-Wert muss gültiges Muster haben",
+			langNl: "Tekenreekswaarde moet een geldig patroon hebben",
+			langPt: "O valor da string deve ter um padrão válido",
+			langPl: "Wartość ciągu musi mieć prawidłowy wzorzec",
+			langJa: "文字列値は有効なパターンでなければなりません",
+			langZh: "字符串值必须具有有效的模式",
 		},
 		msgValidUuid: {
 			langEn: msgValidUuid,
@@ -918,6 +1744,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un UUID válido",
 			langIt: "Il valore deve essere un UUID valido",
 			langDe: "Wert muss eine gültige UUID sein",
+			langNl: "Waarde moet een geldige UUID zijn",
+			langPt: "O valor deve ser um UUID válido",
+			langPl: "Wartość musi być prawidłowym UUID",
+			langJa: "値は有効なUUIDでなければなりません",
+			langZh: "值必须是有效的UUID",
 		},
 		msgPresetAlpha: {
 			langEn: msgPresetAlpha,
@@ -925,6 +1756,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser solo caracteres alfabéticos (A-Z, a-z)",
 			langIt: "Il valore deve essere solo caratteri alfabetici (A-Z, a-z)",
 			langDe: "Wert darf nur aus Buchstaben bestehen (A-Z, a-z)",
+			langNl: "Waarde mag alleen letters bevatten (A-Z, a-z)",
+			langPt: "O valor deve conter apenas caracteres alfabéticos (A-Z, a-z)",
+			langPl: "Wartość może zawierać tylko litery (A-Z, a-z)",
+			langJa: "値には英字 (A-Z, a-z) のみを使用できます",
+			langZh: "值只能包含字母字符（A-Z, a-z）",
 		},
 		msgPresetAlphaNumeric: {
 			langEn: msgPresetAlphaNumeric,
@@ -932,6 +1768,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser solo caracteres alfanuméricos (A-Z, a-z, 0-9)",
 			langIt: "Il valore deve essere solo caratteri alfanumerici (A-Z, a-z, 0-9)",
 			langDe: "Wert darf nur aus alphanumerischen Zeichen bestehen (A-Z, a-z, 0-9)",
+			langNl: "Waarde mag alleen alfanumerieke tekens bevatten (A-Z, a-z, 0-9)",
+			langPt: "O valor deve conter apenas caracteres alfanuméricos (A-Z, a-z, 0-9)",
+			langPl: "Wartość może zawierać tylko znaki alfanumeryczne (A-Z, a-z, 0-9)",
+			langJa: "値には英数字 (A-Z, a-z, 0-9) のみを使用できます",
+			langZh: "值只能包含字母数字字符（A-Z, a-z, 0-9）",
 		},
 		msgPresetBarcode: {
 			langEn: "Value must be a valid barcode",
@@ -939,6 +1780,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código de barras válido",
 			langIt: "Il valore deve essere un codice a barre valido",
 			langDe: "Wert muss ein gültiger Strichcode sein",
+			langNl: "Waarde moet een geldige streepjescode zijn",
+			langPt: "O valor deve ser um código de barras válido",
+			langPl: "Wartość musi być prawidłowym kodem kreskowym",
+			langJa: "値は有効なバーコードでなければなりません",
+			langZh: "值必须是有效的条形码",
 		},
 		msgPresetBase64: {
 			langEn: msgPresetBase64,
@@ -946,6 +1792,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una cadena codificada en base64 válida",
 			langIt: "Il valore deve essere una stringa codificata base64 valida",
 			langDe: "Wert muss eine gültige base64-codierte Zeichenfolge sein",
+			langNl: "Waarde moet een geldige base64-gecodeerde tekenreeks zijn",
+			langPt: "O valor deve ser uma string codificada em base64 válida",
+			langPl: "Wartość musi być prawidłowym ciągiem zakodowanym w base64",
+			langJa: "値は有効なbase64エンコード文字列でなければなりません",
+			langZh: "值必须是有效的base64编码字符串",
 		},
 		msgPresetBase64URL: {
 			langEn: msgPresetBase64URL,
@@ -953,6 +1804,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una cadena codificada en URL base64 válida",
 			langIt: "Il valore deve essere una stringa codificata URL base64 valida",
 			langDe: "Wert muss eine gültige Base64-URL-codierte Zeichenfolge sein",
+			langNl: "Waarde moet een geldige base64-URL-gecodeerde tekenreeks zijn",
+			langPt: "O valor deve ser uma string codificada em base64 URL válida",
+			langPl: "Wartość musi być prawidłowym ciągiem zakodowanym w base64 URL",
+			langJa: "値は有効なbase64 URLエンコード文字列でなければなりません",
+			langZh: "值必须是有效的base64 URL编码字符串",
 		},
 		msgPresetCMYK: {
 			langEn:  msgPresetCMYK,
@@ -961,6 +1817,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs:  "El valor debe ser una cadena de color cmyk() válida",
 			langIt:  "Il valore deve essere una stringa di colore cmyk() valida",
 			langDe:  "Wert muss eine gültige cmyk()-Farbzeichenfolge sein",
+			langNl:  "Waarde moet een geldige cmyk()-kleurtekenreeks zijn",
+			langPt:  "O valor deve ser uma string de cor cmyk() válida",
+			langPl:  "Wartość musi być prawidłowym ciągiem koloru cmyk()",
+			langJa:  "値は有効なcmyk()カラー文字列でなければなりません",
+			langZh:  "值必须是有效的cmyk()颜色字符串",
 		},
 		msgPresetCMYK300: {
 			langEn:  msgPresetCMYK300,
@@ -969,6 +1830,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs:  "El valor debe ser una cadena de color cmyk() válida (máximo 300 %)",
 			langIt:  "Il valore deve essere una stringa di colore cmyk() valida (massimo 300%)",
 			langDe:  "Wert muss eine gültige cmyk()-Farbzeichenfolge sein (maximal 300 %)",
+			langNl:  "Waarde moet een geldige cmyk()-kleurtekenreeks zijn (maximaal 300%)",
+			langPt:  "O valor deve ser uma string de cor cmyk() válida (máximo 300%)",
+			langPl:  "Wartość musi być prawidłowym ciągiem koloru cmyk() (maksymalnie 300%)",
+			langJa:  "値は有効なcmyk()カラー文字列でなければなりません (最大300%)",
+			langZh:  "值必须是有效的cmyk()颜色字符串（最大300%）",
 		},
 		msgPresetE164: {
 			langEn: msgPresetE164,
@@ -976,6 +1842,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código E.164 válido",
 			langIt: "Il valore deve essere un codice E.164 valido",
 			langDe: "Wert muss ein gültiger E.164-Code sein",
+			langNl: "Waarde moet een geldige E.164-code zijn",
+			langPt: "O valor deve ser um código E.164 válido",
+			langPl: "Wartość musi być prawidłowym kodem E.164",
+			langJa: "値は有効なE.164コードでなければなりません",
+			langZh: "值必须是有效的E.164代码",
 		},
 		msgPresetEAN: {
 			langEn: msgPresetEAN,
@@ -983,6 +1854,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código EAN válido",
 			langIt: "Il valore deve essere un codice EAN valido",
 			langDe: "Wert muss ein gültiger EAN-Code sein",
+			langNl: "Waarde moet een geldige EAN-code zijn",
+			langPt: "O valor deve ser um código EAN válido",
+			langPl: "Wartość musi być prawidłowym kodem EAN",
+			langJa: "値は有効なEANコードでなければなりません",
+			langZh: "值必须是有效的EAN代码",
 		},
 		msgPresetEAN8: {
 			langEn: msgPresetEAN8,
@@ -990,6 +1866,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código EAN-8 válido",
 			langIt: "Il valore deve essere un codice EAN-8 valido",
 			langDe: "Wert muss ein gültiger EAN-8-Code sein",
+			langNl: "Waarde moet een geldige EAN-8-code zijn",
+			langPt: "O valor deve ser um código EAN-8 válido",
+			langPl: "Wartość musi być prawidłowym kodem EAN-8",
+			langJa: "値は有効なEAN-8コードでなければなりません",
+			langZh: "值必须是有效的EAN-8代码",
 		},
 		msgPresetEAN13: {
 			langEn: msgPresetEAN13,
@@ -997,6 +1878,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código EAN-13 válido",
 			langIt: "Il valore deve essere un codice EAN-13 valido",
 			langDe: "Wert muss ein gültiger EAN-13-Code sein",
+			langNl: "Waarde moet een geldige EAN-13-code zijn",
+			langPt: "O valor deve ser um código EAN-13 válido",
+			langPl: "Wartość musi być prawidłowym kodem EAN-13",
+			langJa: "値は有効なEAN-13コードでなければなりません",
+			langZh: "值必须是有效的EAN-13代码",
 		},
 		msgPresetDUN14: {
 			langEn: msgPresetDUN14,
@@ -1004,6 +1890,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código DUN-14 válido",
 			langIt: "Il valore deve essere un codice DUN-14 valido",
 			langDe: "Wert muss ein gültiger DUN-14-Code sein",
+			langNl: "Waarde moet een geldige DUN-14-code zijn",
+			langPt: "O valor deve ser um código DUN-14 válido",
+			langPl: "Wartość musi być prawidłowym kodem DUN-14",
+			langJa: "値は有効なDUN-14コードでなければなりません",
+			langZh: "值必须是有效的DUN-14代码",
 		},
 		msgPresetEAN14: {
 			langEn: msgPresetEAN14,
@@ -1011,6 +1902,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código EAN-14 válido",
 			langIt: "Il valore deve essere un codice EAN-14 valido",
 			langDe: "Wert muss ein gültiger EAN-14-Code sein",
+			langNl: "Waarde moet een geldige EAN-14-code zijn",
+			langPt: "O valor deve ser um código EAN-14 válido",
+			langPl: "Wartość musi być prawidłowym kodem EAN-14",
+			langJa: "値は有効なEAN-14コードでなければなりません",
+			langZh: "值必须是有效的EAN-14代码",
 		},
 		msgPresetEAN18: {
 			langEn: msgPresetEAN18,
@@ -1018,6 +1914,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código EAN-18 válido",
 			langIt: "Il valore deve essere un codice EAN-18 valido",
 			langDe: "Wert muss ein gültiger EAN-18-Code sein",
+			langNl: "Waarde moet een geldige EAN-18-code zijn",
+			langPt: "O valor deve ser um código EAN-18 válido",
+			langPl: "Wartość musi być prawidłowym kodem EAN-18",
+			langJa: "値は有効なEAN-18コードでなければなりません",
+			langZh: "值必须是有效的EAN-18代码",
 		},
 		msgPresetEAN99: {
 			langEn: msgPresetEAN99,
@@ -1025,6 +1926,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código EAN-99 válido",
 			langIt: "Il valore deve essere un codice EAN-99 valido",
 			langDe: "Wert muss ein gültiger EAN-99-Code sein",
+			langNl: "Waarde moet een geldige EAN-99-code zijn",
+			langPt: "O valor deve ser um código EAN-99 válido",
+			langPl: "Wartość musi być prawidłowym kodem EAN-99",
+			langJa: "値は有効なEAN-99コードでなければなりません",
+			langZh: "值必须是有效的EAN-99代码",
 		},
 		msgPresetHexadecimal: {
 			langEn: msgPresetHexadecimal,
@@ -1032,6 +1938,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una cadena hexadecimal válida",
 			langIt: "Il valore deve essere una stringa esadecimale valida",
 			langDe: "Wert muss eine gültige hexadezimale Zeichenfolge sein",
+			langNl: "Waarde moet een geldige hexadecimale tekenreeks zijn",
+			langPt: "O valor deve ser uma string hexadecimal válida",
+			langPl: "Wartość musi być prawidłowym ciągiem szesnastkowym",
+			langJa: "値は有効な16進文字列でなければなりません",
+			langZh: "值必须是有效的十六进制字符串",
 		},
 		msgPresetHsl: {
 			langEn:  msgPresetHsl,
@@ -1040,6 +1951,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs:  "El valor debe ser una cadena de color hsl() válida",
 			langIt:  "Il valore deve essere una stringa di colore hsl() valida",
 			langDe:  "Wert muss eine gültige hsl() Farbzeichenfolge sein",
+			langNl:  "Waarde moet een geldige hsl()-kleurtekenreeks zijn",
+			langPt:  "O valor deve ser uma string de cor hsl() válida",
+			langPl:  "Wartość musi być prawidłowym ciągiem koloru hsl()",
+			langJa:  "値は有効なhsl()カラー文字列でなければなりません",
+			langZh:  "值必须是有效的hsl()颜色字符串",
 		},
 		msgPresetHsla: {
 			langEn:  msgPresetHsla,
@@ -1048,6 +1964,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs:  "El valor debe ser una cadena de color hsla() válida",
 			langIt:  "Il valore deve essere una stringa di colore hsla() valida",
 			langDe:  "Wert muss eine gültige hsla() Farbzeichenfolge sein",
+			langNl:  "Waarde moet een geldige hsla()-kleurtekenreeks zijn",
+			langPt:  "O valor deve ser uma string de cor hsla() válida",
+			langPl:  "Wartość musi być prawidłowym ciągiem koloru hsla()",
+			langJa:  "値は有効なhsla()カラー文字列でなければなりません",
+			langZh:  "值必须是有效的hsla()颜色字符串",
 		},
 		msgPresetHtmlColor: {
 			langEn:  msgPresetHtmlColor,
@@ -1056,6 +1977,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs:  "El valor debe ser una cadena de color HTML válida",
 			langIt: "Il valore deve essere una 	stringa di colori HTML valida",
 			langDe: "Wert muss ein gültiger HTML -Farbstring sein",
+			langNl: "Waarde moet een geldige HTML-kleurtekenreeks zijn",
+			langPt: "O valor deve ser uma string de cor HTML válida",
+			langPl: "Wartość musi być prawidłowym ciągiem koloru HTML",
+			langJa: "値は有効なHTMLカラー文字列でなければなりません",
+			langZh: "值必须是有效的HTML颜色字符串",
 		},
 		msgPresetInteger: {
 			langEn: msgPresetInteger,
@@ -1063,6 +1989,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una cadena entera válida (caracteres 0-9)",
 			langIt: "Il valore deve essere una stringa intera valida (caratteri 0-9)",
 			langDe: "Wert muss eine gültige Ganzzahl sein (Zeichen 0-9)",
+			langNl: "Waarde moet een geldige tekenreeks van gehele getallen zijn (tekens 0-9)",
+			langPt: "O valor deve ser uma string de número inteiro válida (caracteres 0-9)",
+			langPl: "Wartość musi być prawidłowym ciągiem liczby całkowitej (znaki 0-9)",
+			langJa: "値は有効な整数文字列 (文字0-9) でなければなりません",
+			langZh: "值必须是有效的整数字符串（字符0-9）",
 		},
 		msgPresetISBN: {
 			langEn: msgPresetISBN,
@@ -1070,6 +2001,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un ISBN válido",
 			langIt: "Il valore deve essere un ISBN valido",
 			langDe: "Wert muss eine gültige ISBN sein",
+			langNl: "Waarde moet een geldig ISBN zijn",
+			langPt: "O valor deve ser um ISBN válido",
+			langPl: "Wartość musi być prawidłowym numerem ISBN",
+			langJa: "値は有効なISBNでなければなりません",
+			langZh: "值必须是有效的ISBN",
 		},
 		msgPresetISBN10: {
 			langEn: msgPresetISBN10,
@@ -1077,6 +2013,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un ISBN-10 válido",
 			langIt: "Il valore deve essere un ISBN-10 valido",
 			langDe: "Wert muss eine gültige ISBN-10 sein",
+			langNl: "Waarde moet een geldig ISBN-10 zijn",
+			langPt: "O valor deve ser um ISBN-10 válido",
+			langPl: "Wartość musi być prawidłowym numerem ISBN-10",
+			langJa: "値は有効なISBN-10でなければなりません",
+			langZh: "值必须是有效的ISBN-10",
 		},
 		msgPresetISBN13: {
 			langEn: msgPresetISBN13,
@@ -1084,6 +2025,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un ISBN-13 válido",
 			langIt: "Il valore deve essere un ISBN-13 valido",
 			langDe: "Wert muss eine gültige ISBN-13 sein",
+			langNl: "Waarde moet een geldig ISBN-13 zijn",
+			langPt: "O valor deve ser um ISBN-13 válido",
+			langPl: "Wartość musi być prawidłowym numerem ISBN-13",
+			langJa: "値は有効なISBN-13でなければなりません",
+			langZh: "值必须是有效的ISBN-13",
 		},
 		msgPresetISSN: {
 			langEn: msgPresetISSN,
@@ -1091,6 +2037,35 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un ISSN válido",
 			langIt: "Il valore deve essere un ISSN valido",
 			langDe: "Wert muss eine gültige ISSN sein",
+			langNl: "Waarde moet een geldig ISSN zijn",
+			langPt: "O valor deve ser um ISSN válido",
+			langPl: "Wartość musi być prawidłowym numerem ISSN",
+			langJa: "値は有効なISSNでなければなりません",
+			langZh: "值必须是有效的ISSN",
+		},
+		msgPresetISSN8: {
+			langEn: msgPresetISSN8,
+			langFr: "La valeur doit être un ISSN-8 valide",
+			langEs: "El valor debe ser un ISSN-8 válido",
+			langIt: "Il valore deve essere un ISSN-8 valido",
+			langDe: "Wert muss eine gültige ISSN-8 sein",
+			langNl: "Waarde moet een geldig ISSN-8 zijn",
+			langPt: "O valor deve ser um ISSN-8 válido",
+			langPl: "Wartość musi być prawidłowym numerem ISSN-8",
+			langJa: "値は有効なISSN-8でなければなりません",
+			langZh: "值必须是有效的ISSN-8",
+		},
+		msgPresetISSN13: {
+			langEn: msgPresetISSN13,
+			langFr: "La valeur doit être un ISSN-13 valide",
+			langEs: "El valor debe ser un ISSN-13 válido",
+			langIt: "Il valore deve essere un ISSN-13 valido",
+			langDe: "Wert muss eine gültige ISSN-13 sein",
+			langNl: "Waarde moet een geldig ISSN-13 zijn",
+			langPt: "O valor deve ser um ISSN-13 válido",
+			langPl: "Wartość musi być prawidłowym numerem ISSN-13",
+			langJa: "値は有効なISSN-13でなければなりません",
+			langZh: "值必须是有效的ISSN-13",
 		},
 		msgPresetNumeric: {
 			langEn: msgPresetNumeric,
@@ -1098,6 +2073,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser una cadena de números válida",
 			langIt: "Il valore deve essere una stringa numerica valida",
 			langDe: "Wert muss eine gültige Zahlenfolge sein",
+			langNl: "Waarde moet een geldige numerieke tekenreeks zijn",
+			langPt: "O valor deve ser uma string numérica válida",
+			langPl: "Wartość musi być prawidłowym ciągiem liczbowym",
+			langJa: "値は有効な数値文字列でなければなりません",
+			langZh: "值必须是有效的数字字符串",
 		},
 		msgPresetPublication: {
 			langEn: msgPresetPublication,
@@ -1105,6 +2085,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un ISBN o ISSN válido",
 			langIt: "Il valore deve essere un ISBN o ISSN valido",
 			langDe: "Wert muss eine gültige ISBN oder ISSN sein",
+			langNl: "Waarde moet een geldig ISBN of ISSN zijn",
+			langPt: "O valor deve ser um ISBN ou ISSN válido",
+			langPl: "Wartość musi być prawidłowym numerem ISBN lub ISSN",
+			langJa: "値は有効なISBNまたはISSNでなければなりません",
+			langZh: "值必须是有效的ISBN或ISSN",
 		},
 		msgPresetRgb: {
 			langEn:  msgPresetRgb,
@@ -1113,6 +2098,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs:  "El valor debe ser una cadena de color rgb() válida",
 			langIt:  "Il valore deve essere una stringa di colore rgb() valida",
 			langDe:  "Wert muss eine gültige rgb() Farbzeichenfolge sein",
+			langNl:  "Waarde moet een geldige rgb()-kleurtekenreeks zijn",
+			langPt:  "O valor deve ser uma string de cor rgb() válida",
+			langPl:  "Wartość musi być prawidłowym ciągiem koloru rgb()",
+			langJa:  "値は有効なrgb()カラー文字列でなければなりません",
+			langZh:  "值必须是有效的rgb()颜色字符串",
 		},
 		msgPresetRgba: {
 			langEn:  msgPresetRgba,
@@ -1121,6 +2111,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs:  "El valor debe ser una cadena de color rgba() válida",
 			langIt:  "Il valore deve essere una stringa di colore rgba() valida",
 			langDe:  "Wert muss eine gültige rgba() Farbzeichenfolge sein",
+			langNl:  "Waarde moet een geldige rgba()-kleurtekenreeks zijn",
+			langPt:  "O valor deve ser uma string de cor rgba() válida",
+			langPl:  "Wartość musi być prawidłowym ciągiem koloru rgba()",
+			langJa:  "値は有効なrgba()カラー文字列でなければなりません",
+			langZh:  "值必须是有效的rgba()颜色字符串",
 		},
 		msgPresetRgbIcc: {
 			langEn:  msgPresetRgbIcc,
@@ -1129,6 +2124,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs:  "El valor debe ser una cadena de color rgb-icc() válida",
 			langIt:  "Il valore deve essere una stringa di colore rgb-icc() valida",
 			langDe:  "Wert muss eine gültige rgb-icc() Farbzeichenfolge sein",
+			langNl:  "Waarde moet een geldige rgb-icc()-kleurtekenreeks zijn",
+			langPt:  "O valor deve ser uma string de cor rgb-icc() válida",
+			langPl:  "Wartość musi być prawidłowym ciągiem koloru rgb-icc()",
+			langJa:  "値は有効なrgb-icc()カラー文字列でなければなりません",
+			langZh:  "值必须是有效的rgb-icc()颜色字符串",
 		},
 		msgPresetULID: {
 			langEn: msgPresetULID,
@@ -1136,6 +2136,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un ULID válido",
 			langIt: "Il valore deve essere un ULID valido",
 			langDe: "Wert muss eine gültige ULID sein",
+			langNl: "Waarde moet een geldige ULID zijn",
+			langPt: "O valor deve ser um ULID válido",
+			langPl: "Wartość musi być prawidłowym ULID",
+			langJa: "値は有効なULIDでなければなりません",
+			langZh: "值必须是有效的ULID",
 		},
 		msgPresetUPC: {
 			langEn: msgPresetUPC,
@@ -1143,6 +2148,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código UPC válido (UPC-A o UPC-E)",
 			langIt: "Il valore deve essere un codice UPC valido (UPC-A o UPC-E)",
 			langDe: "Wert muss ein gültiger UPC-Code sein (UPC-A oder UPC-E)",
+			langNl: "Waarde moet een geldige UPC-code zijn (UPC-A of UPC-E)",
+			langPt: "O valor deve ser um código UPC válido (UPC-A ou UPC-E)",
+			langPl: "Wartość musi być prawidłowym kodem UPC (UPC-A lub UPC-E)",
+			langJa: "値は有効なUPCコード (UPC-AまたはUPC-E) でなければなりません",
+			langZh: "值必须是有效的UPC代码（UPC-A或UPC-E）",
 		},
 		msgPresetUPCA: {
 			langEn: msgPresetUPCA,
@@ -1150,6 +2160,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código UPC-A válido",
 			langIt: "Il valore deve essere un codice UPC-A valido",
 			langDe: "Wert muss ein gültiger UPC-A-Code sein",
+			langNl: "Waarde moet een geldige UPC-A-code zijn",
+			langPt: "O valor deve ser um código UPC-A válido",
+			langPl: "Wartość musi być prawidłowym kodem UPC-A",
+			langJa: "値は有効なUPC-Aコードでなければなりません",
+			langZh: "值必须是有效的UPC-A代码",
 		},
 		msgPresetUPCE: {
 			langEn: msgPresetUPCE,
@@ -1157,6 +2172,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un código UPC-E válido",
 			langIt: "Il valore deve essere un codice UPC-E valido",
 			langDe: "Wert muss ein gültiger UPC-E-Code sein",
+			langNl: "Waarde moet een geldige UPC-E-code zijn",
+			langPt: "O valor deve ser um código UPC-E válido",
+			langPl: "Wartość musi być prawidłowym kodem UPC-E",
+			langJa: "値は有効なUPC-Eコードでなければなりません",
+			langZh: "值必须是有效的UPC-E代码",
 		},
 		msgPresetUuid1: {
 			langEn: msgPresetUuid1,
@@ -1164,6 +2184,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un UUID válido (Versión 1)",
 			langIt: "Il valore deve essere un UUID valido (versione 1)",
 			langDe: "Wert muss eine gültige UUID sein (Version 1)",
+			langNl: "Waarde moet een geldige UUID zijn (versie 1)",
+			langPt: "O valor deve ser um UUID válido (versão 1)",
+			langPl: "Wartość musi być prawidłowym UUID (wersja 1)",
+			langJa: "値は有効なUUID (バージョン1) でなければなりません",
+			langZh: "值必须是有效的UUID（版本1）",
 		},
 		msgPresetUuid2: {
 			langEn: msgPresetUuid2,
@@ -1171,6 +2196,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un UUID válido (Versión 2)",
 			langIt: "Il valore deve essere un UUID valido (versione 2)",
 			langDe: "Wert muss eine gültige UUID sein (Version 2)",
+			langNl: "Waarde moet een geldige UUID zijn (versie 2)",
+			langPt: "O valor deve ser um UUID válido (versão 2)",
+			langPl: "Wartość musi być prawidłowym UUID (wersja 2)",
+			langJa: "値は有効なUUID (バージョン2) でなければなりません",
+			langZh: "值必须是有效的UUID（版本2）",
 		},
 		msgPresetUuid3: {
 			langEn: msgPresetUuid3,
@@ -1178,6 +2208,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un UUID válido (Versión 3)",
 			langIt: "Il valore deve essere un UUID valido (versione 3)",
 			langDe: "Wert muss eine gültige UUID sein (Version 3)",
+			langNl: "Waarde moet een geldige UUID zijn (versie 3)",
+			langPt: "O valor deve ser um UUID válido (versão 3)",
+			langPl: "Wartość musi być prawidłowym UUID (wersja 3)",
+			langJa: "値は有効なUUID (バージョン3) でなければなりません",
+			langZh: "值必须是有效的UUID（版本3）",
 		},
 		msgPresetUuid4: {
 			langEn: msgPresetUuid4,
@@ -1185,6 +2220,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un UUID válido (Versión 4)",
 			langIt: "Il valore deve essere un UUID valido (versione 4)",
 			langDe: "Wert muss eine gültige UUID sein (Version 4)",
+			langNl: "Waarde moet een geldige UUID zijn (versie 4)",
+			langPt: "O valor deve ser um UUID válido (versão 4)",
+			langPl: "Wartość musi być prawidłowym UUID (wersja 4)",
+			langJa: "値は有効なUUID (バージョン4) でなければなりません",
+			langZh: "值必须是有效的UUID（版本4）",
 		},
 		msgPresetUuid5: {
 			langEn: msgPresetUuid5,
@@ -1192,6 +2232,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un UUID válido (Versión 5)",
 			langIt: "Il valore deve essere un UUID valido (versione 5)",
 			langDe: "Wert muss eine gültige UUID sein (Version 5)",
+			langNl: "Waarde moet een geldige UUID zijn (versie 5)",
+			langPt: "O valor deve ser um UUID válido (versão 5)",
+			langPl: "Wartość musi być prawidłowym UUID (wersja 5)",
+			langJa: "値は有効なUUID (バージョン5) でなければなりません",
+			langZh: "值必须是有效的UUID（版本5）",
 		},
 		msgValidMAC: {
 			langEn: msgValidMAC,
@@ -1199,6 +2244,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de cadena debe ser una dirección MAC válida",
 			langIt: "Il valore della stringa deve essere un indirizzo MAC valido",
 			langDe: "String-Wert muss eine gültige MAC-Adresse sein",
+			langNl: "Tekenreekswaarde moet een geldig MAC-adres zijn",
+			langPt: "O valor da string deve ser um endereço MAC válido",
+			langPl: "Wartość ciągu musi być prawidłowym adresem MAC",
+			langJa: "文字列値は有効なMACアドレスでなければなりません",
+			langZh: "字符串值必须是有效的MAC地址",
 		},
 		msgValidCIDR: {
 			langEn: msgValidCIDR,
@@ -1206,6 +2256,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de cadena debe ser una dirección CIDR válida",
 			langIt: "Il valore della stringa deve essere un indirizzo CIDR valido",
 			langDe: "String-Wert muss eine gültige CIDR-Adresse sein",
+			langNl: "Tekenreekswaarde moet een geldig CIDR-adres zijn",
+			langPt: "O valor da string deve ser um endereço CIDR válido",
+			langPl: "Wartość ciągu musi być prawidłowym adresem CIDR",
+			langJa: "文字列値は有効なCIDRアドレスでなければなりません",
+			langZh: "字符串值必须是有效的CIDR地址",
 		},
 		msgValidCIDRv4: {
 			langEn: msgValidCIDRv4,
@@ -1213,6 +2268,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de cadena debe ser una dirección CIDR (versión 4) válida",
 			langIt: "Il valore della stringa deve essere un indirizzo CIDR (versione 4) valido",
 			langDe: "String-Wert muss eine gültige CIDR-Adresse (Version 4) sein",
+			langNl: "Tekenreekswaarde moet een geldig CIDR-adres (versie 4) zijn",
+			langPt: "O valor da string deve ser um endereço CIDR (versão 4) válido",
+			langPl: "Wartość ciągu musi być prawidłowym adresem CIDR (wersja 4)",
+			langJa: "文字列値は有効なCIDR (バージョン4) アドレスでなければなりません",
+			langZh: "字符串值必须是有效的CIDR（版本4）地址",
 		},
 		msgValidCIDRv6: {
 			langEn: msgValidCIDRv6,
@@ -1220,6 +2280,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de cadena debe ser una dirección CIDR (versión 6) válida",
 			langIt: "Il valore della stringa deve essere un indirizzo CIDR (versione 6) valido",
 			langDe: "String-Wert muss eine gültige CIDR-Adresse (Version 6) sein",
+			langNl: "Tekenreekswaarde moet een geldig CIDR-adres (versie 6) zijn",
+			langPt: "O valor da string deve ser um endereço CIDR (versão 6) válido",
+			langPl: "Wartość ciągu musi być prawidłowym adresem CIDR (wersja 6)",
+			langJa: "文字列値は有効なCIDR (バージョン6) アドレスでなければなりません",
+			langZh: "字符串值必须是有效的CIDR（版本6）地址",
 		},
 		msgValidIP: {
 			langEn: msgValidIP,
@@ -1227,6 +2292,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena debe ser una dirección IP válida",
 			langIt: "La stringa deve essere un indirizzo IP valido",
 			langDe: "String muss eine gültige IP-Adresse sein",
+			langNl: "Tekenreeks moet een geldig IP-adres zijn",
+			langPt: "A string deve ser um endereço IP válido",
+			langPl: "Ciąg musi być prawidłowym adresem IP",
+			langJa: "文字列は有効なIPアドレスでなければなりません",
+			langZh: "字符串必须是有效的IP地址",
 		},
 		msgValidIPv4: {
 			langEn: msgValidIPv4,
@@ -1234,6 +2304,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena debe ser una dirección IP válida (versión 4)",
 			langIt: "La stringa deve essere un indirizzo IP (versione 4) valido",
 			langDe: "String muss eine gültige IP-Adresse (Version 4) sein",
+			langNl: "Tekenreeks moet een geldig IP-adres (versie 4) zijn",
+			langPt: "A string deve ser um endereço IP (versão 4) válido",
+			langPl: "Ciąg musi być prawidłowym adresem IP (wersja 4)",
+			langJa: "文字列は有効なIP (バージョン4) アドレスでなければなりません",
+			langZh: "字符串必须是有效的IP（版本4）地址",
 		},
 		msgValidIPv6: {
 			langEn: msgValidIPv6,
@@ -1241,6 +2316,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena debe ser una dirección IP válida (versión 6)",
 			langIt: "La stringa deve essere un indirizzo IP (versione 6) valido",
 			langDe: "String muss eine gültige IP-Adresse (Version 6) sein",
+			langNl: "Tekenreeks moet een geldig IP-adres (versie 6) zijn",
+			langPt: "A string deve ser um endereço IP (versão 6) válido",
+			langPl: "Ciąg musi być prawidłowym adresem IP (wersja 6)",
+			langJa: "文字列は有効なIP (バージョン6) アドレスでなければなりません",
+			langZh: "字符串必须是有效的IP（版本6）地址",
 		},
 		msgValidTCP: {
 			langEn: msgValidTCP,
@@ -1248,6 +2328,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena debe ser una dirección TCP válida",
 			langIt: "La stringa deve essere un indirizzo TCP valido",
 			langDe: "String muss eine gültige TCP-Adresse sein",
+			langNl: "Tekenreeks moet een geldig TCP-adres zijn",
+			langPt: "A string deve ser um endereço TCP válido",
+			langPl: "Ciąg musi być prawidłowym adresem TCP",
+			langJa: "文字列は有効なTCPアドレスでなければなりません",
+			langZh: "字符串必须是有效的TCP地址",
 		},
 		msgValidTCPv4: {
 			langEn: msgValidTCPv4,
@@ -1255,6 +2340,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena debe ser una dirección TCP válida (versión 4)",
 			langIt: "La stringa deve essere un indirizzo TCP (versione 4) valido",
 			langDe: "String muss eine gültige TCP-Adresse (Version 4) sein",
+			langNl: "Tekenreeks moet een geldig TCP-adres (versie 4) zijn",
+			langPt: "A string deve ser um endereço TCP (versão 4) válido",
+			langPl: "Ciąg musi być prawidłowym adresem TCP (wersja 4)",
+			langJa: "文字列は有効なTCP (バージョン4) アドレスでなければなりません",
+			langZh: "字符串必须是有效的TCP（版本4）地址",
 		},
 		msgValidTCPv6: {
 			langEn: msgValidTCPv6,
@@ -1262,6 +2352,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena debe ser una dirección TCP válida (versión 6)",
 			langIt: "La stringa deve essere un indirizzo TCP (versione 6) valido",
 			langDe: "String muss eine gültige TCP-Adresse (Version 6) sein",
+			langNl: "Tekenreeks moet een geldig TCP-adres (versie 6) zijn",
+			langPt: "A string deve ser um endereço TCP (versão 6) válido",
+			langPl: "Ciąg musi być prawidłowym adresem TCP (wersja 6)",
+			langJa: "文字列は有効なTCP (バージョン6) アドレスでなければなりません",
+			langZh: "字符串必须是有效的TCP（版本6）地址",
 		},
 		msgValidUDP: {
 			langEn: msgValidUDP,
@@ -1269,6 +2364,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena debe ser una dirección UDP válida",
 			langIt: "La stringa deve essere un indirizzo UDP valido",
 			langDe: "String muss eine gültige UDP-Adresse sein",
+			langNl: "Tekenreeks moet een geldig UDP-adres zijn",
+			langPt: "A string deve ser um endereço UDP válido",
+			langPl: "Ciąg musi być prawidłowym adresem UDP",
+			langJa: "文字列は有効なUDPアドレスでなければなりません",
+			langZh: "字符串必须是有效的UDP地址",
 		},
 		msgValidUDPv4: {
 			langEn: msgValidUDPv4,
@@ -1276,6 +2376,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena debe ser una dirección UDP (versión 4) válida",
 			langIt: "La stringa deve essere un indirizzo UDP (versione 4) valido",
 			langDe: "String muss eine gültige UDP-Adresse (Version 4) sein",
+			langNl: "Tekenreeks moet een geldig UDP-adres (versie 4) zijn",
+			langPt: "A string deve ser um endereço UDP (versão 4) válido",
+			langPl: "Ciąg musi być prawidłowym adresem UDP (wersja 4)",
+			langJa: "文字列は有効なUDP (バージョン4) アドレスでなければなりません",
+			langZh: "字符串必须是有效的UDP（版本4）地址",
 		},
 		msgValidUDPv6: {
 			langEn: msgValidUDPv6,
@@ -1283,6 +2388,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena debe ser una dirección UDP (versión 6) válida",
 			langIt: "La stringa deve essere un indirizzo UDP (versione 6) valido",
 			langDe: "String muss eine gültige UDP-Adresse (Version 6) sein",
+			langNl: "Tekenreeks moet een geldig UDP-adres (versie 6) zijn",
+			langPt: "A string deve ser um endereço UDP (versão 6) válido",
+			langPl: "Ciąg musi być prawidłowym adresem UDP (wersja 6)",
+			langJa: "文字列は有効なUDP (バージョン6) アドレスでなければなりません",
+			langZh: "字符串必须是有效的UDP（版本6）地址",
 		},
 		msgValidTld: {
 			langEn: msgValidTld,
@@ -1290,6 +2400,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena debe ser un TLD válido",
 			langIt: "La stringa deve essere un TLD valido",
 			langDe: "String muss eine gültige TLD sein",
+			langNl: "Tekenreeks moet een geldig TLD zijn",
+			langPt: "A string deve ser um TLD válido",
+			langPl: "Ciąg musi być prawidłową domeną najwyższego poziomu (TLD)",
+			langJa: "文字列は有効なTLDでなければなりません",
+			langZh: "字符串必须是有效的TLD",
 		},
 		msgValidHostname: {
 			langEn: msgValidHostname,
@@ -1297,6 +2412,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena debe ser un nombre de host válido",
 			langIt: "La stringa deve essere un nome host valido",
 			langDe: "String muss ein gültiger Hostname sein",
+			langNl: "Tekenreeks moet een geldige hostnaam zijn",
+			langPt: "A string deve ser um nome de host válido",
+			langPl: "Ciąg musi być prawidłową nazwą hosta",
+			langJa: "文字列は有効なホスト名でなければなりません",
+			langZh: "字符串必须是有效的主机名",
 		},
 		msgValidURI: {
 			langEn: msgValidURI,
@@ -1304,6 +2424,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena debe ser un URI válido",
 			langIt: "La stringa deve essere un URI valido",
 			langDe: "String muss eine gültige URI sein",
+			langNl: "Tekenreeks moet een geldige URI zijn",
+			langPt: "A string deve ser um URI válido",
+			langPl: "Ciąg musi być prawidłowym URI",
+			langJa: "文字列は有効なURIでなければなりません",
+			langZh: "字符串必须是有效的URI",
 		},
 		msgValidURL: {
 			langEn: msgValidURL,
@@ -1311,6 +2436,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena debe ser un URL válido",
 			langIt: "La stringa deve essere un URL valido",
 			langDe: "String muss eine gültige URL sein",
+			langNl: "Tekenreeks moet een geldige URL zijn",
+			langPt: "A string deve ser uma URL válida",
+			langPl: "Ciąg musi być prawidłowym adresem URL",
+			langJa: "文字列は有効なURLでなければなりません",
+			langZh: "字符串必须是有效的URL",
 		},
 		msgQueryParamMultiNotAllowed: {
 			langEn: msgQueryParamMultiNotAllowed,
@@ -1318,6 +2448,71 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El parámetro de consulta no se puede especificar más de una vez",
 			langIt: "Il parametro di query non può essere specificato più di una volta",
 			langDe: "Abfrageparameter dürfen nicht mehrfach angegeben werden",
+			langNl: "Queryparameter mag niet meer dan één keer worden opgegeven",
+			langPt: "O parâmetro de consulta não pode ser especificado mais de uma vez",
+			langPl: "Parametr zapytania nie może być podany więcej niż raz",
+			langJa: "クエリパラメーターは複数回指定できません",
+			langZh: "查询参数不能多次指定",
+		},
+		msgProblemTitleBadRequest: {
+			langEn: msgProblemTitleBadRequest,
+			langFr: "La requête n'a pas pu être comprise",
+			langEs: "No se pudo entender la solicitud",
+			langIt: "Impossibile comprendere la richiesta",
+			langDe: "Anfrage konnte nicht verstanden werden",
+			langNl: "Request kon niet worden begrepen",
+			langPt: "A requisição não pôde ser compreendida",
+			langPl: "Nie można zrozumieć żądania",
+			langJa: "リクエストを理解できませんでした",
+			langZh: "无法理解请求",
+		},
+		msgProblemTitleUnprocessableEntity: {
+			langEn: msgProblemTitleUnprocessableEntity,
+			langFr: "La requête a échoué à la validation",
+			langEs: "La solicitud no superó la validación",
+			langIt: "La richiesta non ha superato la convalida",
+			langDe: "Anfrage hat die Validierung nicht bestanden",
+			langNl: "Request heeft de validatie niet doorstaan",
+			langPt: "A requisição falhou na validação",
+			langPl: "Żądanie nie przeszło walidacji",
+			langJa: "リクエストの検証に失敗しました",
+			langZh: "请求验证失败",
+		},
+		msgResponseFailedValidation: {
+			langEn: msgResponseFailedValidation,
+			langFr: "La réponse a échoué à la validation",
+			langEs: "La respuesta no superó la validación",
+			langIt: "La risposta non ha superato la convalida",
+			langDe: "Antwort hat die Validierung nicht bestanden",
+			langNl: "Response heeft de validatie niet doorstaan",
+			langPt: "A resposta falhou na validação",
+			langPl: "Odpowiedź nie przeszła walidacji",
+			langJa: "レスポンスの検証に失敗しました",
+			langZh: "响应验证失败",
+		},
+		msgHeaderMultiNotAllowed: {
+			langEn: msgHeaderMultiNotAllowed,
+			langFr: "L'en-tête ne peut pas être spécifié plus d'une fois",
+			langEs: "El encabezado no se puede especificar más de una vez",
+			langIt: "L'intestazione non può essere specificata più di una volta",
+			langDe: "Header dürfen nicht mehrfach angegeben werden",
+			langNl: "Header mag niet meer dan één keer worden opgegeven",
+			langPt: "O cabeçalho não pode ser especificado mais de uma vez",
+			langPl: "Nagłówek nie może być podany więcej niż raz",
+			langJa: "ヘッダーは複数回指定できません",
+			langZh: "标头不能多次指定",
+		},
+		msgSchemaOneOfMultiple: {
+			langEn: msgSchemaOneOfMultiple,
+			langFr: "La valeur doit correspondre à un seul des schémas attendus",
+			langEs: "El valor debe coincidir con solo uno de los esquemas esperados",
+			langIt: "Il valore deve corrispondere a uno solo degli schemi previsti",
+			langDe: "Wert darf nur einem der erwarteten Schemas entsprechen",
+			langNl: "Waarde moet met precies één van de verwachte schema's overeenkomen",
+			langPt: "O valor deve corresponder a apenas um dos esquemas esperados",
+			langPl: "Wartość musi pasować tylko do jednego z oczekiwanych schematów",
+			langJa: "値は想定されるスキーマのいずれか1つのみに一致しなければなりません",
+			langZh: "值必须仅匹配预期模式中的一个",
 		},
 	},
 	Formats: map[string]map[string]string{
@@ -1327,6 +2522,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Los elementos del arreglo deben ser del tipo %[1]s",
 			langIt: "Gli elementi dell'array devono essere di tipo %[1]s",
 			langDe: "Array-Elemente müssen vom Typ %[1]s sein",
+			langNl: "Array-elementen moeten van het type %[1]s zijn",
+			langPt: "Os elementos da matriz devem ser do tipo %[1]s",
+			langPl: "Elementy tablicy muszą być typu %[1]s",
+			langJa: "配列の要素は%[1]s型でなければなりません",
+			langZh: "数组元素必须是%[1]s类型",
 		},
 		fmtMsgArrayElementTypeOrNull: {
 			langEn: fmtMsgArrayElementTypeOrNull,
@@ -1334,20 +2534,35 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Los elementos del arreglo deben ser del tipo %[1]s o nulo",
 			langIt: "Gli elementi dell'array devono essere di tipo %[1]s o null",
 			langDe: "Array-Elemente müssen vom Typ %[1]s oder null sein",
+			langNl: "Array-elementen moeten van het type %[1]s of null zijn",
+			langPt: "Os elementos da matriz devem ser do tipo %[1]s ou nulos",
+			langPl: "Elementy tablicy muszą być typu %[1]s lub null",
+			langJa: "配列の要素は%[1]s型またはnullでなければなりません",
+			langZh: "数组元素必须是%[1]s类型或null",
 		},
 		fmtMsgConstraintSetDefaultAllOf: {
 			langEn: fmtMsgConstraintSetDefaultAllOf,
-			langFr: "L'ensemble de contraintes doit réussir toutes les %[1]d validations non divulguées",
-			langEs: "El conjunto de restricciones debe pasar todas las %[1]d validaciones no reveladas",
-			langIt: "Il set di vincoli deve superare tutte le %[1]d convalide non divulgate",
-			langDe: "Einschränkungssatz muss alle %[1]d nicht offengelegten Validierungen bestehen",
+			langFr: "L'ensemble de contraintes doit réussir {1, plural, one{la # validation non divulguée} other{toutes les # validations non divulguées}}",
+			langEs: "El conjunto de restricciones debe pasar {1, plural, one{la # validación no revelada} other{todas las # validaciones no reveladas}}",
+			langIt: "Il set di vincoli deve superare {1, plural, one{la # convalida non divulgata} other{tutte le # convalide non divulgate}}",
+			langDe: "Einschränkungssatz muss {1, plural, one{die # nicht offengelegte Validierung} other{alle # nicht offengelegten Validierungen}} bestehen",
+			langNl: "Beperkingenset moet {1, plural, one{de # niet-bekendgemaakte validatie} other{alle # niet-bekendgemaakte validaties}} doorstaan",
+			langPt: "O conjunto de restrições deve passar em {1, plural, one{a # validação não divulgada} other{todas as # validações não divulgadas}}",
+			langPl: "Zestaw ograniczeń musi przejść {1, plural, one{# nieujawnioną walidację} few{wszystkie # nieujawnione walidacje} many{wszystkie # nieujawnionych walidacji} other{wszystkie # nieujawnionej walidacji}}",
+			langJa: "制約セットは%[1]d個の非公開の検証すべてに合格しなければなりません",
+			langZh: "约束集必须通过所有%[1]d项未公开的验证",
 		},
 		fmtMsgConstraintSetDefaultOneOf: {
 			langEn: fmtMsgConstraintSetDefaultOneOf,
-			langFr: "L'ensemble de contraintes doit réussir l'une des %[1]d validations non divulguées",
-			langEs: "El conjunto de restricciones debe pasar una de %[1]d validaciones no reveladas",
-			langIt: "Il set di vincoli deve superare una delle %[1]d convalide non divulgate",
-			langDe: "Einschränkungssatz muss eine von %[1]d nicht offengelegten Validierungen bestehen",
+			langFr: "L'ensemble de contraintes doit réussir {1, plural, one{la # validation non divulguée} other{l'une des # validations non divulguées}}",
+			langEs: "El conjunto de restricciones debe pasar {1, plural, one{la # validación no revelada} other{una de # validaciones no reveladas}}",
+			langIt: "Il set di vincoli deve superare {1, plural, one{la # convalida non divulgata} other{una delle # convalide non divulgate}}",
+			langDe: "Einschränkungssatz muss {1, plural, one{die # nicht offengelegte Validierung} other{eine von # nicht offengelegten Validierungen}} bestehen",
+			langNl: "Beperkingenset moet {1, plural, one{de # niet-bekendgemaakte validatie} other{een van # niet-bekendgemaakte validaties}} doorstaan",
+			langPt: "O conjunto de restrições deve passar em {1, plural, one{a # validação não divulgada} other{uma das # validações não divulgadas}}",
+			langPl: "Zestaw ograniczeń musi przejść {1, plural, one{# nieujawnioną walidację} other{jedną z # nieujawnionych walidacji}}",
+			langJa: "制約セットは%[1]d個の非公開の検証のいずれかに合格しなければなりません",
+			langZh: "约束集必须通过%[1]d项未公开的验证之一",
 		},
 		fmtMsgDtGt: {
 			langEn: fmtMsgDtGt,
@@ -1355,6 +2570,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe estar después de '%[1]s'",
 			langIt: "Il valore deve essere successivo a '%[1]s'",
 			langDe: "Wert muss nach '%[1]s' liegen",
+			langNl: "Waarde moet na '%[1]s' liggen",
+			langPt: "O valor deve ser posterior a '%[1]s'",
+			langPl: "Wartość musi być późniejsza niż '%[1]s'",
+			langJa: "値は'%[1]s'より後でなければなりません",
+			langZh: "值必须晚于'%[1]s'",
 		},
 		fmtMsgDtGte: {
 			langEn: fmtMsgDtGte,
@@ -1362,6 +2582,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser posterior o igual a '%[1]s'",
 			langIt: "Il valore deve essere successivo o uguale a '%[1]s'",
 			langDe: "Wert muss nach oder gleich '%[1]s' sein",
+			langNl: "Waarde moet na of gelijk aan '%[1]s' zijn",
+			langPt: "O valor deve ser posterior ou igual a '%[1]s'",
+			langPl: "Wartość musi być późniejsza lub równa '%[1]s'",
+			langJa: "値は'%[1]s'以降でなければなりません",
+			langZh: "值必须晚于或等于'%[1]s'",
 		},
 		fmtMsgDtLt: {
 			langEn: fmtMsgDtLt,
@@ -1369,6 +2594,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe estar antes de '%[1]s'",
 			langIt: "Il valore deve essere prima di '%[1]s'",
 			langDe: "Wert muss vor '%[1]s' liegen",
+			langNl: "Waarde moet vóór '%[1]s' liggen",
+			langPt: "O valor deve ser anterior a '%[1]s'",
+			langPl: "Wartość musi być wcześniejsza niż '%[1]s'",
+			langJa: "値は'%[1]s'より前でなければなりません",
+			langZh: "值必须早于'%[1]s'",
 		},
 		fmtMsgDtLte: {
 			langEn: fmtMsgDtLte,
@@ -1376,34 +2606,59 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser anterior o igual a '%[1]s'",
 			langIt: "Il valore deve essere prima o uguale a '%[1]s'",
 			langDe: "Wert muss vor oder gleich '%[1]s' sein",
+			langNl: "Waarde moet vóór of gelijk aan '%[1]s' zijn",
+			langPt: "O valor deve ser anterior ou igual a '%[1]s'",
+			langPl: "Wartość musi być wcześniejsza lub równa '%[1]s'",
+			langJa: "値は'%[1]s'以前でなければなりません",
+			langZh: "值必须早于或等于'%[1]s'",
 		},
 		fmtMsgDtToleranceFixedMaxAfter: {
 			langEn: fmtMsgDtToleranceFixedMaxAfter,
-			langFr: "La valeur ne doit pas être supérieure à %[1]d %[2]s après %[3]s",
-			langEs: "El valor no debe ser mayor que %[1]d %[2]s después de %[3]s",
-			langIt: "Il valore non deve essere superiore a %[1]d %[2]s dopo %[3]s",
-			langDe: "Wert darf nach %[3]s nicht größer als %[1]d %[2]s sein",
+			langFr: "La valeur ne doit pas être supérieure à %[1]s après %[2]s",
+			langEs: "El valor no debe ser mayor que %[1]s después de %[2]s",
+			langIt: "Il valore non deve essere superiore a %[1]s dopo %[2]s",
+			langDe: "Wert darf nach %[2]s nicht größer als %[1]s sein",
+			langNl: "Waarde mag niet meer dan %[1]s na %[2]s liggen",
+			langPt: "O valor não deve ser mais de %[1]s após %[2]s",
+			langPl: "Wartość nie może być późniejsza o więcej niż %[1]s od %[2]s",
+			langJa: "値は%[2]sの%[1]s後を超えてはなりません",
+			langZh: "值不得晚于%[2]s超过%[1]s",
 		},
 		fmtMsgDtToleranceFixedMaxBefore: {
 			langEn: fmtMsgDtToleranceFixedMaxBefore,
-			langFr: "La valeur ne doit pas être supérieure à %[1]d %[2]s avant %[3]s",
-			langEs: "El valor no debe ser mayor que %[1]d %[2]s antes de %[3]s",
-			langIt: "Il valore non deve essere superiore a %[1]d %[2]s prima di %[3]s",
-			langDe: "Wert darf nicht größer als %[1]d %[2]s vor %[3]s sein",
+			langFr: "La valeur ne doit pas être supérieure à %[1]s avant %[2]s",
+			langEs: "El valor no debe ser mayor que %[1]s antes de %[2]s",
+			langIt: "Il valore non deve essere superiore a %[1]s prima di %[2]s",
+			langDe: "Wert darf nicht größer als %[1]s vor %[2]s sein",
+			langNl: "Waarde mag niet meer dan %[1]s vóór %[2]s liggen",
+			langPt: "O valor não deve ser mais de %[1]s antes de %[2]s",
+			langPl: "Wartość nie może być wcześniejsza o więcej niż %[1]s od %[2]s",
+			langJa: "値は%[2]sの%[1]s前を超えてはなりません",
+			langZh: "值不得早于%[2]s超过%[1]s",
 		},
 		fmtMsgDtToleranceFixedMinAfter: {
 			langEn: fmtMsgDtToleranceFixedMinAfter,
-			langFr: "La valeur doit être au moins %[1]d %[2]s après %[3]s",
-			langEs: "El valor debe ser al menos %[1]d %[2]s después de %[3]s",
-			langIt: "Il valore deve essere almeno %[1]d %[2]s dopo %[3]s",
-			langDe: "Wert muss mindestens %[1]d %[2]s nach %[3]s betragen",
+			langFr: "La valeur doit être au moins %[1]s après %[2]s",
+			langEs: "El valor debe ser al menos %[1]s después de %[2]s",
+			langIt: "Il valore deve essere almeno %[1]s dopo %[2]s",
+			langDe: "Wert muss mindestens %[1]s nach %[2]s betragen",
+			langNl: "Waarde moet minstens %[1]s na %[2]s liggen",
+			langPt: "O valor deve ser pelo menos %[1]s após %[2]s",
+			langPl: "Wartość musi być późniejsza o co najmniej %[1]s od %[2]s",
+			langJa: "値は%[2]sの少なくとも%[1]s後でなければなりません",
+			langZh: "值必须至少晚于%[2]s %[1]s",
 		},
 		fmtMsgDtToleranceFixedMinBefore: {
 			langEn: fmtMsgDtToleranceFixedMinBefore,
-			langFr: "La valeur doit être au moins %[1]d %[2]s avant %[3]s",
-			langEs: "El valor debe ser al menos %[1]d %[2]s antes de %[3]s",
-			langIt: "Il valore deve essere almeno %[1]d %[2]s prima di %[3]s",
-			langDe: "Wert muss mindestens %[1]d %[2]s vor %[3]s betragen",
+			langFr: "La valeur doit être au moins %[1]s avant %[2]s",
+			langEs: "El valor debe ser al menos %[1]s antes de %[2]s",
+			langIt: "Il valore deve essere almeno %[1]s prima di %[2]s",
+			langDe: "Wert muss mindestens %[1]s vor %[2]s betragen",
+			langNl: "Waarde moet minstens %[1]s vóór %[2]s liggen",
+			langPt: "O valor deve ser pelo menos %[1]s antes de %[2]s",
+			langPl: "Wartość musi być wcześniejsza o co najmniej %[1]s od %[2]s",
+			langJa: "値は%[2]sの少なくとも%[1]s前でなければなりません",
+			langZh: "值必须至少早于%[2]s %[1]s",
 		},
 		fmtMsgDtToleranceFixedSame: {
 			langEn: fmtMsgDtToleranceFixedSame,
@@ -1411,6 +2666,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser el mismo %[1]s que %[2]s",
 			langIt: "Il valore deve essere lo stesso %[1]s di %[2]s",
 			langDe: "Wert muss gleich %[1]s wie %[2]s sein",
+			langNl: "Waarde moet hetzelfde %[1]s zijn als %[2]s",
+			langPt: "O valor deve ser o mesmo %[1]s que %[2]s",
+			langPl: "Wartość musi być w tym samym %[1]s co %[2]s",
+			langJa: "値は%[2]sと同じ%[1]sでなければなりません",
+			langZh: "值必须与%[2]s处于同一%[1]s",
 		},
 		fmtMsgDtToleranceNowSame: {
 			langEn: fmtMsgDtToleranceNowSame,
@@ -1418,34 +2678,59 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser el mismo %[1]s que ahora",
 			langIt: "Il valore deve essere lo stesso %[1]s di adesso",
 			langDe: "Wert muss gleich %[1]s sein wie jetzt",
+			langNl: "Waarde moet hetzelfde %[1]s zijn als nu",
+			langPt: "O valor deve ser o mesmo %[1]s que agora",
+			langPl: "Wartość musi być w tym samym %[1]s co teraz",
+			langJa: "値は現在と同じ%[1]sでなければなりません",
+			langZh: "值必须与现在处于同一%[1]s",
 		},
 		fmtMsgDtToleranceNowMaxAfter: {
 			langEn: fmtMsgDtToleranceNowMaxAfter,
-			langFr: "La valeur ne doit pas dépasser %[1]d %[2]s après maintenant",
-			langEs: "El valor no debe ser mayor que %[1]d %[2]s después de ahora",
-			langIt: "Il valore non deve essere superiore a %[1]d %[2]s dopo ora",
-			langDe: "Wert darf nach jetzt nicht mehr als %[1]d %[2]s betragen",
+			langFr: "La valeur ne doit pas dépasser %[1]s après maintenant",
+			langEs: "El valor no debe ser mayor que %[1]s después de ahora",
+			langIt: "Il valore non deve essere superiore a %[1]s dopo ora",
+			langDe: "Wert darf nach jetzt nicht mehr als %[1]s betragen",
+			langNl: "Waarde mag niet meer dan %[1]s na nu liggen",
+			langPt: "O valor não deve ser mais de %[1]s depois de agora",
+			langPl: "Wartość nie może być późniejsza o więcej niż %[1]s od teraz",
+			langJa: "値は現在から%[1]s後を超えてはなりません",
+			langZh: "值不得晚于现在超过%[1]s",
 		},
 		fmtMsgDtToleranceNowMaxBefore: {
 			langEn: fmtMsgDtToleranceNowMaxBefore,
-			langFr: "La valeur ne doit pas dépasser %[1]d %[2]s avant maintenant",
-			langEs: "El valor no debe ser superior a %[1]d %[2]s antes de ahora",
-			langIt: "Il valore non deve essere superiore a %[1]d %[2]s prima di ora",
-			langDe: "Wert darf bis jetzt nicht größer als %[1]d %[2]s sein",
+			langFr: "La valeur ne doit pas dépasser %[1]s avant maintenant",
+			langEs: "El valor no debe ser superior a %[1]s antes de ahora",
+			langIt: "Il valore non deve essere superiore a %[1]s prima di ora",
+			langDe: "Wert darf bis jetzt nicht größer als %[1]s sein",
+			langNl: "Waarde mag niet meer dan %[1]s vóór nu liggen",
+			langPt: "O valor não deve ser mais de %[1]s antes de agora",
+			langPl: "Wartość nie może być wcześniejsza o więcej niż %[1]s od teraz",
+			langJa: "値は現在から%[1]s前を超えてはなりません",
+			langZh: "值不得早于现在超过%[1]s",
 		},
 		fmtMsgDtToleranceNowMinAfter: {
 			langEn: fmtMsgDtToleranceNowMinAfter,
-			langFr: "La valeur doit être au moins %[1]d %[2]s après maintenant",
-			langEs: "El valor debe ser al menos %[1]d %[2]s después de ahora",
-			langIt: "Il valore deve essere almeno %[1]d %[2]s dopo ora",
-			langDe: "Wert muss nach jetzt mindestens %[1]d %[2]s betragen",
+			langFr: "La valeur doit être au moins %[1]s après maintenant",
+			langEs: "El valor debe ser al menos %[1]s después de ahora",
+			langIt: "Il valore deve essere almeno %[1]s dopo ora",
+			langDe: "Wert muss nach jetzt mindestens %[1]s betragen",
+			langNl: "Waarde moet minstens %[1]s na nu liggen",
+			langPt: "O valor deve ser pelo menos %[1]s depois de agora",
+			langPl: "Wartość musi być późniejsza o co najmniej %[1]s od teraz",
+			langJa: "値は現在から少なくとも%[1]s後でなければなりません",
+			langZh: "值必须至少晚于现在%[1]s",
 		},
 		fmtMsgDtToleranceNowMinBefore: {
 			langEn: fmtMsgDtToleranceNowMinBefore,
-			langFr: "La valeur doit être au moins %[1]d %[2]s avant maintenant",
-			langEs: "El valor debe ser al menos %[1]d %[2]s antes de ahora",
-			langIt: "Il valore deve essere almeno %[1]d %[2]s prima di ora",
-			langDe: "Wert muss vorher mindestens %[1]d %[2]s betragen",
+			langFr: "La valeur doit être au moins %[1]s avant maintenant",
+			langEs: "El valor debe ser al menos %[1]s antes de ahora",
+			langIt: "Il valore deve essere almeno %[1]s prima di ora",
+			langDe: "Wert muss vorher mindestens %[1]s betragen",
+			langNl: "Waarde moet minstens %[1]s vóór nu liggen",
+			langPt: "O valor deve ser pelo menos %[1]s antes de agora",
+			langPl: "Wartość musi być wcześniejsza o co najmniej %[1]s od teraz",
+			langJa: "値は現在から少なくとも%[1]s前でなければなりません",
+			langZh: "值必须至少早于现在%[1]s",
 		},
 		fmtMsgDtToleranceOtherSame: {
 			langEn: fmtMsgDtToleranceOtherSame,
@@ -1453,90 +2738,155 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser el mismo %[1]s que el valor de la propiedad '%[2]s'",
 			langIt: "Il valore deve essere lo stesso %[1]s del valore della proprietà '%[2]s'",
 			langDe: "Wert muss gleich %[1]s sein wie Wert der Eigenschaft '%[2]s'",
+			langNl: "Waarde moet hetzelfde %[1]s zijn als de waarde van eigenschap '%[2]s'",
+			langPt: "O valor deve ser o mesmo %[1]s que o valor da propriedade '%[2]s'",
+			langPl: "Wartość musi być w tym samym %[1]s co wartość właściwości '%[2]s'",
+			langJa: "値はプロパティ'%[2]s'の値と同じ%[1]sでなければなりません",
+			langZh: "值必须与属性'%[2]s'的值处于同一%[1]s",
 		},
 		fmtMsgDtToleranceOtherMaxAfter: {
 			langEn: fmtMsgDtToleranceOtherMaxAfter,
-			langFr: "La valeur ne doit pas être supérieure à %[1]d %[2]s après la valeur de la propriété '%[3]s'",
-			langEs: "El valor no debe ser mayor que %[1]d %[2]s después del valor de la propiedad '%[3]s'",
-			langIt: "Il valore non deve essere superiore a %[1]d %[2]s dopo il valore della proprietà '%[3]s'",
-			langDe: "Wert darf nicht größer als %[1]d %[2]s nach dem Wert der Eigenschaft '%[3]s' sein",
+			langFr: "La valeur ne doit pas être supérieure à %[1]s après la valeur de la propriété '%[2]s'",
+			langEs: "El valor no debe ser mayor que %[1]s después del valor de la propiedad '%[2]s'",
+			langIt: "Il valore non deve essere superiore a %[1]s dopo il valore della proprietà '%[2]s'",
+			langDe: "Wert darf nicht größer als %[1]s nach dem Wert der Eigenschaft '%[2]s' sein",
+			langNl: "Waarde mag niet meer dan %[1]s na de waarde van eigenschap '%[2]s' liggen",
+			langPt: "O valor não deve ser mais de %[1]s após o valor da propriedade '%[2]s'",
+			langPl: "Wartość nie może być późniejsza o więcej niż %[1]s od wartości właściwości '%[2]s'",
+			langJa: "値はプロパティ'%[2]s'の値の%[1]s後を超えてはなりません",
+			langZh: "值不得晚于属性'%[2]s'的值超过%[1]s",
 		},
 		fmtMsgDtToleranceOtherMaxBefore: {
 			langEn: fmtMsgDtToleranceOtherMaxBefore,
-			langFr: "La valeur ne doit pas être supérieure à %[1]d %[2]s avant la valeur de la propriété '%[3]s'",
-			langEs: "El valor no debe ser mayor que %[1]d %[2]s antes del valor de la propiedad '%[3]s'",
-			langIt: "Il valore non deve essere superiore a %[1]d %[2]s prima del valore della proprietà '%[3]s'",
-			langDe: "Wert darf nicht größer als %[1]d %[2]s vor dem Wert der Eigenschaft '%[3]s' sein",
+			langFr: "La valeur ne doit pas être supérieure à %[1]s avant la valeur de la propriété '%[2]s'",
+			langEs: "El valor no debe ser mayor que %[1]s antes del valor de la propiedad '%[2]s'",
+			langIt: "Il valore non deve essere superiore a %[1]s prima del valore della proprietà '%[2]s'",
+			langDe: "Wert darf nicht größer als %[1]s vor dem Wert der Eigenschaft '%[2]s' sein",
+			langNl: "Waarde mag niet meer dan %[1]s vóór de waarde van eigenschap '%[2]s' liggen",
+			langPt: "O valor não deve ser mais de %[1]s antes do valor da propriedade '%[2]s'",
+			langPl: "Wartość nie może być wcześniejsza o więcej niż %[1]s od wartości właściwości '%[2]s'",
+			langJa: "値はプロパティ'%[2]s'の値の%[1]s前を超えてはなりません",
+			langZh: "值不得早于属性'%[2]s'的值超过%[1]s",
 		},
 		fmtMsgDtToleranceOtherMinAfter: {
 			langEn: fmtMsgDtToleranceOtherMinAfter,
-			langFr: "La valeur doit être au moins %[1]d %[2]s après la valeur de la propriété '%[3]s'",
-			langEs: "El valor debe ser al menos %[1]d %[2]s después del valor de la propiedad '%[3]s'",
-			langIt: "Il valore deve essere almeno %[1]d %[2]s dopo il valore della proprietà '%[3]s'",
-			langDe: "Wert muss mindestens %[1]d %[2]s nach dem Wert der Eigenschaft '%[3]s' betragen",
+			langFr: "La valeur doit être au moins %[1]s après la valeur de la propriété '%[2]s'",
+			langEs: "El valor debe ser al menos %[1]s después del valor de la propiedad '%[2]s'",
+			langIt: "Il valore deve essere almeno %[1]s dopo il valore della proprietà '%[2]s'",
+			langDe: "Wert muss mindestens %[1]s nach dem Wert der Eigenschaft '%[2]s' betragen",
+			langNl: "Waarde moet minstens %[1]s na de waarde van eigenschap '%[2]s' liggen",
+			langPt: "O valor deve ser pelo menos %[1]s após o valor da propriedade '%[2]s'",
+			langPl: "Wartość musi być późniejsza o co najmniej %[1]s od wartości właściwości '%[2]s'",
+			langJa: "値はプロパティ'%[2]s'の値の少なくとも%[1]s後でなければなりません",
+			langZh: "值必须至少晚于属性'%[2]s'的值%[1]s",
 		},
 		fmtMsgDtToleranceOtherMinBefore: {
 			langEn: fmtMsgDtToleranceOtherMinBefore,
-			langFr: "La valeur doit être au moins %[1]d %[2]s avant la valeur de la propriété '%[3]s'",
-			langEs: "El valor debe ser al menos %[1]d %[2]s antes del valor de la propiedad '%[3]s'",
-			langIt: "Il valore deve essere almeno %[1]d %[2]s prima del valore della proprietà '%[3]s'",
-			langDe: "Wert muss mindestens %[1]d %[2]s vor dem Wert der Eigenschaft '%[3]s' liegen",
+			langFr: "La valeur doit être au moins %[1]s avant la valeur de la propriété '%[2]s'",
+			langEs: "El valor debe ser al menos %[1]s antes del valor de la propiedad '%[2]s'",
+			langIt: "Il valore deve essere almeno %[1]s prima del valore della proprietà '%[2]s'",
+			langDe: "Wert muss mindestens %[1]s vor dem Wert der Eigenschaft '%[2]s' liegen",
+			langNl: "Waarde moet minstens %[1]s vóór de waarde van eigenschap '%[2]s' liggen",
+			langPt: "O valor deve ser pelo menos %[1]s antes do valor da propriedade '%[2]s'",
+			langPl: "Wartość musi być wcześniejsza o co najmniej %[1]s od wartości właściwości '%[2]s'",
+			langJa: "値はプロパティ'%[2]s'の値の少なくとも%[1]s前でなければなりません",
+			langZh: "值必须至少早于属性'%[2]s'的值%[1]s",
 		},
 		fmtMsgDtAgeMin: {
 			langEn: fmtMsgDtAgeMin,
-			langFr: "L'âge doit être supérieur à %[1]d ans",
-			langEs: "La edad debe ser mayor de %[1]d años",
-			langIt: "L'età deve avere più di %[1]d anni",
-			langDe: "Das Alter muss über %[1]d Jahre alt sein",
+			langFr: "L'âge doit être supérieur à {1, plural, one{# an} other{# ans}}",
+			langEs: "La edad debe ser mayor de {1, plural, one{# año} other{# años}}",
+			langIt: "L'età deve avere più di {1, plural, one{# anno} other{# anni}}",
+			langDe: "Das Alter muss über {1, plural, one{# Jahr} other{# Jahre}} alt sein",
+			langNl: "Leeftijd moet meer dan %[1]d jaar zijn",
+			langPt: "A idade deve ser superior a {1, plural, one{# ano} other{# anos}}",
+			langPl: "Wiek musi być większy niż {1, plural, one{# rok} few{# lata} many{# lat} other{# roku}}",
+			langJa: "年齢は%[1]d歳を超えていなければなりません",
+			langZh: "年龄必须超过%[1]d岁",
 		},
 		fmtMsgDtAgeMinOrOver: {
 			langEn: fmtMsgDtAgeMinOrOver,
-			langFr: "L'âge doit être de %[1]d ans ou plus",
-			langEs: "La edad debe ser mayor de %[1]d años",
-			langIt: "L'età deve avere almeno %[1]d anni",
-			langDe: "Das Alter muss %[1]d Jahre oder älter sein",
+			langFr: "L'âge doit être de {1, plural, one{# an} other{# ans}} ou plus",
+			langEs: "La edad debe ser mayor de {1, plural, one{# año} other{# años}}",
+			langIt: "L'età deve avere almeno {1, plural, one{# anno} other{# anni}}",
+			langDe: "Das Alter muss {1, plural, one{# Jahr} other{# Jahre}} oder älter sein",
+			langNl: "Leeftijd moet %[1]d jaar of ouder zijn",
+			langPt: "A idade deve ser de {1, plural, one{# ano} other{# anos}} ou mais",
+			langPl: "Wiek musi wynosić {1, plural, one{# rok} few{# lata} many{# lat} other{# roku}} lub więcej",
+			langJa: "年齢は%[1]d歳以上でなければなりません",
+			langZh: "年龄必须为%[1]d岁或以上",
 		},
 		fmtMsgDtAgeMax: {
 			langEn: fmtMsgDtAgeMax,
-			langFr: "L'âge doit être inférieur à %[1]d ans",
-			langEs: "La edad debe ser menor de %[1]d años",
-			langIt: "L'età deve avere meno di %[1]d anni",
-			langDe: "Das Alter muss unter %[1]d Jahre alt sein",
+			langFr: "L'âge doit être inférieur à {1, plural, one{# an} other{# ans}}",
+			langEs: "La edad debe ser menor de {1, plural, one{# año} other{# años}}",
+			langIt: "L'età deve avere meno di {1, plural, one{# anno} other{# anni}}",
+			langDe: "Das Alter muss unter {1, plural, one{# Jahr} other{# Jahre}} alt sein",
+			langNl: "Leeftijd moet minder dan %[1]d jaar zijn",
+			langPt: "A idade deve ser inferior a {1, plural, one{# ano} other{# anos}}",
+			langPl: "Wiek musi być mniejszy niż {1, plural, one{# rok} few{# lata} many{# lat} other{# roku}}",
+			langJa: "年齢は%[1]d歳未満でなければなりません",
+			langZh: "年龄必须小于%[1]d岁",
 		},
 		fmtMsgDtAgeMaxOrUnder: {
 			langEn: fmtMsgDtAgeMaxOrUnder,
-			langFr: "L'âge doit être de %[1]d ans ou moins",
-			langEs: "La edad debe ser de %[1]d años o menos",
-			langIt: "L'età deve avere %[1]d anni o meno",
-			langDe: "Das Alter muss %[1]d Jahre oder jünger sein",
+			langFr: "L'âge doit être de {1, plural, one{# an} other{# ans}} ou moins",
+			langEs: "La edad debe ser de {1, plural, one{# año} other{# años}} o menos",
+			langIt: "L'età deve avere {1, plural, one{# anno} other{# anni}} o meno",
+			langDe: "Das Alter muss {1, plural, one{# Jahr} other{# Jahre}} oder jünger sein",
+			langNl: "Leeftijd moet %[1]d jaar of jonger zijn",
+			langPt: "A idade deve ser de {1, plural, one{# ano} other{# anos}} ou menos",
+			langPl: "Wiek musi wynosić {1, plural, one{# rok} few{# lata} many{# lat} other{# roku}} lub mniej",
+			langJa: "年齢は%[1]d歳以下でなければなりません",
+			langZh: "年龄必须为%[1]d岁或以下",
 		},
 		fmtMsgDtAgeMinExcMaxExc: {
 			langEn: fmtMsgDtAgeMinExcMaxExc,
-			langFr: "L'âge doit être supérieur à %[1]d ans et inférieur à %[2]d ans",
-			langEs: "La edad debe ser mayor de %[1]d años y menor de %[2]d años",
-			langIt: "L'età deve avere più di %[1]d anni e meno di %[2]d anni",
-			langDe: "Das Alter muss über %[1]d Jahre und unter %[2]d Jahre alt sein",
+			langFr: "L'âge doit être supérieur à {1, plural, one{# an} other{# ans}} et inférieur à {2, plural, one{# an} other{# ans}}",
+			langEs: "La edad debe ser mayor de {1, plural, one{# año} other{# años}} y menor de {2, plural, one{# año} other{# años}}",
+			langIt: "L'età deve avere più di {1, plural, one{# anno} other{# anni}} e meno di {2, plural, one{# anno} other{# anni}}",
+			langDe: "Das Alter muss über {1, plural, one{# Jahr} other{# Jahre}} und unter {2, plural, one{# Jahr} other{# Jahre}} alt sein",
+			langNl: "Leeftijd moet meer dan %[1]d jaar en minder dan %[2]d jaar zijn",
+			langPt: "A idade deve ser superior a {1, plural, one{# ano} other{# anos}} e inferior a {2, plural, one{# ano} other{# anos}}",
+			langPl: "Wiek musi być większy niż {1, plural, one{# rok} few{# lata} many{# lat} other{# roku}} i mniejszy niż {2, plural, one{# rok} few{# lata} many{# lat} other{# roku}}",
+			langJa: "年齢は%[1]d歳を超え%[2]d歳未満でなければなりません",
+			langZh: "年龄必须超过%[1]d岁且小于%[2]d岁",
 		},
 		fmtMsgDtAgeMinMax: {
 			langEn: fmtMsgDtAgeMinMax,
-			langFr: "L'âge doit être compris entre %[1]d ans et %[2]d ans",
-			langEs: "La edad debe estar entre %[1]d años y %[2]d años",
-			langIt: "L'età deve essere compresa tra %[1]d anni e %[2]d anni",
-			langDe: "Das Alter muss zwischen %[1]d und %[2]d Jahren liegen",
+			langFr: "L'âge doit être compris entre {1, plural, one{# an} other{# ans}} et {2, plural, one{# an} other{# ans}}",
+			langEs: "La edad debe estar entre {1, plural, one{# año} other{# años}} y {2, plural, one{# año} other{# años}}",
+			langIt: "L'età deve essere compresa tra {1, plural, one{# anno} other{# anni}} e {2, plural, one{# anno} other{# anni}}",
+			langDe: "Das Alter muss zwischen {1, plural, one{# Jahr} other{# Jahren}} und {2, plural, one{# Jahr} other{# Jahren}} liegen",
+			langNl: "Leeftijd moet tussen %[1]d jaar en %[2]d jaar liggen",
+			langPt: "A idade deve estar entre {1, plural, one{# ano} other{# anos}} e {2, plural, one{# ano} other{# anos}}",
+			langPl: "Wiek musi wynosić od {1, plural, one{# roku} other{# lat}} do {2, plural, one{# roku} other{# lat}}",
+			langJa: "年齢は%[1]d歳から%[2]d歳の間でなければなりません",
+			langZh: "年龄必须在%[1]d岁到%[2]d岁之间",
 		},
 		fmtMsgDtAgeMinMaxExc: {
 			langEn: fmtMsgDtAgeMinMaxExc,
-			langFr: "L'âge doit être de %[1]d ans ou plus et de moins de %[2]d ans",
-			langEs: "La edad debe ser mayor de %[1]d años y menor de %[2]d años",
-			langIt: "L'età deve avere almeno %[1]d anni e avere meno di %[2]d anni",
-			langDe: "Das Alter muss %[1]d Jahre oder über und unter %[2]d Jahre alt sein",
+			langFr: "L'âge doit être de {1, plural, one{# an} other{# ans}} ou plus et de moins de {2, plural, one{# an} other{# ans}}",
+			langEs: "La edad debe ser mayor de {1, plural, one{# año} other{# años}} y menor de {2, plural, one{# año} other{# años}}",
+			langIt: "L'età deve avere almeno {1, plural, one{# anno} other{# anni}} e avere meno di {2, plural, one{# anno} other{# anni}}",
+			langDe: "Das Alter muss {1, plural, one{# Jahr} other{# Jahre}} oder über und unter {2, plural, one{# Jahr} other{# Jahre}} alt sein",
+			langNl: "Leeftijd moet %[1]d jaar of ouder en jonger dan %[2]d jaar zijn",
+			langPt: "A idade deve ser de {1, plural, one{# ano} other{# anos}} ou mais e inferior a {2, plural, one{# ano} other{# anos}}",
+			langPl: "Wiek musi wynosić {1, plural, one{# rok} few{# lata} many{# lat} other{# roku}} lub więcej i mniej niż {2, plural, one{# rok} few{# lata} many{# lat} other{# roku}}",
+			langJa: "年齢は%[1]d歳以上%[2]d歳未満でなければなりません",
+			langZh: "年龄必须为%[1]d岁或以上且小于%[2]d岁",
 		},
 		fmtMsgDtAgeMinExcMax: {
 			langEn: fmtMsgDtAgeMinExcMax,
-			langFr: "L'âge doit être compris entre plus de %[1]d ans et %[2]d ans ou moins",
-			langEs: "La edad debe estar entre más de %[1]d años y %[2]d años o menos",
-			langIt: "L'età deve essere compresa tra più di %[1]d anni e %[2]d anni o meno",
-			langDe: "Das Alter muss zwischen über %[1]d Jahren und %[2]d Jahren oder jünger liegen",
+			langFr: "L'âge doit être compris entre plus de {1, plural, one{# an} other{# ans}} et {2, plural, one{# an} other{# ans}} ou moins",
+			langEs: "La edad debe estar entre más de {1, plural, one{# año} other{# años}} y {2, plural, one{# año} other{# años}} o menos",
+			langIt: "L'età deve essere compresa tra più di {1, plural, one{# anno} other{# anni}} e {2, plural, one{# anno} other{# anni}} o meno",
+			langDe: "Das Alter muss zwischen über {1, plural, one{# Jahr} other{# Jahren}} und {2, plural, one{# Jahr} other{# Jahren}} oder jünger liegen",
+			langNl: "Leeftijd moet tussen meer dan %[1]d jaar en %[2]d jaar of jonger liggen",
+			langPt: "A idade deve estar entre mais de {1, plural, one{# ano} other{# anos}} e {2, plural, one{# ano} other{# anos}} ou menos",
+			langPl: "Wiek musi być większy niż {1, plural, one{# rok} few{# lata} many{# lat} other{# roku}} i wynosić {2, plural, one{# rok} few{# lata} many{# lat} other{# roku}} lub mniej",
+			langJa: "年齢は%[1]d歳を超え%[2]d歳以下でなければなりません",
+			langZh: "年龄必须超过%[1]d岁且为%[2]d岁或以下",
 		},
 		fmtMsgEqualsOther: {
 			langEn: fmtMsgEqualsOther,
@@ -1544,13 +2894,23 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser igual al valor de la propiedad '%[1]s'",
 			langIt: "Il valore deve essere uguale al valore della proprietà '%[1]s'",
 			langDe: "Wert muss gleich dem Wert der Eigenschaft '%[1]s' sein",
+			langNl: "Waarde moet gelijk zijn aan de waarde van eigenschap '%[1]s'",
+			langPt: "O valor deve ser igual ao valor da propriedade '%[1]s'",
+			langPl: "Wartość musi być równa wartości właściwości '%[1]s'",
+			langJa: "値はプロパティ'%[1]s'の値と等しくなければなりません",
+			langZh: "值必须等于属性'%[1]s'的值",
 		},
 		fmtMsgExactLen: {
 			langEn: fmtMsgExactLen,
-			langFr: "La longueur de la valeur doit être %[1]d",
-			langEs: "La longitud del valor debe ser %[1]d",
-			langIt: "La lunghezza del valore deve essere %[1]d",
-			langDe: "Wertlänge muss %[1]d sein",
+			langFr: "La longueur de la valeur doit être {1, plural, one{# élément} other{# éléments}}",
+			langEs: "La longitud del valor debe ser {1, plural, one{# elemento} other{# elementos}}",
+			langIt: "La lunghezza del valore deve essere {1, plural, one{# elemento} other{# elementi}}",
+			langDe: "Wertlänge muss {1, plural, one{# Element} other{# Elemente}} sein",
+			langNl: "Lengte van de waarde moet {1, plural, one{# element} other{# elementen}} zijn",
+			langPt: "O comprimento do valor deve ser {1, plural, one{# item} other{# itens}}",
+			langPl: "Długość wartości musi wynosić {1, plural, one{# element} few{# elementy} many{# elementów} other{# elementu}}",
+			langJa: "値の長さは%[1]d項目でなければなりません",
+			langZh: "值的长度必须为%[1]d项",
 		},
 		fmtMsgGt: {
 			langEn: fmtMsgGt,
@@ -1558,6 +2918,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser mayor que %[1]v",
 			langIt: "Il valore deve essere maggiore di %[1]v",
 			langDe: "Wert muss größer als %[1]v sein",
+			langNl: "Waarde moet groter zijn dan %[1]v",
+			langPt: "O valor deve ser maior que %[1]v",
+			langPl: "Wartość musi być większa niż %[1]v",
+			langJa: "値は%[1]vより大きくなければなりません",
+			langZh: "值必须大于%[1]v",
 		},
 		fmtMsgGte: {
 			langEn: fmtMsgGte,
@@ -1565,6 +2930,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser mayor o igual que %[1]v",
 			langIt: "Il valore deve essere maggiore o uguale a %[1]v",
 			langDe: "Wert muss größer oder gleich %[1]v sein",
+			langNl: "Waarde moet groter dan of gelijk aan %[1]v zijn",
+			langPt: "O valor deve ser maior ou igual a %[1]v",
+			langPl: "Wartość musi być większa lub równa %[1]v",
+			langJa: "値は%[1]v以上でなければなりません",
+			langZh: "值必须大于或等于%[1]v",
 		},
 		fmtMsgStrGt: {
 			langEn: fmtMsgStrGt,
@@ -1572,6 +2942,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser mayor que '%[1]s'",
 			langIt: "Il valore deve essere maggiore di '%[1]s'",
 			langDe: "Wert muss größer als '%[1]s' sein",
+			langNl: "Waarde moet groter zijn dan '%[1]s'",
+			langPt: "O valor deve ser maior que '%[1]s'",
+			langPl: "Wartość musi być większa niż '%[1]s'",
+			langJa: "値は'%[1]s'より大きくなければなりません",
+			langZh: "值必须大于'%[1]s'",
 		},
 		fmtMsgStrGte: {
 			langEn: fmtMsgStrGte,
@@ -1579,6 +2954,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser mayor o igual que '%[1]s'",
 			langIt: "Il valore deve essere maggiore o uguale a '%[1]s'",
 			langDe: "Wert muss größer oder gleich '%[1]s' sein",
+			langNl: "Waarde moet groter dan of gelijk aan '%[1]s' zijn",
+			langPt: "O valor deve ser maior ou igual a '%[1]s'",
+			langPl: "Wartość musi być większa lub równa '%[1]s'",
+			langJa: "値は'%[1]s'以上でなければなりません",
+			langZh: "值必须大于或等于'%[1]s'",
 		},
 		fmtMsgGtOther: {
 			langEn: fmtMsgGtOther,
@@ -1586,6 +2966,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser mayor que el valor de la propiedad '%[1]s'",
 			langIt: "Il valore deve essere maggiore del valore della proprietà '%[1]s'",
 			langDe: "Wert muss größer sein als Wert der Eigenschaft '%[1]s'",
+			langNl: "Waarde moet groter zijn dan de waarde van eigenschap '%[1]s'",
+			langPt: "O valor deve ser maior que o valor da propriedade '%[1]s'",
+			langPl: "Wartość musi być większa niż wartość właściwości '%[1]s'",
+			langJa: "値はプロパティ'%[1]s'の値より大きくなければなりません",
+			langZh: "值必须大于属性'%[1]s'的值",
 		},
 		fmtMsgGteOther: {
 			langEn: fmtMsgGteOther,
@@ -1593,6 +2978,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser mayor o igual que el valor de la propiedad '%[1]s'",
 			langIt: "Il valore deve essere maggiore o uguale al valore della proprietà '%[1]s'",
 			langDe: "Wert muss größer oder gleich dem Wert der Eigenschaft '%[1]s' sein",
+			langNl: "Waarde moet groter dan of gelijk aan de waarde van eigenschap '%[1]s' zijn",
+			langPt: "O valor deve ser maior ou igual ao valor da propriedade '%[1]s'",
+			langPl: "Wartość musi być większa lub równa wartości właściwości '%[1]s'",
+			langJa: "値はプロパティ'%[1]s'の値以上でなければなりません",
+			langZh: "值必须大于或等于属性'%[1]s'的值",
 		},
 		fmtMsgLt: {
 			langEn: fmtMsgLt,
@@ -1600,6 +2990,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser menor que %[1]v",
 			langIt: "Il valore deve essere inferiore a %[1]v",
 			langDe: "Wert muss kleiner als %[1]v sein",
+			langNl: "Waarde moet kleiner zijn dan %[1]v",
+			langPt: "O valor deve ser menor que %[1]v",
+			langPl: "Wartość musi być mniejsza niż %[1]v",
+			langJa: "値は%[1]vより小さくなければなりません",
+			langZh: "值必须小于%[1]v",
 		},
 		fmtMsgLte: {
 			langEn: fmtMsgLte,
@@ -1607,6 +3002,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser menor o igual que %[1]v",
 			langIt: "Il valore deve essere inferiore o uguale a %[1]v",
 			langDe: "Wert muss kleiner oder gleich %[1]v sein",
+			langNl: "Waarde moet kleiner dan of gelijk aan %[1]v zijn",
+			langPt: "O valor deve ser menor ou igual a %[1]v",
+			langPl: "Wartość musi być mniejsza lub równa %[1]v",
+			langJa: "値は%[1]v以下でなければなりません",
+			langZh: "值必须小于或等于%[1]v",
 		},
 		fmtMsgStrLt: {
 			langEn: fmtMsgStrLt,
@@ -1614,6 +3014,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser menor que '%[1]s'",
 			langIt: "Il valore deve essere inferiore a '%[1]s'",
 			langDe: "Wert muss kleiner als '%[1]s' sein",
+			langNl: "Waarde moet kleiner zijn dan '%[1]s'",
+			langPt: "O valor deve ser menor que '%[1]s'",
+			langPl: "Wartość musi być mniejsza niż '%[1]s'",
+			langJa: "値は'%[1]s'より小さくなければなりません",
+			langZh: "值必须小于'%[1]s'",
 		},
 		fmtMsgStrLte: {
 			langEn: fmtMsgStrLte,
@@ -1621,6 +3026,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser menor o igual que '%[1]s'",
 			langIt: "Il valore deve essere inferiore o uguale a '%[1]s'",
 			langDe: "Wert muss kleiner oder gleich '%[1]s' sein",
+			langNl: "Waarde moet kleiner dan of gelijk aan '%[1]s' zijn",
+			langPt: "O valor deve ser menor ou igual a '%[1]s'",
+			langPl: "Wartość musi być mniejsza lub równa '%[1]s'",
+			langJa: "値は'%[1]s'以下でなければなりません",
+			langZh: "值必须小于或等于'%[1]s'",
 		},
 		fmtMsgLtOther: {
 			langEn: fmtMsgLtOther,
@@ -1628,6 +3038,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser menor que el valor de la propiedad '%[1]s'",
 			langIt: "Il valore deve essere inferiore al valore della proprietà '%[1]s'",
 			langDe: "Wert muss kleiner sein als Wert der Eigenschaft '%[1]s'",
+			langNl: "Waarde moet kleiner zijn dan de waarde van eigenschap '%[1]s'",
+			langPt: "O valor deve ser menor que o valor da propriedade '%[1]s'",
+			langPl: "Wartość musi być mniejsza niż wartość właściwości '%[1]s'",
+			langJa: "値はプロパティ'%[1]s'の値より小さくなければなりません",
+			langZh: "值必须小于属性'%[1]s'的值",
 		},
 		fmtMsgLteOther: {
 			langEn: fmtMsgLteOther,
@@ -1635,34 +3050,59 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser menor o igual que el valor de la propiedad '%[1]s'",
 			langIt: "Il valore deve essere inferiore o uguale al valore della proprietà '%[1]s'",
 			langDe: "Wert muss kleiner oder gleich dem Wert der Eigenschaft '%[1]s' sein",
+			langNl: "Waarde moet kleiner dan of gelijk aan de waarde van eigenschap '%[1]s' zijn",
+			langPt: "O valor deve ser menor ou igual ao valor da propriedade '%[1]s'",
+			langPl: "Wartość musi być mniejsza lub równa wartości właściwości '%[1]s'",
+			langJa: "値はプロパティ'%[1]s'の値以下でなければなりません",
+			langZh: "值必须小于或等于属性'%[1]s'的值",
 		},
 		fmtMsgMinLen: {
 			langEn: fmtMsgMinLen,
-			langFr: "La longueur de la valeur doit être d'au moins %[1]d",
-			langEs: "La longitud del valor debe ser al menos %[1]d",
-			langIt: "La lunghezza del valore deve essere almeno %[1]d",
-			langDe: "Wertlänge muss mindestens %[1]d betragen",
+			langFr: "La longueur de la valeur doit être d'au moins {1, plural, one{# élément} other{# éléments}}",
+			langEs: "La longitud del valor debe ser al menos {1, plural, one{# elemento} other{# elementos}}",
+			langIt: "La lunghezza del valore deve essere almeno {1, plural, one{# elemento} other{# elementi}}",
+			langDe: "Wertlänge muss mindestens {1, plural, one{# Element} other{# Elemente}} betragen",
+			langNl: "Lengte van de waarde moet minstens {1, plural, one{# element} other{# elementen}} zijn",
+			langPt: "O comprimento do valor deve ser de pelo menos {1, plural, one{# item} other{# itens}}",
+			langPl: "Długość wartości musi wynosić co najmniej {1, plural, one{# element} few{# elementy} many{# elementów} other{# elementu}}",
+			langJa: "値の長さは少なくとも%[1]d項目でなければなりません",
+			langZh: "值的长度必须至少为%[1]d项",
 		},
 		fmtMsgMinLenExc: {
 			langEn: fmtMsgMinLenExc,
-			langFr: "La longueur de la valeur doit être supérieure à %[1]d",
-			langEs: "La longitud del valor debe ser mayor que %[1]d",
-			langIt: "La lunghezza del valore deve essere maggiore di %[1]d",
-			langDe: "Wertlänge muss größer sein als %[1]d",
+			langFr: "La longueur de la valeur doit être supérieure à {1, plural, one{# élément} other{# éléments}}",
+			langEs: "La longitud del valor debe ser mayor que {1, plural, one{# elemento} other{# elementos}}",
+			langIt: "La lunghezza del valore deve essere maggiore di {1, plural, one{# elemento} other{# elementi}}",
+			langDe: "Wertlänge muss größer sein als {1, plural, one{# Element} other{# Elemente}}",
+			langNl: "Lengte van de waarde moet groter zijn dan {1, plural, one{# element} other{# elementen}}",
+			langPt: "O comprimento do valor deve ser maior que {1, plural, one{# item} other{# itens}}",
+			langPl: "Długość wartości musi być większa niż {1, plural, one{# element} few{# elementy} many{# elementów} other{# elementu}}",
+			langJa: "値の長さは%[1]d項目より大きくなければなりません",
+			langZh: "值的长度必须大于%[1]d项",
 		},
 		fmtMsgMinMax: {
 			langEn: fmtMsgMinMax,
-			langFr: "La longueur de la valeur doit être comprise entre %[1]d (%[2]s) et %[3]d (%[4]s)",
-			langEs: "La longitud del valor debe estar entre %[1]d (%[2]s) y %[3]d (%[4]s)",
-			langIt: "La lunghezza del valore deve essere compresa tra %[1]d (%[2]s) e %[3]d (%[4]s)",
-			langDe: "Wertlänge muss zwischen %[1]d (%[2]s) und %[3]d (%[4]s) liegen",
+			langFr: "La longueur de la valeur doit être comprise entre %[1]d (%[2]s) et {3, plural, one{# élément} other{# éléments}} (%[4]s)",
+			langEs: "La longitud del valor debe estar entre %[1]d (%[2]s) y {3, plural, one{# elemento} other{# elementos}} (%[4]s)",
+			langIt: "La lunghezza del valore deve essere compresa tra %[1]d (%[2]s) e {3, plural, one{# elemento} other{# elementi}} (%[4]s)",
+			langDe: "Wertlänge muss zwischen %[1]d (%[2]s) und {3, plural, one{# Element} other{# Elemente}} (%[4]s) liegen",
+			langNl: "Lengte van de waarde moet tussen %[1]d (%[2]s) en {3, plural, one{# element} other{# elementen}} (%[4]s) liggen",
+			langPt: "O comprimento do valor deve estar entre %[1]d (%[2]s) e {3, plural, one{# item} other{# itens}} (%[4]s)",
+			langPl: "Długość wartości musi mieścić się w zakresie od %[1]d (%[2]s) do {3, plural, one{# elementu} other{# elementów}} (%[4]s)",
+			langJa: "値の長さは%[1]d (%[2]s) から%[3]d項目 (%[4]s) の間でなければなりません",
+			langZh: "值的长度必须介于%[1]d（%[2]s）和%[3]d项（%[4]s）之间",
 		},
 		fmtMsgMultipleOf: {
 			langEn: fmtMsgMultipleOf,
-			langFr: "La valeur doit être un multiple de %[1]d",
-			langEs: "El valor debe ser un múltiplo de %[1]d",
-			langIt: "Il valore deve essere un multiplo di %[1]d",
-			langDe: "Wert muss ein Vielfaches von %[1]d sein",
+			langFr: "La valeur doit être un multiple de {1, number}",
+			langEs: "El valor debe ser un múltiplo de {1, number}",
+			langIt: "Il valore deve essere un multiplo di {1, number}",
+			langDe: "Wert muss ein Vielfaches von {1, number} sein",
+			langNl: "Waarde moet een veelvoud van {1, number} zijn",
+			langPt: "O valor deve ser um múltiplo de {1, number}",
+			langPl: "Wartość musi być wielokrotnością {1, number}",
+			langJa: "値は{1, number}の倍数でなければなりません",
+			langZh: "值必须是{1, number}的倍数",
 		},
 		fmtMsgNotEqualsOther: {
 			langEn: fmtMsgNotEqualsOther,
@@ -1670,6 +3110,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor no debe ser igual al valor de la propiedad '%[1]s'",
 			langIt: "Il valore non deve essere uguale al valore della proprietà '%[1]s'",
 			langDe: "Wert darf nicht gleich dem Wert der Eigenschaft '%[1]s' sein",
+			langNl: "Waarde mag niet gelijk zijn aan de waarde van eigenschap '%[1]s'",
+			langPt: "O valor não deve ser igual ao valor da propriedade '%[1]s'",
+			langPl: "Wartość nie może być równa wartości właściwości '%[1]s'",
+			langJa: "値はプロパティ'%[1]s'の値と等しくてはなりません",
+			langZh: "值不得等于属性'%[1]s'的值",
 		},
 		fmtMsgRange: {
 			langEn: fmtMsgRange,
@@ -1677,48 +3122,83 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe estar entre %[1]v (%[2]s) y %[3]v (%[4]s)",
 			langIt: "Il valore deve essere compreso tra %[1]v (%[2]s) e %[3]v (%[4]s)",
 			langDe: "Wert muss zwischen %[1]v (%[2]s) und %[3]v (%[4]s) liegen",
+			langNl: "Waarde moet tussen %[1]v (%[2]s) en %[3]v (%[4]s) liggen",
+			langPt: "O valor deve estar entre %[1]v (%[2]s) e %[3]v (%[4]s)",
+			langPl: "Wartość musi mieścić się w zakresie od %[1]v (%[2]s) do %[3]v (%[4]s)",
+			langJa: "値は%[1]v (%[2]s) から%[3]v (%[4]s) の間でなければなりません",
+			langZh: "值必须介于%[1]v（%[2]s）和%[3]v（%[4]s）之间",
 		},
 		fmtMsgStringExactLen: {
 			langEn: fmtMsgStringExactLen,
-			langFr: "La longueur de la valeur de la chaîne doit être de %[1]d caractères",
-			langEs: "La longitud del valor de la cadena debe ser %[1]d caracteres",
-			langIt: "La lunghezza del valore della stringa deve essere %[1]d caratteri",
+			langFr: "La longueur de la valeur de la chaîne doit être de {1, plural, one{# caractère} other{# caractères}}",
+			langEs: "La longitud del valor de la cadena debe ser {1, plural, one{# carácter} other{# caracteres}}",
+			langIt: "La lunghezza del valore della stringa deve essere {1, plural, one{# carattere} other{# caratteri}}",
 			langDe: "String-Wert muss %[1]d Zeichen lang sein",
+			langNl: "Lengte van de tekenreekswaarde moet {1, plural, one{# teken} other{# tekens}} zijn",
+			langPt: "O comprimento do valor da string deve ser de {1, plural, one{# caractere} other{# caracteres}}",
+			langPl: "Długość wartości ciągu musi wynosić {1, plural, one{# znak} few{# znaki} many{# znaków} other{# znaku}}",
+			langJa: "文字列値の長さは%[1]d文字でなければなりません",
+			langZh: "字符串值的长度必须为%[1]d个字符",
 		},
 		fmtMsgStringMaxLen: {
 			langEn: fmtMsgStringMaxLen,
-			langFr: "La longueur de la valeur de la chaîne ne doit pas dépasser %[1]d caractères",
-			langEs: "La longitud del valor de la cadena no debe exceder %[1]d caracteres",
-			langIt: "La lunghezza del valore della stringa non deve superare %[1]d caratteri",
+			langFr: "La longueur de la valeur de la chaîne ne doit pas dépasser {1, plural, one{# caractère} other{# caractères}}",
+			langEs: "La longitud del valor de la cadena no debe exceder {1, plural, one{# carácter} other{# caracteres}}",
+			langIt: "La lunghezza del valore della stringa non deve superare {1, plural, one{# carattere} other{# caratteri}}",
 			langDe: "Stringwertlänge darf %[1]d Zeichen nicht überschreiten",
+			langNl: "Lengte van de tekenreekswaarde mag niet meer dan {1, plural, one{# teken} other{# tekens}} zijn",
+			langPt: "O comprimento do valor da string não deve exceder {1, plural, one{# caractere} other{# caracteres}}",
+			langPl: "Długość wartości ciągu nie może przekraczać {1, plural, one{# znaku} other{# znaków}}",
+			langJa: "文字列値の長さは%[1]d文字を超えてはなりません",
+			langZh: "字符串值的长度不得超过%[1]d个字符",
 		},
 		fmtMsgStringMaxLenExc: {
 			langEn: fmtMsgStringMaxLenExc,
-			langFr: "La longueur de la valeur de la chaîne doit être inférieure à %[1]d caractères",
-			langEs: "La longitud del valor de la cadena debe ser inferior a %[1]d caracteres",
-			langIt: "La lunghezza del valore della stringa deve essere inferiore a %[1]d caratteri",
+			langFr: "La longueur de la valeur de la chaîne doit être inférieure à {1, plural, one{# caractère} other{# caractères}}",
+			langEs: "La longitud del valor de la cadena debe ser inferior a {1, plural, one{# carácter} other{# caracteres}}",
+			langIt: "La lunghezza del valore della stringa deve essere inferiore a {1, plural, one{# carattere} other{# caratteri}}",
 			langDe: "String-Wert muss weniger als %[1]d Zeichen lang sein",
+			langNl: "Lengte van de tekenreekswaarde moet minder dan {1, plural, one{# teken} other{# tekens}} zijn",
+			langPt: "O comprimento do valor da string deve ser menor que {1, plural, one{# caractere} other{# caracteres}}",
+			langPl: "Długość wartości ciągu musi być mniejsza niż {1, plural, one{# znak} few{# znaki} many{# znaków} other{# znaku}}",
+			langJa: "文字列値の長さは%[1]d文字未満でなければなりません",
+			langZh: "字符串值的长度必须小于%[1]d个字符",
 		},
 		fmtMsgStringMinLen: {
 			langEn: fmtMsgStringMinLen,
-			langFr: "La longueur de la valeur de la chaîne doit être d'au moins %[1]d caractères",
-			langEs: "La longitud del valor de la cadena debe ser de al menos %[1]d caracteres",
-			langIt: "La lunghezza del valore della stringa deve essere di almeno %[1]d caratteri",
+			langFr: "La longueur de la valeur de la chaîne doit être d'au moins {1, plural, one{# caractère} other{# caractères}}",
+			langEs: "La longitud del valor de la cadena debe ser de al menos {1, plural, one{# carácter} other{# caracteres}}",
+			langIt: "La lunghezza del valore della stringa deve essere di almeno {1, plural, one{# carattere} other{# caratteri}}",
 			langDe: "String-Wert muss mindestens %[1]d Zeichen lang sein",
+			langNl: "Lengte van de tekenreekswaarde moet minstens {1, plural, one{# teken} other{# tekens}} zijn",
+			langPt: "O comprimento do valor da string deve ser de pelo menos {1, plural, one{# caractere} other{# caracteres}}",
+			langPl: "Długość wartości ciągu musi wynosić co najmniej {1, plural, one{# znak} few{# znaki} many{# znaków} other{# znaku}}",
+			langJa: "文字列値の長さは少なくとも%[1]d文字でなければなりません",
+			langZh: "字符串值的长度必须至少为%[1]d个字符",
 		},
 		fmtMsgStringMinLenExc: {
 			langEn: fmtMsgStringMinLenExc,
-			langFr: "La longueur de la valeur de la chaîne doit être supérieure à %[1]d caractères",
-			langEs: "La longitud del valor de la cadena debe ser mayor que %[1]d caracteres",
-			langIt: "La lunghezza del valore della stringa deve essere maggiore di %[1]d caratteri",
+			langFr: "La longueur de la valeur de la chaîne doit être supérieure à {1, plural, one{# caractère} other{# caractères}}",
+			langEs: "La longitud del valor de la cadena debe ser mayor que {1, plural, one{# carácter} other{# caracteres}}",
+			langIt: "La lunghezza del valore della stringa deve essere maggiore di {1, plural, one{# carattere} other{# caratteri}}",
 			langDe: "Stringwertlänge muss größer als %[1]d Zeichen sein",
+			langNl: "Lengte van de tekenreekswaarde moet meer dan {1, plural, one{# teken} other{# tekens}} zijn",
+			langPt: "O comprimento do valor da string deve ser maior que {1, plural, one{# caractere} other{# caracteres}}",
+			langPl: "Długość wartości ciągu musi być większa niż {1, plural, one{# znak} few{# znaki} many{# znaków} other{# znaku}}",
+			langJa: "文字列値の長さは%[1]d文字より長くなければなりません",
+			langZh: "字符串值的长度必须大于%[1]d个字符",
 		},
 		fmtMsgStringMinMaxLen: {
 			langEn: fmtMsgStringMinMaxLen,
-			langFr: "La longueur de la valeur de chaîne doit être comprise entre %[1]d (%[2]s) et %[3]d (%[4]s)",
-			langEs: "La longitud del valor de la cadena debe estar entre %[1]d (%[2]s) y %[3]d (%[4]s)",
-			langIt: "La lunghezza del valore della stringa deve essere compresa tra %[1]d (%[2]s) e %[3]d (%[4]s)",
-			langDe: "Stringwertlänge muss zwischen %[1]d (%[2]s) und %[3]d (%[4]s) liegen",
+			langFr: "La longueur de la valeur de chaîne doit être comprise entre %[1]d (%[2]s) et {3, plural, one{# caractère} other{# caractères}} (%[4]s)",
+			langEs: "La longitud del valor de la cadena debe estar entre %[1]d (%[2]s) y {3, plural, one{# carácter} other{# caracteres}} (%[4]s)",
+			langIt: "La lunghezza del valore della stringa deve essere compresa tra %[1]d (%[2]s) e {3, plural, one{# carattere} other{# caratteri}} (%[4]s)",
+			langDe: "Stringwertlänge muss zwischen %[1]d (%[2]s) und %[3]d Zeichen (%[4]s) liegen",
+			langNl: "Lengte van de tekenreekswaarde moet tussen %[1]d (%[2]s) en {3, plural, one{# teken} other{# tekens}} (%[4]s) liggen",
+			langPt: "O comprimento do valor da string deve estar entre %[1]d (%[2]s) e {3, plural, one{# caractere} other{# caracteres}} (%[4]s)",
+			langPl: "Długość wartości ciągu musi mieścić się w zakresie od %[1]d (%[2]s) do {3, plural, one{# znaku} other{# znaków}} (%[4]s)",
+			langJa: "文字列値の長さは%[1]d (%[2]s) から%[3]d文字 (%[4]s) の間でなければなりません",
+			langZh: "字符串值的长度必须介于%[1]d（%[2]s）和%[3]d个字符（%[4]s）之间",
 		},
 		fmtMsgUnknownPresetPattern: {
 			langEn: fmtMsgUnknownPresetPattern,
@@ -1726,6 +3206,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Patrón predeterminado desconocido '%[1]s'",
 			langIt: "Modello predefinito sconosciuto '%[1]s'",
 			langDe: "Unbekanntes voreingestelltes Muster '%[1]s'",
+			langNl: "Onbekend vooraf ingesteld patroon '%[1]s'",
+			langPt: "Padrão predefinido desconhecido '%[1]s'",
+			langPl: "Nieznany predefiniowany wzorzec '%[1]s'",
+			langJa: "不明なプリセットパターン'%[1]s'",
+			langZh: "未知的预设模式'%[1]s'",
 		},
 		fmtMsgUuidCorrectVer: {
 			langEn: fmtMsgUuidCorrectVer,
@@ -1733,6 +3218,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un UUID válido (versión %[1]d)",
 			langIt: "Il valore deve essere un UUID valido (versione %[1]d)",
 			langDe: "Wert muss eine gültige UUID sein (Version %[1]d)",
+			langNl: "Waarde moet een geldige UUID zijn (versie %[1]d)",
+			langPt: "O valor deve ser um UUID válido (versão %[1]d)",
+			langPl: "Wartość musi być prawidłowym UUID (wersja %[1]d)",
+			langJa: "値は有効なUUID (バージョン%[1]d) でなければなりません",
+			langZh: "值必须是有效的UUID（版本%[1]d）",
 		},
 		fmtMsgUuidMinVersion: {
 			langEn: fmtMsgUuidMinVersion,
@@ -1740,6 +3230,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor debe ser un UUID válido (versión mínima %[1]d)",
 			langIt: "Il valore deve essere un UUID valido (versione minima %[1]d)",
 			langDe: "Wert muss eine gültige UUID sein (Mindestversion %[1]d)",
+			langNl: "Waarde moet een geldige UUID zijn (minimale versie %[1]d)",
+			langPt: "O valor deve ser um UUID válido (versão mínima %[1]d)",
+			langPl: "Wartość musi być prawidłowym UUID (minimalna wersja %[1]d)",
+			langJa: "値は有効なUUID (最小バージョン%[1]d) でなければなりません",
+			langZh: "值必须是有效的UUID（最低版本%[1]d）",
 		},
 		fmtMsgValidToken: {
 			langEn: fmtMsgValidToken,
@@ -1747,6 +3242,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de la cadena debe ser un token válido - %[1]s",
 			langIt: "Il valore della stringa deve essere un token valido - %[1]s",
 			langDe: "String-Wert muss gültiges Token sein - %[1]s",
+			langNl: "Tekenreekswaarde moet een geldig token zijn - \"%[1]s\"",
+			langPt: "O valor da string deve ser um token válido - \"%[1]s\"",
+			langPl: "Wartość ciągu musi być prawidłowym tokenem - \"%[1]s\"",
+			langJa: "文字列値は有効なトークンでなければなりません - \"%[1]s\"",
+			langZh: "字符串值必须是有效的令牌 - \"%[1]s\"",
 		},
 		fmtMsgValueExpectedType: {
 			langEn: fmtMsgValueExpectedType,
@@ -1754,6 +3254,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "Se espera que el valor sea del tipo %[1]s",
 			langIt: "Valore previsto di tipo %[1]s",
 			langDe: "Wert sollte vom Typ %[1]s sein",
+			langNl: "Waarde moet van het type %[1]s zijn",
+			langPt: "Esperava-se que o valor fosse do tipo %[1]s",
+			langPl: "Oczekiwano, że wartość będzie typu %[1]s",
+			langJa: "値は%[1]s型である必要があります",
+			langZh: "值应为%[1]s类型",
 		},
 		fmtMsgStringContains: {
 			langEn: fmtMsgStringContains,
@@ -1761,6 +3266,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena debe contener %[1]s",
 			langIt: "La stringa deve contenere %[1]s",
 			langDe: "Zeichenfolge muss %[1]s enthalten",
+			langNl: "Tekenreeks moet %[1]s bevatten",
+			langPt: "A string deve conter %[1]s",
+			langPl: "Ciąg musi zawierać %[1]s",
+			langJa: "文字列には%[1]sが含まれていなければなりません",
+			langZh: "字符串必须包含%[1]s",
 		},
 		fmtMsgStringNotContains: {
 			langEn: fmtMsgStringNotContains,
@@ -1768,6 +3278,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "La cadena no debe contener %[1]s",
 			langIt: "La stringa non deve contenere %[1]s",
 			langDe: "Zeichenfolge darf %[1]s nicht enthalten",
+			langNl: "Tekenreeks mag %[1]s niet bevatten",
+			langPt: "A string não deve conter %[1]s",
+			langPl: "Ciąg nie może zawierać %[1]s",
+			langJa: "文字列に%[1]sを含めてはなりません",
+			langZh: "字符串不得包含%[1]s",
 		},
 		fmtMsgStringStartsWith: {
 			langEn: fmtMsgStringStartsWith,
@@ -1775,6 +3290,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de cadena debe comenzar con %[1]s",
 			langIt: "Il valore della stringa deve iniziare con %[1]s",
 			langDe: "Zeichenfolgenwert muss mit %[1]s beginnen",
+			langNl: "Tekenreekswaarde moet beginnen met %[1]s",
+			langPt: "O valor da string deve começar com %[1]s",
+			langPl: "Wartość ciągu musi zaczynać się od %[1]s",
+			langJa: "文字列値は%[1]sで始まらなければなりません",
+			langZh: "字符串值必须以%[1]s开头",
 		},
 		fmtMsgStringNotStartsWith: {
 			langEn: fmtMsgStringNotStartsWith,
@@ -1782,6 +3302,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de cadena no debe comenzar con %[1]s",
 			langIt: "Il valore della stringa non deve iniziare con %[1]s",
 			langDe: "Zeichenfolgenwert darf nicht mit %[1]s beginnen",
+			langNl: "Tekenreekswaarde mag niet beginnen met %[1]s",
+			langPt: "O valor da string não deve começar com %[1]s",
+			langPl: "Wartość ciągu nie może zaczynać się od %[1]s",
+			langJa: "文字列値は%[1]sで始まってはなりません",
+			langZh: "字符串值不得以%[1]s开头",
 		},
 		fmtMsgStringEndsWith: {
 			langEn: fmtMsgStringEndsWith,
@@ -1789,6 +3314,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de cadena debe terminar con %[1]s",
 			langIt: "Il valore della stringa deve terminare con %[1]s",
 			langDe: "Zeichenfolgenwert muss mit %[1]s enden",
+			langNl: "Tekenreekswaarde moet eindigen op %[1]s",
+			langPt: "O valor da string deve terminar com %[1]s",
+			langPl: "Wartość ciągu musi kończyć się na %[1]s",
+			langJa: "文字列値は%[1]sで終わらなければなりません",
+			langZh: "字符串值必须以%[1]s结尾",
 		},
 		fmtMsgStringNotEndsWith: {
 			langEn: fmtMsgStringNotEndsWith,
@@ -1796,6 +3326,11 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El valor de cadena no debe terminar con %[1]s",
 			langIt: "Il valore della stringa non deve terminare con %[1]s",
 			langDe: "Zeichenfolgenwert darf nicht mit %[1]s enden",
+			langNl: "Tekenreekswaarde mag niet eindigen op %[1]s",
+			langPt: "O valor da string não deve terminar com %[1]s",
+			langPl: "Wartość ciągu nie może kończyć się na %[1]s",
+			langJa: "文字列値は%[1]sで終わってはなりません",
+			langZh: "字符串值不得以%[1]s结尾",
 		},
 		fmtMsgQueryParamType: {
 			langEn: fmtMsgQueryParamType,
@@ -1803,6 +3338,191 @@ var defaultInternalTranslator = &internalTranslator{
 			langEs: "El parámetro de consulta debe ser del tipo %[1]s",
 			langIt: "Il parametro della query deve essere di tipo %[1]s",
 			langDe: "Der Abfrageparameter muss vom Typ %[1]s sein",
+			langNl: "Queryparameter moet van het type %[1]s zijn",
+			langPt: "O parâmetro de consulta deve ser do tipo %[1]s",
+			langPl: "Parametr zapytania musi być typu %[1]s",
+			langJa: "クエリパラメーターは%[1]s型でなければなりません",
+			langZh: "查询参数必须是%[1]s类型",
+		},
+		fmtMsgPathParamType: {
+			langEn: fmtMsgPathParamType,
+			langFr: "Le paramètre de chemin doit être de type %[1]s",
+			langEs: "El parámetro de ruta debe ser del tipo %[1]s",
+			langIt: "Il parametro del percorso deve essere di tipo %[1]s",
+			langDe: "Der Pfadparameter muss vom Typ %[1]s sein",
+			langNl: "Padparameter moet van het type %[1]s zijn",
+			langPt: "O parâmetro de caminho deve ser do tipo %[1]s",
+			langPl: "Parametr ścieżki musi być typu %[1]s",
+			langJa: "パスパラメーターは%[1]s型でなければなりません",
+			langZh: "路径参数必须是%[1]s类型",
+		},
+		fmtMsgHeaderType: {
+			langEn: fmtMsgHeaderType,
+			langFr: "L'en-tête doit être de type %[1]s",
+			langEs: "El encabezado debe ser del tipo %[1]s",
+			langIt: "L'intestazione deve essere di tipo %[1]s",
+			langDe: "Der Header muss vom Typ %[1]s sein",
+			langNl: "Header moet van het type %[1]s zijn",
+			langPt: "O cabeçalho deve ser do tipo %[1]s",
+			langPl: "Nagłówek musi być typu %[1]s",
+			langJa: "ヘッダーは%[1]s型でなければなりません",
+			langZh: "标头必须是%[1]s类型",
+		},
+		fmtUnitMillennium: {
+			langEn: fmtUnitMillennium,
+			langFr: "{1, plural, one{# millénaire} other{# millénaires}}",
+			langEs: "{1, plural, one{# milenio} other{# milenios}}",
+			langIt: "{1, plural, one{# millennio} other{# millenni}}",
+			langDe: "{1, plural, one{# Jahrtausend} other{# Jahrtausende}}",
+			langNl: "{1, plural, one{# millennium} other{# millennia}}",
+			langPt: "{1, plural, one{# milênio} other{# milênios}}",
+			langPl: "{1, plural, one{# tysiąclecie} few{# tysiąclecia} many{# tysiącleci} other{# tysiąclecia}}",
+			langJa: "{1, plural, other{#千年紀}}",
+			langZh: "{1, plural, other{#千年}}",
+		},
+		fmtUnitCentury: {
+			langEn: fmtUnitCentury,
+			langFr: "{1, plural, one{# siècle} other{# siècles}}",
+			langEs: "{1, plural, one{# siglo} other{# siglos}}",
+			langIt: "{1, plural, one{# secolo} other{# secoli}}",
+			langDe: "{1, plural, one{# Jahrhundert} other{# Jahrhunderte}}",
+			langNl: "{1, plural, one{# eeuw} other{# eeuwen}}",
+			langPt: "{1, plural, one{# século} other{# séculos}}",
+			langPl: "{1, plural, one{# wiek} few{# wieki} many{# wieków} other{# wieku}}",
+			langJa: "{1, plural, other{#世紀}}",
+			langZh: "{1, plural, other{#个世纪}}",
+		},
+		fmtUnitDecade: {
+			langEn: fmtUnitDecade,
+			langFr: "{1, plural, one{# décennie} other{# décennies}}",
+			langEs: "{1, plural, one{# década} other{# décadas}}",
+			langIt: "{1, plural, one{# decennio} other{# decenni}}",
+			langDe: "{1, plural, one{# Jahrzehnt} other{# Jahrzehnte}}",
+			langNl: "{1, plural, one{# decennium} other{# decennia}}",
+			langPt: "{1, plural, one{# década} other{# décadas}}",
+			langPl: "{1, plural, one{# dekada} few{# dekady} many{# dekad} other{# dekady}}",
+			langJa: "{1, plural, other{#十年}}",
+			langZh: "{1, plural, other{#个十年}}",
+		},
+		fmtUnitYear: {
+			langEn: fmtUnitYear,
+			langFr: "{1, plural, one{# an} other{# ans}}",
+			langEs: "{1, plural, one{# año} other{# años}}",
+			langIt: "{1, plural, one{# anno} other{# anni}}",
+			langDe: "{1, plural, one{# Jahr} other{# Jahre}}",
+			langNl: "{1, plural, one{# jaar} other{# jaar}}",
+			langPt: "{1, plural, one{# ano} other{# anos}}",
+			langPl: "{1, plural, one{# rok} few{# lata} many{# lat} other{# roku}}",
+			langJa: "{1, plural, other{#年}}",
+			langZh: "{1, plural, other{#年}}",
+		},
+		fmtUnitMonth: {
+			langEn: fmtUnitMonth,
+			langFr: "{1, plural, one{# mois} other{# mois}}",
+			langEs: "{1, plural, one{# mes} other{# meses}}",
+			langIt: "{1, plural, one{# mese} other{# mesi}}",
+			langDe: "{1, plural, one{# Monat} other{# Monate}}",
+			langNl: "{1, plural, one{# maand} other{# maanden}}",
+			langPt: "{1, plural, one{# mês} other{# meses}}",
+			langPl: "{1, plural, one{# miesiąc} few{# miesiące} many{# miesięcy} other{# miesiąca}}",
+			langJa: "{1, plural, other{#か月}}",
+			langZh: "{1, plural, other{#个月}}",
+		},
+		fmtUnitWeek: {
+			langEn: fmtUnitWeek,
+			langFr: "{1, plural, one{# semaine} other{# semaines}}",
+			langEs: "{1, plural, one{# semana} other{# semanas}}",
+			langIt: "{1, plural, one{# settimana} other{# settimane}}",
+			langDe: "{1, plural, one{# Woche} other{# Wochen}}",
+			langNl: "{1, plural, one{# week} other{# weken}}",
+			langPt: "{1, plural, one{# semana} other{# semanas}}",
+			langPl: "{1, plural, one{# tydzień} few{# tygodnie} many{# tygodni} other{# tygodnia}}",
+			langJa: "{1, plural, other{#週間}}",
+			langZh: "{1, plural, other{#周}}",
+		},
+		fmtUnitDay: {
+			langEn: fmtUnitDay,
+			langFr: "{1, plural, one{# jour} other{# jours}}",
+			langEs: "{1, plural, one{# día} other{# días}}",
+			langIt: "{1, plural, one{# giorno} other{# giorni}}",
+			langDe: "{1, plural, one{# Tag} other{# Tage}}",
+			langNl: "{1, plural, one{# dag} other{# dagen}}",
+			langPt: "{1, plural, one{# dia} other{# dias}}",
+			langPl: "{1, plural, one{# dzień} few{# dni} many{# dni} other{# dnia}}",
+			langJa: "{1, plural, other{#日間}}",
+			langZh: "{1, plural, other{#天}}",
+		},
+		fmtUnitHour: {
+			langEn: fmtUnitHour,
+			langFr: "{1, plural, one{# heure} other{# heures}}",
+			langEs: "{1, plural, one{# hora} other{# horas}}",
+			langIt: "{1, plural, one{# ora} other{# ore}}",
+			langDe: "{1, plural, one{# Stunde} other{# Stunden}}",
+			langNl: "{1, plural, one{# uur} other{# uur}}",
+			langPt: "{1, plural, one{# hora} other{# horas}}",
+			langPl: "{1, plural, one{# godzina} few{# godziny} many{# godzin} other{# godziny}}",
+			langJa: "{1, plural, other{#時間}}",
+			langZh: "{1, plural, other{#小时}}",
+		},
+		fmtUnitMinute: {
+			langEn: fmtUnitMinute,
+			langFr: "{1, plural, one{# minute} other{# minutes}}",
+			langEs: "{1, plural, one{# minuto} other{# minutos}}",
+			langIt: "{1, plural, one{# minuto} other{# minuti}}",
+			langDe: "{1, plural, one{# Minute} other{# Minuten}}",
+			langNl: "{1, plural, one{# minuut} other{# minuten}}",
+			langPt: "{1, plural, one{# minuto} other{# minutos}}",
+			langPl: "{1, plural, one{# minuta} few{# minuty} many{# minut} other{# minuty}}",
+			langJa: "{1, plural, other{#分}}",
+			langZh: "{1, plural, other{#分钟}}",
+		},
+		fmtUnitSecond: {
+			langEn: fmtUnitSecond,
+			langFr: "{1, plural, one{# seconde} other{# secondes}}",
+			langEs: "{1, plural, one{# segundo} other{# segundos}}",
+			langIt: "{1, plural, one{# secondo} other{# secondi}}",
+			langDe: "{1, plural, one{# Sekunde} other{# Sekunden}}",
+			langNl: "{1, plural, one{# seconde} other{# seconden}}",
+			langPt: "{1, plural, one{# segundo} other{# segundos}}",
+			langPl: "{1, plural, one{# sekunda} few{# sekundy} many{# sekund} other{# sekundy}}",
+			langJa: "{1, plural, other{#秒}}",
+			langZh: "{1, plural, other{#秒}}",
+		},
+		fmtUnitMillisecond: {
+			langEn: fmtUnitMillisecond,
+			langFr: "{1, plural, one{# milliseconde} other{# millisecondes}}",
+			langEs: "{1, plural, one{# milisegundo} other{# milisegundos}}",
+			langIt: "{1, plural, one{# millisecondo} other{# millisecondi}}",
+			langDe: "{1, plural, one{# Millisekunde} other{# Millisekunden}}",
+			langNl: "{1, plural, one{# milliseconde} other{# milliseconden}}",
+			langPt: "{1, plural, one{# milissegundo} other{# milissegundos}}",
+			langPl: "{1, plural, one{# milisekunda} few{# milisekundy} many{# milisekund} other{# milisekundy}}",
+			langJa: "{1, plural, other{#ミリ秒}}",
+			langZh: "{1, plural, other{#毫秒}}",
+		},
+		fmtUnitMicrosecond: {
+			langEn: fmtUnitMicrosecond,
+			langFr: "{1, plural, one{# microseconde} other{# microsecondes}}",
+			langEs: "{1, plural, one{# microsegundo} other{# microsegundos}}",
+			langIt: "{1, plural, one{# microsecondo} other{# microsecondi}}",
+			langDe: "{1, plural, one{# Mikrosekunde} other{# Mikrosekunden}}",
+			langNl: "{1, plural, one{# microseconde} other{# microseconden}}",
+			langPt: "{1, plural, one{# microssegundo} other{# microssegundos}}",
+			langPl: "{1, plural, one{# mikrosekunda} few{# mikrosekundy} many{# mikrosekund} other{# mikrosekundy}}",
+			langJa: "{1, plural, other{#マイクロ秒}}",
+			langZh: "{1, plural, other{#微秒}}",
+		},
+		fmtUnitNanosecond: {
+			langEn: fmtUnitNanosecond,
+			langFr: "{1, plural, one{# nanoseconde} other{# nanosecondes}}",
+			langEs: "{1, plural, one{# nanosegundo} other{# nanosegundos}}",
+			langIt: "{1, plural, one{# nanosecondo} other{# nanosecondi}}",
+			langDe: "{1, plural, one{# Nanosekunde} other{# Nanosekunden}}",
+			langNl: "{1, plural, one{# nanoseconde} other{# nanoseconden}}",
+			langPt: "{1, plural, one{# nanossegundo} other{# nanossegundos}}",
+			langPl: "{1, plural, one{# nanosekunda} few{# nanosekundy} many{# nanosekund} other{# nanosekundy}}",
+			langJa: "{1, plural, other{#ナノ秒}}",
+			langZh: "{1, plural, other{#纳秒}}",
 		},
 	},
 }
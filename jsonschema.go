@@ -0,0 +1,235 @@
+package valix
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// JSONSchemaDraft is the JSON Schema draft (as the `$schema` URI) used by Validator.ToJSONSchema
+type JSONSchemaDraft string
+
+const (
+	// JSONSchemaDraft202012 is JSON Schema draft 2020-12 (the default)
+	JSONSchemaDraft202012 JSONSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+	// JSONSchemaDraft201909 is JSON Schema draft 2019-09
+	JSONSchemaDraft201909 JSONSchemaDraft = "https://json-schema.org/draft/2019-09/schema"
+	// JSONSchemaDraft07 is JSON Schema draft-07 (shared definitions are in `definitions` and `dependencies` is used rather than `dependentRequired`)
+	JSONSchemaDraft07 JSONSchemaDraft = "http://json-schema.org/draft-07/schema#"
+)
+
+const (
+	msgJsonSchemaUnsupportedDraft = "unsupported JSON Schema draft '%s'"
+	jsonSchemaDefaultDefName      = "Object"
+)
+
+// ToJSONSchema generates a JSON Schema for the validator (if the draft is empty, JSONSchemaDraft202012 is used)
+//
+// Built-in constraints are mapped to their equivalent keywords (as with ToOpenAPISchema) and object validators that are
+// used more than once are generated as shared definitions (in `$defs`).  Where the PropertyValidator.RequiredWith and
+// PropertyValidator.UnwantedWith expressions only check the presence of sibling properties, they are generated as
+// `dependentRequired` or `if`/`then` - other expressions, conditions and constraints that cannot be expressed are
+// carried as `x-valix-*` annotations
+func (v *Validator) ToJSONSchema(draft JSONSchemaDraft) (map[string]interface{}, error) {
+	useDraft := draft
+	if useDraft == "" {
+		useDraft = JSONSchemaDraft202012
+	}
+	defsKey, refPrefix := "$defs", "#/$defs/"
+	switch useDraft {
+	case JSONSchemaDraft202012, JSONSchemaDraft201909:
+	case JSONSchemaDraft07:
+		defsKey, refPrefix = "definitions", "#/definitions/"
+	default:
+		return nil, fmt.Errorf(msgJsonSchemaUnsupportedDraft, draft)
+	}
+	b := &oasSchemaBuilder{refs: map[*Validator]string{}, draft: useDraft}
+	shared := sharedValidators(v)
+	names := make([]string, 0, len(shared))
+	usedNames := map[string]bool{}
+	for _, sv := range shared {
+		if sv == v {
+			b.refs[sv] = "#"
+			continue
+		}
+		name := jsonSchemaDefName(sv, usedNames)
+		names = append(names, name)
+		b.refs[sv] = refPrefix + name
+	}
+	result := b.validatorSchemaNoRef(v)
+	if len(names) > 0 {
+		defs := map[string]interface{}{}
+		for _, sv := range shared {
+			if sv != v {
+				defs[strings.TrimPrefix(b.refs[sv], refPrefix)] = b.validatorSchemaNoRef(sv)
+			}
+		}
+		result[defsKey] = defs
+	}
+	result["$schema"] = string(useDraft)
+	return result, nil
+}
+
+// sharedValidators finds the object validators that are used more than once (in order of discovery)
+func sharedValidators(root *Validator) []*Validator {
+	counts := map[*Validator]int{}
+	order := make([]*Validator, 0)
+	var visit func(v *Validator)
+	var visitProperties func(properties Properties)
+	visit = func(v *Validator) {
+		counts[v]++
+		if counts[v] > 1 {
+			return
+		}
+		order = append(order, v)
+		visitProperties(v.Properties)
+		for _, cv := range v.ConditionalVariants {
+			visitProperties(cv.Properties)
+		}
+	}
+	visitProperties = func(properties Properties) {
+		names := make([]string, 0, len(properties))
+		for pn := range properties {
+			names = append(names, pn)
+		}
+		sort.Strings(names)
+		for _, pn := range names {
+			if ov := properties[pn].ObjectValidator; ov != nil {
+				visit(ov)
+			}
+		}
+	}
+	visit(root)
+	result := make([]*Validator, 0)
+	for _, v := range order {
+		if counts[v] > 1 {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+var jsonSchemaDefNameRegexp = regexp.MustCompile(`[^A-Za-z0-9_\-]`)
+
+func jsonSchemaDefName(v *Validator, usedNames map[string]bool) string {
+	base := jsonSchemaDefaultDefName
+	if v.OasInfo != nil {
+		if title := jsonSchemaDefNameRegexp.ReplaceAllString(v.OasInfo.Title, ""); title != "" {
+			base = title
+		}
+	}
+	result := base
+	for i := 2; usedNames[result]; i++ {
+		result = base + strconv.Itoa(i)
+	}
+	usedNames[result] = true
+	return result
+}
+
+// addPresenceDependencies adds `dependentRequired` (or `dependencies`) and `if`/`then` for the property
+// validator RequiredWith and UnwantedWith expressions that can be expressed
+func (b *oasSchemaBuilder) addPresenceDependencies(schema map[string]interface{}, properties Properties) {
+	names := make([]string, 0, len(properties))
+	for pn := range properties {
+		names = append(names, pn)
+	}
+	sort.Strings(names)
+	dependents := map[string]interface{}{}
+	conditionals := make([]interface{}, 0)
+	for _, pn := range names {
+		pv := properties[pn]
+		if len(pv.RequiredWith) > 0 && isPresenceExpressible(pv.RequiredWith) {
+			if other, ok := singlePresentProperty(pv.RequiredWith); ok {
+				list, _ := dependents[other].([]interface{})
+				dependents[other] = append(list, pn)
+			} else {
+				conditionals = append(conditionals, map[string]interface{}{
+					"if":   presenceSchema(pv.RequiredWith),
+					"then": map[string]interface{}{oasKeyRequired: []interface{}{pn}},
+				})
+			}
+		}
+		if len(pv.UnwantedWith) > 0 && isPresenceExpressible(pv.UnwantedWith) {
+			conditionals = append(conditionals, map[string]interface{}{
+				"if":   presenceSchema(pv.UnwantedWith),
+				"then": map[string]interface{}{"not": map[string]interface{}{oasKeyRequired: []interface{}{pn}}},
+			})
+		}
+	}
+	if len(dependents) > 0 {
+		schema[ternary(b.draft == JSONSchemaDraft07).string("dependencies", "dependentRequired")] = dependents
+	}
+	if len(conditionals) > 0 {
+		schema["allOf"] = conditionals
+	}
+}
+
+// isPresenceExpressible determines whether an expression only checks the presence of sibling properties
+// (i.e. no paths to other objects and no condition checks)
+func isPresenceExpressible(expr OthersExpr) bool {
+	for _, item := range expr {
+		switch it := item.(type) {
+		case *OtherProperty:
+			if it.Name == "" || strings.ContainsAny(it.Name, ".~\\") {
+				return false
+			}
+		case *OtherGrouping:
+			if !isPresenceExpressible(it.Of) {
+				return false
+			}
+		case *OthersExpr:
+			if !isPresenceExpressible(*it) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return len(expr) > 0
+}
+
+// singlePresentProperty determines whether the expression is just the presence of a single property
+func singlePresentProperty(expr OthersExpr) (string, bool) {
+	if len(expr) == 1 {
+		if op, ok := expr[0].(*OtherProperty); ok && !op.Not {
+			return op.Name, true
+		}
+	}
+	return "", false
+}
+
+func presenceSchema(expr OthersExpr) map[string]interface{} {
+	var result map[string]interface{}
+	for i, item := range expr {
+		var s map[string]interface{}
+		switch it := item.(type) {
+		case *OtherProperty:
+			s = map[string]interface{}{oasKeyRequired: []interface{}{it.Name}}
+			if it.Not {
+				s = map[string]interface{}{"not": s}
+			}
+		case *OtherGrouping:
+			s = presenceSchema(it.Of)
+			if it.Not {
+				s = map[string]interface{}{"not": s}
+			}
+		case *OthersExpr:
+			s = presenceSchema(*it)
+		}
+		if i == 0 {
+			result = s
+		} else {
+			switch item.GetOperator() {
+			case Or:
+				result = map[string]interface{}{"anyOf": []interface{}{result, s}}
+			case Xor:
+				result = map[string]interface{}{oasKeyOneOf: []interface{}{result, s}}
+			default:
+				result = map[string]interface{}{"allOf": []interface{}{result, s}}
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,120 @@
+package valix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator_ToJSONSchema(t *testing.T) {
+	address := &Validator{
+		OasInfo: &OasInfo{Title: "Address"},
+		Properties: Properties{
+			"street": {Type: JsonString, NotNull: true, Mandatory: true},
+		},
+	}
+	v := &Validator{
+		Properties: Properties{
+			"name":     {Type: JsonString, NotNull: true, Constraints: Constraints{&StringMaxLength{Value: 10}}},
+			"home":     {Type: JsonObject, ObjectValidator: address},
+			"work":     {Type: JsonObject, ObjectValidator: address},
+			"other":    {Type: JsonObject, ObjectValidator: &Validator{}},
+			"city":     {Type: JsonString, RequiredWith: MustParseExpression("street")},
+			"street":   {Type: JsonString},
+			"zip":      {Type: JsonString, RequiredWith: MustParseExpression("street && city")},
+			"po":       {Type: JsonString, UnwantedWith: MustParseExpression("street || !zip")},
+			"parented": {Type: JsonString, RequiredWith: MustParseExpression("..foo")},
+			"cond":     {Type: JsonString, UnwantedWith: MustParseExpression("~bar")},
+		},
+	}
+	schema, err := v.ToJSONSchema("")
+	require.NoError(t, err)
+	require.Equal(t, string(JSONSchemaDraft202012), schema["$schema"])
+	defs := schema["$defs"].(map[string]interface{})
+	require.Equal(t, 1, len(defs))
+	require.Equal(t, "object", defs["Address"].(map[string]interface{})["type"])
+	pties := schema["properties"].(map[string]interface{})
+	require.Equal(t, "#/$defs/Address", pties["home"].(map[string]interface{})["$ref"])
+	require.Equal(t, "#/$defs/Address", pties["work"].(map[string]interface{})["$ref"])
+	require.Equal(t, "object", pties["other"].(map[string]interface{})["type"].([]interface{})[0])
+	require.Equal(t, 10, pties["name"].(map[string]interface{})["maxLength"])
+
+	require.Equal(t, map[string]interface{}{"street": []interface{}{"city"}}, schema["dependentRequired"])
+	conditionals := schema["allOf"].([]interface{})
+	require.Equal(t, 2, len(conditionals))
+	po := conditionals[0].(map[string]interface{})
+	require.Equal(t, map[string]interface{}{
+		"anyOf": []interface{}{
+			map[string]interface{}{"required": []interface{}{"street"}},
+			map[string]interface{}{"not": map[string]interface{}{"required": []interface{}{"zip"}}},
+		},
+	}, po["if"])
+	require.Equal(t, map[string]interface{}{"not": map[string]interface{}{"required": []interface{}{"po"}}}, po["then"])
+	zip := conditionals[1].(map[string]interface{})
+	require.Equal(t, map[string]interface{}{
+		"allOf": []interface{}{
+			map[string]interface{}{"required": []interface{}{"street"}},
+			map[string]interface{}{"required": []interface{}{"city"}},
+		},
+	}, zip["if"])
+	require.Equal(t, map[string]interface{}{"required": []interface{}{"zip"}}, zip["then"])
+
+	// inexpressible carried as annotations...
+	require.Equal(t, "..foo", pties["parented"].(map[string]interface{})["x-valix-requiredWith"])
+	require.Equal(t, "~bar", pties["cond"].(map[string]interface{})["x-valix-unwantedWith"])
+	_, has := pties["city"].(map[string]interface{})["x-valix-requiredWith"]
+	require.False(t, has)
+}
+
+func TestValidator_ToJSONSchemaDrafts(t *testing.T) {
+	shared := &Validator{}
+	v := &Validator{
+		Properties: Properties{
+			"a": {Type: JsonObject, ObjectValidator: shared},
+			"b": {Type: JsonObject, ObjectValidator: shared},
+			"c": {Type: JsonString, RequiredWith: MustParseExpression("a")},
+		},
+	}
+	schema, err := v.ToJSONSchema(JSONSchemaDraft07)
+	require.NoError(t, err)
+	require.Equal(t, string(JSONSchemaDraft07), schema["$schema"])
+	require.Equal(t, 1, len(schema["definitions"].(map[string]interface{})))
+	require.Equal(t, "#/definitions/Object", schema["properties"].(map[string]interface{})["a"].(map[string]interface{})["$ref"])
+	require.Equal(t, map[string]interface{}{"a": []interface{}{"c"}}, schema["dependencies"])
+
+	schema, err = v.ToJSONSchema(JSONSchemaDraft201909)
+	require.NoError(t, err)
+	require.Equal(t, string(JSONSchemaDraft201909), schema["$schema"])
+	require.NotNil(t, schema["dependentRequired"])
+
+	_, err = v.ToJSONSchema("draft-04")
+	require.Error(t, err)
+	require.Equal(t, "unsupported JSON Schema draft 'draft-04'", err.Error())
+
+	// OpenAPI schema still uses annotations...
+	oas := v.ToOpenAPISchema()
+	require.Equal(t, "a", oas["properties"].(map[string]interface{})["c"].(map[string]interface{})["x-valix-requiredWith"])
+}
+
+func TestValidator_ToJSONSchemaRecursive(t *testing.T) {
+	node := &Validator{
+		OasInfo: &OasInfo{Title: "Node"},
+		Properties: Properties{
+			"name": {Type: JsonString},
+		},
+	}
+	node.Properties["children"] = &PropertyValidator{Type: JsonArray, ObjectValidator: &Validator{
+		AllowArray:     true,
+		DisallowObject: true,
+		Properties: Properties{
+			"node": {Type: JsonObject, ObjectValidator: node},
+		},
+	}}
+	node.Properties["parent"] = &PropertyValidator{Type: JsonObject, ObjectValidator: node}
+	schema, err := node.ToJSONSchema("")
+	require.NoError(t, err)
+	pties := schema["properties"].(map[string]interface{})
+	require.Equal(t, "#", pties["parent"].(map[string]interface{})["$ref"])
+	_, hasDefs := schema["$defs"]
+	require.False(t, hasDefs)
+}
@@ -32,6 +32,9 @@ func (v *Validator) toJSON() (map[string]interface{}, error) {
 		ptyNameStopOnFirst:             v.StopOnFirst,
 		ptyNameUseNumber:               v.UseNumber,
 		ptyNameOrderedPropertyChecks:   v.OrderedPropertyChecks,
+		ptyNameIncludeRejectedValues:   v.IncludeRejectedValues,
+		ptyNameIncludeSourcePositions:  v.IncludeSourcePositions,
+		ptyNamePathFormat:              v.PathFormat.String(),
 		ptyNameProperties:              properties,
 	}
 	if len(v.Constraints) > 0 {
@@ -106,6 +109,9 @@ func (pv *PropertyValidator) toJSON() (map[string]interface{}, error) {
 		}
 		result[ptyNameUnwantedConditions] = arr
 	}
+	if pv.Label != "" {
+		result[ptyNameLabel] = pv.Label
+	}
 	if pv.RequiredWith != nil {
 		result[ptyNameRequiredWith] = pv.RequiredWith.String()
 	}
@@ -231,6 +237,24 @@ func (oas *OasInfo) toJson() map[string]interface{} {
 		ptyNameOasFormat:      oas.Format,
 		ptyNameOasExample:     oas.Example,
 		ptyNameOasDeprecated:  oas.Deprecated,
+		ptyNameOasReadOnly:    oas.ReadOnly,
+		ptyNameOasWriteOnly:   oas.WriteOnly,
+		ptyNameOasExamples:    oas.Examples,
+		ptyNameOasDefault:     oas.Default,
+		ptyNameOasEnum:        oas.Enum,
+		ptyNameOasExtDocs:     oas.externalDocsToJson(),
+		ptyNameOasNullable:    oas.Nullable,
+		ptyNameOasExtensions:  oas.Extensions,
+	}
+}
+
+func (oas *OasInfo) externalDocsToJson() interface{} {
+	if oas.ExternalDocs == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		ptyNameOasExtDocsUrl:  oas.ExternalDocs.URL,
+		ptyNameOasDescription: oas.ExternalDocs.Description,
 	}
 }
 
@@ -295,6 +319,21 @@ func (c *SetConditionIf) MarshalJSON() ([]byte, error) {
 	return json.Marshal(j)
 }
 
+func (c *WarningOnly) MarshalJSON() ([]byte, error) {
+	j := map[string]interface{}{
+		"Info":       c.Info,
+		"Constraint": nil,
+	}
+	if c.Constraint != nil {
+		if cj, err := constraintToJson(c.Constraint); err == nil {
+			j["Constraint"] = cj
+		} else {
+			return nil, err
+		}
+	}
+	return json.Marshal(j)
+}
+
 func (o *OthersExpr) MarshalJSON() ([]byte, error) {
 	str := `"` + strings.ReplaceAll(o.String(), `"`, `\"`) + `"`
 	return []byte(str), nil
@@ -147,12 +147,15 @@ func TestValidator_MarshalJSON(t *testing.T) {
 	require.True(t, valid)
 
 	// and check the JSON matches the validator...
-	require.Equal(t, 13, len(obj))
+	require.Equal(t, 16, len(obj))
 	require.True(t, obj[ptyNameIgnoreUnknownProperties].(bool))
 	require.True(t, obj[ptyNameAllowArray].(bool))
 	require.True(t, obj[ptyNameDisallowObject].(bool))
 	require.True(t, obj[ptyNameStopOnFirst].(bool))
 	require.True(t, obj[ptyNameOrderedPropertyChecks].(bool))
+	require.False(t, obj[ptyNameIncludeRejectedValues].(bool))
+	require.False(t, obj[ptyNameIncludeSourcePositions].(bool))
+	require.Equal(t, "dotted", obj[ptyNamePathFormat])
 	require.True(t, obj[ptyNameAllowNullItems].(bool))
 	require.Equal(t, 1, len(obj[ptyNameWhenConditions].([]interface{})))
 	require.Equal(t, "test", obj[ptyNameWhenConditions].([]interface{})[0])
@@ -196,7 +199,7 @@ func TestValidator_MarshalJSON(t *testing.T) {
 	require.Equal(t, 1, len(slc))
 
 	sub = obj[ptyNameOasInfo].(map[string]interface{})
-	require.Equal(t, 5, len(sub))
+	require.Equal(t, 13, len(sub))
 	require.True(t, sub[ptyNameOasDeprecated].(bool))
 
 	sub = obj[ptyNameProperties].(map[string]interface{})
@@ -235,7 +238,7 @@ func TestValidator_MarshalJSON(t *testing.T) {
 	require.Equal(t, "message 5", fields["Message"])
 	require.Equal(t, "^([A-Z]+)$", fields["Regexp"])
 	subSub := obj[ptyNameOasInfo].(map[string]interface{})
-	require.Equal(t, 5, len(subSub))
+	require.Equal(t, 13, len(subSub))
 	require.True(t, subSub[ptyNameOasDeprecated].(bool))
 
 	pty = sub["bar"].(map[string]interface{})
@@ -496,3 +499,81 @@ func TestConstraint_SetConditionIf_MarshalJSONFails(t *testing.T) {
 	_, err := json.Marshal(c)
 	require.Error(t, err)
 }
+
+func TestOasInfoMarshalUnmarshalRoundTrip(t *testing.T) {
+	nullable := false
+	v := &Validator{
+		Properties: Properties{
+			"foo": {
+				Type: JsonString,
+				OasInfo: &OasInfo{
+					Example:      "a",
+					Examples:     map[string]string{"first": "b", "second": "c"},
+					Default:      "d",
+					Enum:         []string{"a", "b", "c", "d"},
+					ExternalDocs: &OasExternalDocs{URL: "https://example.com/docs", Description: "docs"},
+					Nullable:     &nullable,
+					Extensions:   map[string]interface{}{"x-foo": "bar", "x-num": float64(1)},
+				},
+			},
+		},
+	}
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	v2 := &Validator{}
+	err = json.Unmarshal(data, v2)
+	require.NoError(t, err)
+	require.Equal(t, v.Properties["foo"].OasInfo, v2.Properties["foo"].OasInfo)
+
+	obj := map[string]interface{}{}
+	err = json.Unmarshal(data, &obj)
+	require.NoError(t, err)
+	ok, _ := ValidatorValidator.Validate(obj)
+	require.True(t, ok)
+
+	ok, violations := ValidatorValidator.Validate(jsonObject(`{"oasInfo":{"examples":{"first":1}}}`))
+	require.False(t, ok)
+	require.Equal(t, msgOasExamplesStrings, violations[0].Message)
+	ok, violations = ValidatorValidator.Validate(jsonObject(`{"oasInfo":{"extensions":{"foo":1}}}`))
+	require.False(t, ok)
+	require.Equal(t, msgOasExtensionsPrefix, violations[0].Message)
+	ok, violations = ValidatorValidator.Validate(jsonObject(`{"oasInfo":{"externalDocs":{"unknown":""}}}`))
+	require.False(t, ok)
+	require.Equal(t, msgOasExternalDocs, violations[0].Message)
+}
+
+func TestConstraint_WarningOnly_MarshalUnmarshalRoundTrip(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"foo": {
+				Type: JsonString,
+				Constraints: Constraints{
+					&WarningOnly{Constraint: &StringMaxLength{Value: 3}, Info: true},
+				},
+			},
+		},
+	}
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	uv := &Validator{}
+	err = json.Unmarshal(data, uv)
+	require.NoError(t, err)
+	w, ok := uv.Properties["foo"].Constraints[0].(*WarningOnly)
+	require.True(t, ok)
+	require.True(t, w.Info)
+	require.Equal(t, 3, w.Constraint.(*StringMaxLength).Value)
+}
+
+func TestPropertyValidator_MarshalJSON_WithLabel(t *testing.T) {
+	pv := &PropertyValidator{Label: "Postcode"}
+	data, err := json.Marshal(pv)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"label":"Postcode"`)
+	v := &Validator{}
+	err = json.Unmarshal([]byte(`{"properties":{"postcode":`+string(data)+`}}`), v)
+	require.NoError(t, err)
+	require.Equal(t, "Postcode", v.Properties["postcode"].Label)
+	require.Equal(t, "Postcode", v.Properties["postcode"].Clone().Label)
+}
@@ -0,0 +1,281 @@
+package valix
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Message templates
+//
+// Violation messages (including a constraint's Message field, PropertyValidator.RequiredWithMessage,
+// PropertyValidator.UnwantedWithMessage, PropertyValidator.OnlyMessage, the equivalent "_msg" v8n tag tokens and
+// any translations of those messages) may contain named placeholders - e.g.
+//   "{property} must be between {minimum} and {maximum} characters (got {value})"
+//
+// The placeholders available are:
+//   {property}   - the name of the violating property (or "[n]" for array elements)
+//   {label}      - the translated label of the violating property (see PropertyValidator.Label) - or the
+//                  property name if the property has no label
+//   {path}       - the path of the violating property (see Violation.Path)
+//   {fullPath}   - the full path of the violating property (see Violation.FullPath)
+//   {value}      - the rejected value (only available where the value is present)
+//   {constraint} - the name of the failing constraint (only for violations from failing constraints)
+//
+// plus the constraint's published placeholder values - which are the constraint's fields (first letter lower-cased,
+// e.g. {minimum}, {maximum}, {exclusiveMin} for a Length constraint).  Where a constraint has a single Value
+// field, that value is also published using a descriptive name (e.g. {maximum} for StringMaxLength, {length} for
+// StringExactLength).  Constraints can publish additional placeholder values by implementing
+// PlaceholderValuesProvider
+//
+// Placeholders with names that are not available are left as-is.  A literal "{" can be written as "{{" (only in
+// messages that use at least one available placeholder - messages that use none are left entirely as-is)
+//
+// For constraints, only the constraint's Message is rendered as a template - default messages (which already contain
+// the constraint's values) are not
+
+// PlaceholderValuesProvider is an interface that constraints can implement to publish additional values for named
+// placeholders in violation message templates
+type PlaceholderValuesProvider interface {
+	PlaceholderValues() map[string]interface{}
+}
+
+// I18nTemplateRenderer is an optional interface that I18nContext implementations can implement to control the
+// rendering of message templates (e.g. to provide locale specific formatting of values)
+//
+// Where an I18nContext does not implement this interface, templates are rendered using RenderMessageTemplate
+type I18nTemplateRenderer interface {
+	// RenderTemplate renders the (already translated) template with the placeholder values
+	RenderTemplate(template string, values map[string]interface{}) string
+}
+
+const (
+	placeholderProperty   = "property"
+	placeholderLabel      = "label"
+	placeholderPath       = "path"
+	placeholderFullPath   = "fullPath"
+	placeholderValue      = "value"
+	placeholderConstraint = "constraint"
+)
+
+// constraintValuePlaceholders is the descriptive placeholder names for built-in constraints that have a single
+// Value field
+var constraintValuePlaceholders = map[string]string{
+	"LengthExact":                "length",
+	"StringExactLength":          "length",
+	"StringMaxLength":            "maximum",
+	"StringMinLength":            "minimum",
+	"Maximum":                    "maximum",
+	"MaximumInt":                 "maximum",
+	"Minimum":                    "minimum",
+	"MinimumInt":                 "minimum",
+	"LessThan":                   "maximum",
+	"LessThanOrEqual":            "maximum",
+	"GreaterThan":                "minimum",
+	"GreaterThanOrEqual":         "minimum",
+	"StringLessThan":             "maximum",
+	"StringLessThanOrEqual":      "maximum",
+	"StringGreaterThan":          "minimum",
+	"StringGreaterThanOrEqual":   "minimum",
+	"DatetimeLessThan":           "maximum",
+	"DatetimeLessThanOrEqual":    "maximum",
+	"DatetimeGreaterThan":        "minimum",
+	"DatetimeGreaterThanOrEqual": "minimum",
+	"DatetimeTolerance":          "datetime",
+	"MultipleOf":                 "multiple",
+	"StringContains":             "contains",
+	"StringStartsWith":           "prefix",
+	"StringEndsWith":             "suffix",
+}
+
+// RenderMessageTemplate renders the named placeholders (e.g. "{property}") in a message template using the supplied
+// values - if the I18nContext implements I18nTemplateRenderer, the rendering is delegated to it
+//
+// ICU MessageFormat style arguments (e.g. "{maximum, plural, one{# character} other{# characters}}") are expanded
+// using the locale of the I18nContext (see FormatMessage)
+//
+// Note: the template is not translated (templates should be translated prior to rendering)
+func RenderMessageTemplate(tcx I18nContext, template string, values map[string]interface{}) string {
+	if r, ok := tcx.(I18nTemplateRenderer); ok {
+		return r.RenderTemplate(template, values)
+	}
+	return renderMessageTemplate(expandMessageFormat(formatterFor(tcx), template, valuesLookup(values), escapeTemplateBrace), values)
+}
+
+func renderMessageTemplate(template string, values map[string]interface{}) string {
+	if !strings.Contains(template, "{") {
+		return template
+	}
+	var sb strings.Builder
+	for i := 0; i < len(template); i++ {
+		ch := template[i]
+		if ch == '{' {
+			if i+1 < len(template) && template[i+1] == '{' {
+				sb.WriteByte('{')
+				i++
+				continue
+			}
+			if end := strings.IndexByte(template[i:], '}'); end != -1 {
+				if value, ok := values[template[i+1:i+end]]; ok {
+					sb.WriteString(placeholderValueString(value))
+					i += end
+					continue
+				}
+			}
+		}
+		sb.WriteByte(ch)
+	}
+	return sb.String()
+}
+
+func placeholderValueString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case json.Number:
+		return v.String()
+	case fmt.Stringer:
+		return v.String()
+	case []string:
+		return strings.Join(v, ", ")
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Slice {
+		parts := make([]string, rv.Len())
+		for i := range parts {
+			parts[i] = placeholderValueString(rv.Index(i).Interface())
+		}
+		return strings.Join(parts, ", ")
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// constraintPlaceholderValues returns the published placeholder values for the constraint
+func constraintPlaceholderValues(constraint Constraint) map[string]interface{} {
+	result := map[string]interface{}{}
+	useConstraint := unwrapConstraint(constraint)
+	if rv := reflect.ValueOf(useConstraint); rv.IsValid() && rv.Kind() == reflect.Ptr && !rv.IsNil() && rv.Elem().Kind() == reflect.Struct {
+		name := rv.Elem().Type().Name()
+		for k, v := range constraintParameters(rv.Elem()) {
+			result[k] = v
+		}
+		if alias, ok := constraintValuePlaceholders[name]; ok {
+			if v, ok := result[placeholderValue]; ok {
+				result[alias] = v
+			}
+		}
+		result[placeholderConstraint] = name
+	}
+	if pvp, ok := useConstraint.(PlaceholderValuesProvider); ok {
+		for k, v := range pvp.PlaceholderValues() {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// usesPlaceholders determines whether the template uses any of the named placeholders (including as ICU
+// MessageFormat style arguments) - templates that do not are not rendered (so that any "{{" is left as-is)
+func usesPlaceholders(template string, values map[string]interface{}) bool {
+	for i := 0; i < len(template); i++ {
+		if template[i] != '{' {
+			continue
+		}
+		if i+1 < len(template) && template[i+1] == '{' {
+			i++
+			continue
+		}
+		if end := strings.IndexAny(template[i+1:], "},"); end != -1 {
+			if _, ok := values[strings.TrimSpace(template[i+1:i+1+end])]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// constraintMessageTemplate returns the Message field of the (unwrapped) constraint - or an empty string if the
+// constraint has no Message field (or it is NoMessage)
+func constraintMessageTemplate(constraint Constraint) string {
+	if rv := reflect.ValueOf(unwrapConstraint(constraint)); rv.IsValid() && rv.Kind() == reflect.Ptr && !rv.IsNil() && rv.Elem().Kind() == reflect.Struct {
+		if fv := rv.Elem().FieldByName(constraintPtyNameMessage); fv.IsValid() && fv.Kind() == reflect.String && fv.String() != NoMessage {
+			return fv.String()
+		}
+	}
+	return ""
+}
+
+// renderConstraintMessageForCurrent renders the message template for a failing constraint on the current property
+//
+// The message is only rendered where it is the constraint's (translated) Message template - messages built by the
+// constraint (e.g. from message formats) already contain runtime values that must not be treated as template text
+func (vc *ValidatorContext) renderConstraintMessageForCurrent(msg string, constraint Constraint) string {
+	if template := constraintMessageTemplate(constraint); template != "" && msg == vc.TranslateMessage(template) {
+		return vc.renderMessageForCurrent(msg, nil, constraint)
+	}
+	return msg
+}
+
+// renderMessageForCurrent renders the message template for a violation on the current property (or, if name is
+// non-nil, the named property of the current object)
+func (vc *ValidatorContext) renderMessageForCurrent(msg string, name *string, constraint Constraint) string {
+	if !strings.Contains(msg, "{") {
+		return msg
+	}
+	values := map[string]interface{}{}
+	if constraint != nil {
+		values = constraintPlaceholderValues(constraint)
+	}
+	curr := vc.currentStackItem()
+	if name != nil {
+		values[placeholderProperty] = *name
+		values[placeholderLabel] = vc.propertyLabel(*name)
+		values[placeholderPath] = curr.asPath()
+		values[placeholderFullPath] = curr.format.join(curr.asPath(), *name)
+		if obj, ok := curr.value.(map[string]interface{}); ok {
+			if v, present := obj[*name]; present {
+				values[placeholderValue] = v
+			} else {
+				delete(values, placeholderValue)
+			}
+		} else {
+			delete(values, placeholderValue)
+		}
+	} else {
+		values[placeholderProperty] = curr.propertyAsString()
+		values[placeholderLabel] = vc.CurrentLabel()
+		values[placeholderPath] = curr.path
+		values[placeholderFullPath] = curr.asPath()
+		values[placeholderValue] = curr.value
+	}
+	if !usesPlaceholders(msg, values) {
+		return msg
+	}
+	return RenderMessageTemplate(vc.i18nContext, msg, values)
+}
+
+// propertyLabel returns the translated label of the named property of the current object (or the property name
+// if the property has no label)
+func (vc *ValidatorContext) propertyLabel(name string) string {
+	var properties Properties
+	switch v := vc.currentStackItem().validator.(type) {
+	case *Validator:
+		properties = v.Properties
+	case *PropertyValidator:
+		if v.ObjectValidator != nil {
+			properties = v.ObjectValidator.Properties
+		}
+	}
+	if pv, ok := propertiesRepo.fetch(properties)[name]; ok && pv != nil && pv.Label != "" {
+		return vc.TranslateToken(pv.Label)
+	}
+	return name
+}
@@ -0,0 +1,260 @@
+package valix
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderMessageTemplate(t *testing.T) {
+	values := map[string]interface{}{
+		"property": "foo",
+		"minimum":  float64(1),
+		"maximum":  json.Number("10"),
+		"ratio":    0.5,
+		"tokens":   []string{"a", "b"},
+		"numbers":  []int{1, 2},
+		"nothing":  nil,
+		"flag":     true,
+	}
+	testCases := []struct {
+		template string
+		expect   string
+	}{
+		{"no placeholders", "no placeholders"},
+		{"{property} between {minimum} and {maximum}", "foo between 1 and 10"},
+		{"{ratio} {tokens} {numbers} {nothing} {flag}", "0.5 a, b 1, 2 null true"},
+		{"{unknown} left as-is", "{unknown} left as-is"},
+		{"{{property}} escaped", "{property}} escaped"},
+		{"unclosed {property", "unclosed {property"},
+		{"{}", "{}"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.template, func(t *testing.T) {
+			require.Equal(t, tc.expect, RenderMessageTemplate(nil, tc.template, values))
+		})
+	}
+}
+
+type testTemplateI18nContext struct {
+	I18nContext
+}
+
+func (c *testTemplateI18nContext) RenderTemplate(template string, values map[string]interface{}) string {
+	return strings.ToUpper(renderMessageTemplate(template, values))
+}
+
+func TestRenderMessageTemplate_WithRenderer(t *testing.T) {
+	tcx := &testTemplateI18nContext{newDefaultI18nContext("en", "")}
+	require.Equal(t, "FOO", RenderMessageTemplate(tcx, "{property}", map[string]interface{}{"property": "foo"}))
+}
+
+func TestMessageTemplates_ConstraintMessages(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"name": {
+				Type: JsonString,
+				Constraints: Constraints{
+					&StringMaxLength{Value: 3, Message: "{property} must not exceed {maximum} characters (got '{value}')"},
+				},
+			},
+			"code": {
+				Type: JsonString,
+				Constraints: Constraints{
+					&StringLength{Minimum: 2, Maximum: 4, Message: "{fullPath} length must be {minimum}-{maximum} ({constraint})"},
+				},
+			},
+			"age": {
+				Type: JsonInteger,
+				Constraints: Constraints{
+					&ConditionalConstraint{
+						When:       Conditions{"!SKIP"},
+						Constraint: &Minimum{Value: 18, Message: "{property} must be at least {minimum} - not {value}"},
+					},
+				},
+			},
+		},
+	}
+	ok, violations := v.Validate(map[string]interface{}{"name": "abcd", "code": "x", "age": float64(16)})
+	require.False(t, ok)
+	require.Equal(t, 3, len(violations))
+	byPath := ViolationsByPath(violations)
+	require.Equal(t, "name must not exceed 3 characters (got 'abcd')", byPath["name"][0].Message)
+	require.Equal(t, "code length must be 2-4 (StringLength)", byPath["code"][0].Message)
+	require.Equal(t, "age must be at least 18 - not 16", byPath["age"][0].Message)
+}
+
+type testPlaceholderConstraint struct {
+	Message string
+}
+
+func (c *testPlaceholderConstraint) Check(v interface{}, vcx *ValidatorContext) (bool, string) {
+	return false, c.GetMessage(vcx)
+}
+
+func (c *testPlaceholderConstraint) GetMessage(tcx I18nContext) string {
+	return defaultMessage(tcx, c.Message, "")
+}
+
+func (c *testPlaceholderConstraint) PlaceholderValues() map[string]interface{} {
+	return map[string]interface{}{"expected": "something else"}
+}
+
+func TestMessageTemplates_PlaceholderValuesProvider(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"foo": {
+				Type:        JsonAny,
+				Constraints: Constraints{&testPlaceholderConstraint{Message: "expected {expected} for {property}"}},
+			},
+		},
+	}
+	_, violations := v.Validate(map[string]interface{}{"foo": "x"})
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "expected something else for foo", violations[0].Message)
+}
+
+func TestMessageTemplates_PropertyMessages(t *testing.T) {
+	type myStruct struct {
+		Foo string `json:"foo" v8n:"only,only_msg:'{property} must be alone at {fullPath} (not with {value})'"`
+		Bar string `json:"bar" v8n:"+:baz,+msg:'{property} is required with baz'"`
+		Baz string `json:"baz" v8n:"-:qux,-msg:'{property} unwanted with qux - got {value}'"`
+		Qux string `json:"qux"`
+	}
+	v, err := ValidatorFor(myStruct{})
+	require.NoError(t, err)
+
+	_, violations := v.Validate(map[string]interface{}{"foo": "x", "qux": "z"})
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "foo must be alone at foo (not with x)", violations[0].Message)
+
+	_, violations = v.Validate(map[string]interface{}{"baz": "y"})
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "bar is required with baz", violations[0].Message)
+
+	_, violations = v.Validate(map[string]interface{}{"bar": "x", "baz": "y", "qux": "z"})
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "baz unwanted with qux - got y", violations[0].Message)
+}
+
+func TestMessageTemplates_CustomViolations(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"foo": {
+				Type: JsonString,
+				Constraints: Constraints{
+					NewCustomConstraint(func(value interface{}, vcx *ValidatorContext, this *CustomConstraint) (bool, string) {
+						vcx.AddViolationForCurrent("bad {property} value {value}", false)
+						return true, ""
+					}, ""),
+				},
+			},
+		},
+	}
+	_, violations := v.Validate(map[string]interface{}{"foo": "x"})
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "bad foo value x", violations[0].Message)
+}
+
+func TestMessageTemplates_DefaultMessagesNotRendered(t *testing.T) {
+	c := &StringValidToken{Tokens: []string{"{a}", "{value}"}}
+	v := &Validator{
+		Properties: Properties{
+			"foo": {Type: JsonString, Constraints: Constraints{c}},
+		},
+	}
+	_, violations := v.Validate(map[string]interface{}{"foo": "abcdef"})
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, c.GetMessage(nil), violations[0].Message)
+	require.Contains(t, violations[0].Message, `"{a}","{value}"`)
+}
+
+func TestMessageTemplates_MessagesWithoutPlaceholdersNotRendered(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"foo": {Type: JsonString, Constraints: Constraints{&StringMaxLength{Value: 1, Message: "x {{y}} z"}}},
+			"bar": {
+				Type: JsonString,
+				Constraints: Constraints{
+					NewCustomConstraint(func(value interface{}, vcx *ValidatorContext, this *CustomConstraint) (bool, string) {
+						vcx.AddViolationForCurrent("bad {{value}} {unknown}", false)
+						return true, ""
+					}, ""),
+				},
+			},
+			"baz": {Type: JsonString, Constraints: Constraints{&StringMaxLength{Value: 1, Message: "{{literal}} {property}"}}},
+		},
+	}
+	_, violations := v.Validate(map[string]interface{}{"foo": "abc", "bar": "abc", "baz": "abc"})
+	require.Equal(t, 3, len(violations))
+	byPath := ViolationsByPath(violations)
+	require.Equal(t, "x {{y}} z", byPath["foo"][0].Message)
+	require.Equal(t, "bad {{value}} {unknown}", byPath["bar"][0].Message)
+	require.Equal(t, "{literal}} baz", byPath["baz"][0].Message)
+}
+
+func TestMessageTemplates_Translated(t *testing.T) {
+	const template = "{property} too long - max {maximum}"
+	DefaultTranslator.AddMessageLanguageTranslation("fr", template, "{property} trop long - max {maximum}")
+	defer delete(defaultInternalTranslator.Messages, template)
+	v := &Validator{
+		Properties: Properties{
+			"foo": {Type: JsonString, Constraints: Constraints{&StringMaxLength{Value: 2, Message: template}}},
+		},
+	}
+	req, _ := http.NewRequest("POST", "http://example.com", strings.NewReader(`{"foo": "abc"}`))
+	req.Header.Set("Accept-Language", "fr")
+	_, violations, _ := v.RequestValidate(req)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "foo trop long - max 2", violations[0].Message)
+}
+
+func TestMessageTemplates_Labels(t *testing.T) {
+	const label = "Test postcode"
+	DefaultTranslator.AddTokenLanguageTranslation("fr", label, "Code postal de test")
+	defer delete(defaultInternalTranslator.Tokens, label)
+	type myStruct struct {
+		Postcode string `json:"postcode" v8n:"notNull,required,label:'Test postcode',&StringNotEmpty{Message:'{label} must not be empty'}"`
+		Town     string `json:"town" v8n:"+:postcode,+msg:'{label} is required with {property}'" oas:"label:'Test town'"`
+		Other    string `json:"other" v8n:"&StringNotEmpty{Message:'{label} must not be empty'}"`
+	}
+	v, err := ValidatorFor(myStruct{})
+	require.NoError(t, err)
+	require.Equal(t, label, v.Properties["postcode"].Label)
+	require.Equal(t, "Test town", v.Properties["town"].Label)
+
+	_, violations := v.Validate(map[string]interface{}{"postcode": "", "other": ""})
+	require.Equal(t, 3, len(violations))
+	SortViolationsByPathAndProperty(violations)
+	require.Equal(t, "other must not be empty", violations[0].Message)
+	require.Equal(t, "Test postcode must not be empty", violations[1].Message)
+	require.Equal(t, "Test town is required with town", violations[2].Message)
+
+	_, violations = v.ValidateWith(map[string]interface{}{"postcode": "", "town": "x", "other": "x"}, WithLanguage("fr", ""))
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "Code postal de test must not be empty", violations[0].Message)
+}
+
+func TestConstraintPlaceholderValues(t *testing.T) {
+	values := constraintPlaceholderValues(&StringMaxLength{Value: 10})
+	require.Equal(t, 10, values["value"])
+	require.Equal(t, 10, values["maximum"])
+	require.Equal(t, "StringMaxLength", values["constraint"])
+
+	values = constraintPlaceholderValues(&WarningOnly{Constraint: &StringExactLength{Value: 4}})
+	require.Equal(t, 4, values["length"])
+
+	values = constraintPlaceholderValues(&Length{Minimum: 1, Maximum: 5, ExclusiveMax: true})
+	require.Equal(t, 1, values["minimum"])
+	require.Equal(t, 5, values["maximum"])
+	require.Equal(t, true, values["exclusiveMax"])
+
+	for name := range constraintValuePlaceholders {
+		c, ok := constraintsRegistry.get(name)
+		require.True(t, ok, name)
+		require.NotNil(t, c)
+	}
+}
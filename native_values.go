@@ -0,0 +1,140 @@
+package valix
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// nativeToJson converts Go native values (i.e. values in maps and slices built in Go code, rather than decoded
+// from JSON) to their JSON decoded equivalents - so that they are validated consistently:
+//
+//   - integer kinds (e.g. int8, uint16, int64) are converted to int (or json.Number if the value does not fit in an int)
+//   - float kinds are converted to float64
+//   - bool and string kinds (e.g. named string types) are converted to bool and string
+//   - time.Time, *time.Time, Time and *Time are left as-is (these are treated as datetimes)
+//   - other values implementing encoding.TextMarshaler or fmt.Stringer are converted to string
+//   - typed slices and arrays (e.g. []string) are converted to []interface{}
+//   - maps with string keys (e.g. map[string]string) are converted to map[string]interface{}
+//   - pointers are dereferenced (nil pointers are converted to nil)
+//
+// The original value is never modified - maps and slices are only copied where a conversion is needed (and the
+// returned bool indicates whether any conversion was made)
+func nativeToJson(v interface{}) (interface{}, bool) {
+	switch vt := v.(type) {
+	case nil, string, bool, int, float64, json.Number, time.Time, *time.Time, Time, *Time:
+		return v, false
+	case map[string]interface{}:
+		return nativeToJsonMap(vt)
+	case []interface{}:
+		return nativeToJsonSlice(vt)
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := rv.Int()
+		if i >= math.MinInt && i <= math.MaxInt {
+			return int(i), true
+		}
+		return json.Number(strconv.FormatInt(i, 10)), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		if u <= math.MaxInt {
+			return int(u), true
+		}
+		return json.Number(strconv.FormatUint(u, 10)), true
+	case reflect.Float32:
+		// use the shortest representation of the float32 - so that, e.g., float32(0.1) is 0.1 (not 0.10000000149011612)
+		if f, err := strconv.ParseFloat(strconv.FormatFloat(rv.Float(), 'g', -1, 32), 64); err == nil {
+			return f, true
+		}
+		return rv.Float(), true
+	case reflect.Float64:
+		return rv.Float(), true
+	case reflect.Bool:
+		return rv.Bool(), true
+	case reflect.String:
+		return rv.String(), true
+	case reflect.Ptr, reflect.Slice, reflect.Map:
+		if rv.IsNil() {
+			return nil, true
+		}
+	}
+	if str, ok := nativeString(v); ok {
+		return str, true
+	}
+	switch rv.Kind() {
+	case reflect.Ptr:
+		result, _ := nativeToJson(rv.Elem().Interface())
+		return result, true
+	case reflect.Slice, reflect.Array:
+		result := make([]interface{}, rv.Len())
+		for i := range result {
+			result[i], _ = nativeToJson(rv.Index(i).Interface())
+		}
+		return result, true
+	case reflect.Map:
+		if rv.Type().Key().Kind() == reflect.String {
+			result := make(map[string]interface{}, rv.Len())
+			iter := rv.MapRange()
+			for iter.Next() {
+				result[iter.Key().String()], _ = nativeToJson(iter.Value().Interface())
+			}
+			return result, true
+		}
+	}
+	return v, false
+}
+
+func nativeToJsonMap(m map[string]interface{}) (map[string]interface{}, bool) {
+	var result map[string]interface{}
+	for k, v := range m {
+		if nv, changed := nativeToJson(v); changed {
+			if result == nil {
+				result = make(map[string]interface{}, len(m))
+				for ck, cv := range m {
+					result[ck] = cv
+				}
+			}
+			result[k] = nv
+		}
+	}
+	if result != nil {
+		return result, true
+	}
+	return m, false
+}
+
+func nativeToJsonSlice(s []interface{}) ([]interface{}, bool) {
+	var result []interface{}
+	for i, v := range s {
+		if nv, changed := nativeToJson(v); changed {
+			if result == nil {
+				result = make([]interface{}, len(s))
+				copy(result, s)
+			}
+			result[i] = nv
+		}
+	}
+	if result != nil {
+		return result, true
+	}
+	return s, false
+}
+
+// nativeString returns the string representation of values that implement encoding.TextMarshaler or fmt.Stringer
+func nativeString(v interface{}) (string, bool) {
+	switch vt := v.(type) {
+	case encoding.TextMarshaler:
+		if b, err := vt.MarshalText(); err == nil {
+			return string(b), true
+		}
+	case fmt.Stringer:
+		return vt.String(), true
+	}
+	return "", false
+}
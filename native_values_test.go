@@ -0,0 +1,244 @@
+package valix
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testNamedString string
+type testNamedInt int
+
+type testStringer struct {
+	value string
+}
+
+func (s testStringer) String() string {
+	return "stringer:" + s.value
+}
+
+func TestNativeToJson(t *testing.T) {
+	str := "foo"
+	var nilStr *string
+	now := time.Now()
+	testCases := []struct {
+		value          interface{}
+		expect         interface{}
+		expectConverts bool
+	}{
+		{nil, nil, false},
+		{"foo", "foo", false},
+		{true, true, false},
+		{1, 1, false},
+		{1.5, 1.5, false},
+		{json.Number("1"), json.Number("1"), false},
+		{now, now, false},
+		{&now, &now, false},
+		{int8(-1), -1, true},
+		{int16(2), 2, true},
+		{int32(3), 3, true},
+		{int64(4), 4, true},
+		{uint(5), 5, true},
+		{uint8(6), 6, true},
+		{uint64(math.MaxUint64), json.Number("18446744073709551615"), true},
+		{float32(0.1), 0.1, true},
+		{testNamedString("foo"), "foo", true},
+		{testNamedInt(7), 7, true},
+		{&str, "foo", true},
+		{nilStr, nil, true},
+		{[]string(nil), nil, true},
+		{testStringer{"foo"}, "stringer:foo", true},
+		{net.ParseIP("127.0.0.1"), "127.0.0.1", true},
+		{[]string{"a", "b"}, []interface{}{"a", "b"}, true},
+		{[2]int8{1, 2}, []interface{}{1, 2}, true},
+		{map[string]string{"a": "b"}, map[string]interface{}{"a": "b"}, true},
+		{map[int]string{1: "b"}, map[int]string{1: "b"}, false},
+		{struct{}{}, struct{}{}, false},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("[%d]%T", i+1, tc.value), func(t *testing.T) {
+			v, converted := nativeToJson(tc.value)
+			require.Equal(t, tc.expectConverts, converted)
+			require.Equal(t, tc.expect, v)
+		})
+	}
+}
+
+func TestNativeToJsonMap_DoesNotModifyOriginal(t *testing.T) {
+	obj := map[string]interface{}{
+		"foo": "bar",
+		"sub": map[string]interface{}{
+			"age": int8(1),
+		},
+		"arr": []interface{}{"a", uint16(2)},
+	}
+	converted, changed := nativeToJsonMap(obj)
+	require.True(t, changed)
+	require.Equal(t, map[string]interface{}{
+		"foo": "bar",
+		"sub": map[string]interface{}{
+			"age": 1,
+		},
+		"arr": []interface{}{"a", 2},
+	}, converted)
+	require.Equal(t, int8(1), obj["sub"].(map[string]interface{})["age"])
+	require.Equal(t, uint16(2), obj["arr"].([]interface{})[1])
+
+	obj = map[string]interface{}{"foo": "bar", "arr": []interface{}{1, "a"}}
+	converted, changed = nativeToJsonMap(obj)
+	require.False(t, changed)
+	require.Equal(t, obj, converted)
+}
+
+func TestValidate_NativeValues(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"age": {
+				Type:        JsonInteger,
+				Constraints: Constraints{&Minimum{Value: 18}},
+			},
+			"score": {
+				Type:        JsonNumber,
+				Constraints: Constraints{&Maximum{Value: 0.5}},
+			},
+			"name": {
+				Type:        JsonString,
+				Constraints: Constraints{&StringMaxLength{Value: 3}},
+			},
+			"when": {
+				Type: JsonDatetime,
+			},
+			"tags": {
+				Type:        JsonArray,
+				Constraints: Constraints{&ArrayOf{Type: "string"}, &ArrayUnique{}},
+			},
+			"attrs": {
+				Type: JsonObject,
+				ObjectValidator: &Validator{
+					AllowArray: false,
+					Properties: Properties{
+						"colour": {Type: JsonString, Mandatory: true},
+					},
+				},
+			},
+			"ip": {
+				Type:        JsonString,
+				Constraints: Constraints{&StringNotEmpty{}},
+			},
+		},
+	}
+	ok, violations := v.Validate(map[string]interface{}{
+		"age":   int8(21),
+		"score": float32(0.5),
+		"name":  testNamedString("Bob"),
+		"when":  time.Now(),
+		"tags":  []string{"a", "b"},
+		"attrs": map[string]string{"colour": "red"},
+		"ip":    net.ParseIP("127.0.0.1"),
+	})
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+
+	ok, violations = v.Validate(map[string]interface{}{
+		"age":   uint64(17),
+		"score": float32(0.6),
+		"name":  testNamedString("Alice"),
+		"tags":  []string{"a", "a"},
+		"attrs": map[string]int{"colour": 1},
+	})
+	require.False(t, ok)
+	require.Equal(t, 5, len(violations))
+	properties := make([]string, 0, len(violations))
+	for _, vi := range violations {
+		properties = append(properties, vi.Property)
+	}
+	require.ElementsMatch(t, []string{"age", "score", "name", "tags", "colour"}, properties)
+}
+
+func TestValidate_NativeValuesCompared(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"foo": {
+				Type:        JsonNumber,
+				Constraints: Constraints{&EqualsOther{PropertyName: "bar"}},
+			},
+			"bar": {
+				Type: JsonNumber,
+			},
+		},
+	}
+	ok, _ := v.Validate(map[string]interface{}{
+		"foo": int8(1),
+		"bar": uint64(1),
+	})
+	require.True(t, ok)
+	ok, _ = v.Validate(map[string]interface{}{
+		"foo": float32(1.5),
+		"bar": 1.5,
+	})
+	require.True(t, ok)
+	ok, _ = v.Validate(map[string]interface{}{
+		"foo": int8(1),
+		"bar": int16(2),
+	})
+	require.False(t, ok)
+}
+
+func TestValidateArrayOf_NativeValues(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"age": {Type: JsonInteger, Mandatory: true},
+		},
+	}
+	ok, _ := v.ValidateArrayOf([]interface{}{map[string]interface{}{"age": int32(1)}, map[string]int{"age": 2}})
+	require.True(t, ok)
+	ok, violations := v.ValidateArrayOf([]interface{}{map[string]float32{"age": 1.5}})
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+}
+
+func TestPropertyValidator_Validate_NativeValues(t *testing.T) {
+	pv := &PropertyValidator{
+		Type:        JsonArray,
+		Constraints: Constraints{&ArrayUnique{}},
+	}
+	ok, _ := pv.Validate([]int{1, 2, 3})
+	require.True(t, ok)
+	ok, violations := pv.Validate([]int{1, 2, 2})
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+
+	pv = &PropertyValidator{Type: JsonInteger, NotNull: true}
+	var nilInt *int
+	ok, violations = pv.Validate(nilInt)
+	require.False(t, ok)
+	require.Equal(t, msgValueCannotBeNull, violations[0].Message)
+	i := 1
+	ok, _ = pv.Validate(&i)
+	require.True(t, ok)
+}
+
+func TestTypedEquals_NativeNumerics(t *testing.T) {
+	require.True(t, typedEquals(int8(1), 1))
+	require.True(t, typedEquals(uint16(1), int64(1)))
+	require.True(t, typedEquals(float32(1), 1))
+	require.True(t, typedEquals(json.Number("1"), uint8(1)))
+	require.False(t, typedEquals(int8(1), int16(2)))
+	require.False(t, typedEquals(int8(1), "1"))
+}
+
+func TestCoerceToNumeric_NamedTypes(t *testing.T) {
+	f, ok, isNumber := coerceToFloat(testNamedInt(2))
+	require.True(t, ok)
+	require.True(t, isNumber)
+	require.Equal(t, 2.0, f)
+	i, ok, isNumber := coerceToInt(testNamedInt(2))
+	require.True(t, ok)
+	require.True(t, isNumber)
+	require.Equal(t, int64(2), i)
+}
@@ -0,0 +1,511 @@
+package valix
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	errMsgNegativeNilValidator = "cannot generate negative cases for nil validator"
+	errMsgNegativeInvalidBase  = "payload for negative cases must be valid - path '%s', property '%s': %s"
+	negativeUnknownProperty    = "unknownProperty"
+	negativeInvalidString      = "~invalid~"
+	negativeDatetimeFormat     = "2006-01-02T15:04:05Z07:00"
+	negativeDateFormat         = "2006-01-02"
+)
+
+// NegativeCase is an invalid payload generated by GenerateNegativeCases - along with the violation that the payload
+// is expected to produce
+type NegativeCase struct {
+	// Name is a descriptive name for the case (suitable for use as a sub-test name)
+	Name string
+	// Payload is the mutated (invalid) payload
+	Payload interface{}
+	// Path is the expected violation path (see Violation.Path) - always in PathFormatDotted format
+	Path string
+	// Property is the expected violation property (see Violation.Property)
+	Property string
+	// Code is the expected violation code (see Violation.Codes) - zero where the violation has no code
+	Code int
+}
+
+// JSON returns the case payload as JSON (e.g. for use as a seed corpus entry with testing.F.Add)
+func (nc *NegativeCase) JSON() []byte {
+	data, _ := json.Marshal(nc.Payload)
+	return data
+}
+
+// Matches checks whether the supplied violations contain the expected violation for the case
+func (nc *NegativeCase) Matches(violations []*Violation) bool {
+	for _, violation := range violations {
+		if violation.Path == nc.Path && violation.Property == nc.Property && violationHasCode(violation, nc.Code) {
+			return true
+		}
+	}
+	return false
+}
+
+func violationHasCode(violation *Violation, code int) bool {
+	if len(violation.Codes) == 0 {
+		return code == 0
+	}
+	c, ok := violation.Codes[0].(int)
+	return ok && c == code
+}
+
+// GenerateNegativeCases takes a validator and a valid payload and generates minimally mutated invalid payloads - one for
+// each way that the payload can be made to violate the validator, e.g. dropping a mandatory property, setting a null,
+// using the wrong type, exceeding a Maximum, breaking a check digit or adding an unknown property
+//
+// Every generated case is verified (by validating the mutated payload) to produce the expected violation - mutations that
+// do not produce the expected violation are not returned
+//
+// An error is returned if the supplied payload is not itself valid
+func GenerateNegativeCases(v *Validator, valid interface{}) ([]*NegativeCase, error) {
+	if v == nil {
+		return nil, errors.New(errMsgNegativeNilValidator)
+	}
+	// cases are generated (and verified) with paths in dotted format...
+	v = v.WithPathFormat(PathFormatDotted)
+	g := &negativeGenerator{v: v}
+	data, err := json.Marshal(valid)
+	if err != nil {
+		return nil, err
+	}
+	if ok, violations, _ := v.ValidateReader(bytes.NewReader(data)); !ok {
+		return nil, fmt.Errorf(errMsgNegativeInvalidBase, violations[0].Path, violations[0].Property, violations[0].Message)
+	}
+	if err = json.Unmarshal(data, &g.base); err != nil {
+		return nil, err
+	}
+	switch bv := g.base.(type) {
+	case map[string]interface{}:
+		g.object(v, bv, nil, "")
+	case []interface{}:
+		if len(bv) > 0 {
+			if elem, ok := bv[0].(map[string]interface{}); ok {
+				g.object(v, elem, []interface{}{0}, "[0]")
+			}
+		}
+	}
+	return g.cases, nil
+}
+
+type negativeGenerator struct {
+	v     *Validator
+	base  interface{}
+	cases []*NegativeCase
+}
+
+type negativeMutation struct {
+	desc  string
+	value interface{}
+}
+
+func (g *negativeGenerator) object(v *Validator, obj map[string]interface{}, loc []interface{}, path string) {
+	if !v.IgnoreUnknownProperties {
+		name := negativeUnknownProperty
+		for i := 1; obj[name] != nil || v.Properties[name] != nil; i++ {
+			name = fmt.Sprintf("%s%d", negativeUnknownProperty, i)
+		}
+		g.add(joinNegativePath(path, name)+": unknown property",
+			g.mutate(append(copyNegativeLoc(loc), name), true, negativeInvalidString), path, name, CodeUnknownProperty, "")
+	}
+	names := make([]string, 0, len(v.Properties))
+	for name := range v.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pv := v.Properties[name]
+		value, present := obj[name]
+		if pv == nil || !present {
+			continue
+		}
+		ptyLoc := append(copyNegativeLoc(loc), name)
+		if pv.Mandatory && len(pv.MandatoryWhen) == 0 {
+			g.add(joinNegativePath(path, name)+": missing mandatory property", g.mutate(ptyLoc, false, nil), path, name, CodeMissingProperty, "")
+		}
+		g.property(pv, value, ptyLoc, path, name)
+	}
+}
+
+func (g *negativeGenerator) property(pv *PropertyValidator, value interface{}, loc []interface{}, path string, name string) {
+	ptyPath := joinNegativePath(path, name)
+	if value == nil {
+		return
+	}
+	if pv.NotNull {
+		g.add(ptyPath+": null", g.mutate(loc, true, nil), path, name, CodeValueCannotBeNull, "")
+	}
+	if wrong, ok := negativeWrongType(pv.Type); ok {
+		g.add(ptyPath+": wrong type", g.mutate(loc, true, wrong), path, name, CodeValueExpectedType, "")
+	}
+	for _, c := range flattenExampleConstraints(pv.Constraints) {
+		cName := negativeConstraintName(c)
+		if ao, ok := c.(*ArrayOf); ok {
+			g.arrayOf(ao, value, loc, path, name)
+			continue
+		}
+		mutations, known := negativeMutations(c, value)
+		for _, m := range mutations {
+			g.add(ptyPath+": "+cName+" "+m.desc, g.mutate(loc, true, m.value), path, name, CodePropertyConstraintFail, "")
+		}
+		if !known {
+			g.fallback(c, value, loc, path, name, CodePropertyConstraintFail)
+		}
+	}
+	if pv.ObjectValidator != nil {
+		switch cv := value.(type) {
+		case map[string]interface{}:
+			g.object(pv.ObjectValidator, cv, loc, ptyPath)
+		case []interface{}:
+			if len(cv) > 0 {
+				if elem, ok := cv[0].(map[string]interface{}); ok {
+					g.object(pv.ObjectValidator, elem, append(copyNegativeLoc(loc), 0), ptyPath+"[0]")
+				}
+			}
+		}
+	}
+}
+
+func (g *negativeGenerator) arrayOf(c *ArrayOf, value interface{}, loc []interface{}, path string, name string) {
+	arr, ok := value.([]interface{})
+	if !ok || len(arr) == 0 {
+		return
+	}
+	ptyPath := joinNegativePath(path, name)
+	elemLoc := append(copyNegativeLoc(loc), 0)
+	if jt, ok := JsonTypeFromString(c.Type); ok {
+		if wrong, ok := negativeWrongType(jt); ok {
+			g.add(ptyPath+"[0]: ArrayOf wrong element type", g.mutate(elemLoc, true, wrong), path, name, CodePropertyConstraintFail, "")
+		}
+	}
+	if !c.AllowNullElement {
+		g.add(ptyPath+"[0]: ArrayOf null element", g.mutate(elemLoc, true, nil), path, name, CodePropertyConstraintFail, "")
+	}
+	for _, ec := range flattenExampleConstraints(c.Constraints) {
+		mutations, known := negativeMutations(ec, arr[0])
+		for _, m := range mutations {
+			g.add(ptyPath+"[0]: "+negativeConstraintName(ec)+" "+m.desc, g.mutate(elemLoc, true, m.value), ptyPath, "[0]", 0, "")
+		}
+		if !known {
+			g.fallback(ec, arr[0], elemLoc, ptyPath, "[0]", 0)
+		}
+	}
+}
+
+// fallback tries generic mutations for constraints that have no specific mutation - the first one that produces the
+// constraint's own violation message is used
+func (g *negativeGenerator) fallback(c Constraint, value interface{}, loc []interface{}, path string, name string, code int) {
+	candidates := []interface{}{"", negativeInvalidString, strings.Repeat("a", 1024), float64(-1e9), float64(1e9), 0.5, float64(0)}
+	if s, ok := value.(string); ok {
+		candidates = append([]interface{}{s + negativeInvalidString}, candidates...)
+	}
+	for _, candidate := range candidates {
+		if g.add(joinNegativePath(path, name)+": "+negativeConstraintName(c), g.mutate(loc, true, candidate), path, name, code, c.GetMessage(nil)) {
+			return
+		}
+	}
+}
+
+// add verifies that the mutated payload produces the expected violation (and message, if specified) and, if so,
+// adds the case
+func (g *negativeGenerator) add(caseName string, payload interface{}, path string, property string, code int, msg string) bool {
+	nc := &NegativeCase{
+		Name:     caseName,
+		Payload:  payload,
+		Path:     path,
+		Property: property,
+		Code:     code,
+	}
+	_, violations, _ := g.v.ValidateReader(bytes.NewReader(nc.JSON()))
+	for _, violation := range violations {
+		if violation.IsError() && violation.Path == path && violation.Property == property && violationHasCode(violation, code) &&
+			(msg == "" || violation.Message == msg) {
+			g.cases = append(g.cases, nc)
+			return true
+		}
+	}
+	return false
+}
+
+// mutate returns a deep copy of the base payload with the value at the location set (or deleted if set is false)
+func (g *negativeGenerator) mutate(loc []interface{}, set bool, value interface{}) interface{} {
+	result := copyNegativeValue(g.base)
+	var parent interface{} = result
+	for i, key := range loc {
+		last := i == len(loc)-1
+		switch p := parent.(type) {
+		case map[string]interface{}:
+			k := key.(string)
+			if !last {
+				parent = p[k]
+			} else if set {
+				p[k] = value
+			} else {
+				delete(p, k)
+			}
+		case []interface{}:
+			idx := key.(int)
+			if !last {
+				parent = p[idx]
+			} else {
+				p[idx] = value
+			}
+		}
+	}
+	return result
+}
+
+func copyNegativeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			result[k] = copyNegativeValue(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = copyNegativeValue(item)
+		}
+		return result
+	}
+	return value
+}
+
+func copyNegativeLoc(loc []interface{}) []interface{} {
+	return append(make([]interface{}, 0, len(loc)+1), loc...)
+}
+
+func joinNegativePath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func negativeConstraintName(c Constraint) string {
+	t := reflect.TypeOf(c)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+func negativeWrongType(t JsonType) (interface{}, bool) {
+	switch t {
+	case JsonString:
+		return float64(1), true
+	case JsonNumber, JsonInteger, JsonBoolean, JsonObject, JsonArray:
+		return negativeInvalidString, true
+	case JsonDatetime:
+		return true, true
+	}
+	return nil, false
+}
+
+// negativeMutations returns the mutations of a value that should violate the constraint (the bool result indicates
+// whether the constraint is known)
+func negativeMutations(c Constraint, value interface{}) ([]negativeMutation, bool) {
+	result := make([]negativeMutation, 0)
+	add := func(desc string, v interface{}) {
+		result = append(result, negativeMutation{desc: desc, value: v})
+	}
+	str, isStr := value.(string)
+	num, isNum := value.(float64)
+	arr, isArr := value.([]interface{})
+	switch ct := c.(type) {
+	case *Length:
+		addNegativeLengths(add, value, ct.Minimum+ternary(ct.ExclusiveMin).int(1, 0), ct.Maximum-ternary(ct.ExclusiveMax).int(1, 0), ct.Maximum > 0)
+	case *LengthExact:
+		addNegativeLengths(add, value, ct.Value, ct.Value, true)
+	case *StringLength:
+		addNegativeLengths(add, value, ct.Minimum+ternary(ct.ExclusiveMin).int(1, 0), ct.Maximum-ternary(ct.ExclusiveMax).int(1, 0), ct.Maximum > 0)
+	case *StringMinLength:
+		addNegativeLengths(add, value, ct.Value+ternary(ct.ExclusiveMin).int(1, 0), 0, false)
+	case *StringMaxLength:
+		addNegativeLengths(add, value, 0, ct.Value-ternary(ct.ExclusiveMax).int(1, 0), true)
+	case *StringExactLength:
+		addNegativeLengths(add, value, ct.Value, ct.Value, true)
+	case *NotEmpty:
+		switch value.(type) {
+		case string:
+			add("empty", "")
+		case []interface{}:
+			add("empty", []interface{}{})
+		case map[string]interface{}:
+			add("empty", map[string]interface{}{})
+		}
+	case *StringNotEmpty:
+		add("empty", "")
+	case *StringNotBlank:
+		add("blank", "   ")
+	case *Range:
+		addNegativeBelow(add, ct.Minimum, ct.ExclusiveMin)
+		addNegativeAbove(add, ct.Maximum, ct.ExclusiveMax)
+	case *RangeInt:
+		addNegativeBelow(add, float64(ct.Minimum), ct.ExclusiveMin)
+		addNegativeAbove(add, float64(ct.Maximum), ct.ExclusiveMax)
+	case *Minimum:
+		addNegativeBelow(add, ct.Value, ct.ExclusiveMin)
+	case *MinimumInt:
+		addNegativeBelow(add, float64(ct.Value), ct.ExclusiveMin)
+	case *Maximum:
+		addNegativeAbove(add, ct.Value, ct.ExclusiveMax)
+	case *MaximumInt:
+		addNegativeAbove(add, float64(ct.Value), ct.ExclusiveMax)
+	case *Positive:
+		addNegativeBelow(add, 0, true)
+	case *PositiveOrZero:
+		addNegativeBelow(add, 0, false)
+	case *Negative:
+		addNegativeAbove(add, 0, true)
+	case *NegativeOrZero:
+		addNegativeAbove(add, 0, false)
+	case *MultipleOf:
+		if isNum && ct.Value > 1 {
+			add("not multiple", num+1)
+		}
+	case *StringValidToken:
+		add("invalid token", negativeInvalidString)
+	case *StringPresetPattern:
+		if isStr {
+			if preset, ok := GetRegisteredPreset(ct.Preset); ok {
+				if broken, ok := negativeBreakCheckDigit(str, preset.Check); ok {
+					add("broken check digit", broken)
+				}
+			}
+		}
+		add("invalid", negativeInvalidString)
+	case *StringValidCardNumber:
+		if isStr {
+			if broken, ok := negativeBreakCheckDigit(str, func(s string) bool {
+				return ct.checkString(s, nil)
+			}); ok {
+				add("broken check digit", broken)
+			}
+		}
+	case *StringValidCountryCode, *StringValidCurrencyCode, *StringValidLanguageCode, *StringValidUuid,
+		*StringValidISODatetime, *StringValidISODate, *StringValidISODuration, *StringValidTimezone, *StringValidEmail,
+		*NetIsURL, *NetIsURI, *NetIsHostname, *NetIsIP, *NetIsMac, *NetIsCIDR, *NetIsTld, *StringValidJson, *StringPattern:
+		add("invalid", negativeInvalidString)
+	case *StringUppercase:
+		if isStr && strings.ToLower(str) != str {
+			add("lowercase", strings.ToLower(str))
+		}
+	case *StringLowercase:
+		if isStr && strings.ToUpper(str) != str {
+			add("uppercase", strings.ToUpper(str))
+		}
+	case *StringStartsWith:
+		if isStr && !ct.Not {
+			add("wrong start", "~"+str)
+		}
+	case *StringEndsWith:
+		if isStr && !ct.Not {
+			add("wrong end", str+"~")
+		}
+	case *StringContains:
+		if isStr && !ct.Not && ct.Value != "" {
+			add("not containing", strings.ReplaceAll(str, ct.Value, ""))
+		}
+	case *ArrayUnique:
+		if isArr && len(arr) > 0 {
+			add("duplicate", append(copyNegativeValue(arr).([]interface{}), copyNegativeValue(arr[0])))
+		}
+	case *DatetimeRange:
+		addNegativeDatetime(add, value, ct.Minimum, -1)
+		addNegativeDatetime(add, value, ct.Maximum, 1)
+	case *DatetimeGreaterThan:
+		addNegativeDatetime(add, value, ct.Value, 0)
+	case *DatetimeGreaterThanOrEqual:
+		addNegativeDatetime(add, value, ct.Value, -1)
+	case *DatetimeLessThan:
+		addNegativeDatetime(add, value, ct.Value, 0)
+	case *DatetimeLessThanOrEqual:
+		addNegativeDatetime(add, value, ct.Value, 1)
+	case *DatetimeFuture, *DatetimeFutureOrPresent:
+		addNegativeDatetime(add, value, time.Now().Format(negativeDatetimeFormat), -1)
+	case *DatetimePast, *DatetimePastOrPresent:
+		addNegativeDatetime(add, value, time.Now().Format(negativeDatetimeFormat), 1)
+	default:
+		return result, false
+	}
+	return result, true
+}
+
+func addNegativeLengths(add func(string, interface{}), value interface{}, min int, max int, hasMax bool) {
+	switch v := value.(type) {
+	case string:
+		if min > 0 && len(v) >= min {
+			add("too short", v[:min-1])
+		}
+		if hasMax && max >= 0 {
+			add("too long", v+strings.Repeat("a", max+1-len(v)))
+		}
+	case []interface{}:
+		if min > 0 && len(v) >= min {
+			add("too few items", copyNegativeValue(v[:min-1]))
+		}
+		if hasMax && max >= 0 && len(v) > 0 {
+			items := copyNegativeValue(v).([]interface{})
+			for len(items) <= max {
+				items = append(items, copyNegativeValue(v[0]))
+			}
+			add("too many items", items)
+		}
+	}
+}
+
+func addNegativeBelow(add func(string, interface{}), min float64, exclusive bool) {
+	add("below minimum", ternary(exclusive).float64(min, min-1))
+}
+
+func addNegativeAbove(add func(string, interface{}), max float64, exclusive bool) {
+	add("above maximum", ternary(exclusive).float64(max, max+1))
+}
+
+// addNegativeDatetime adds a mutation of the datetime bound - offset by the direction (in days)
+func addNegativeDatetime(add func(string, interface{}), value interface{}, bound string, direction int) {
+	str, ok := value.(string)
+	if !ok || bound == "" {
+		return
+	}
+	if dt, ok := stringToDatetime(bound, false); ok {
+		mutated := dt.Add(time.Duration(direction) * 24 * time.Hour)
+		layout := negativeDatetimeFormat
+		if len(str) == len(negativeDateFormat) {
+			layout = negativeDateFormat
+		}
+		desc := "at"
+		if direction > 0 {
+			desc = "after"
+		} else if direction < 0 {
+			desc = "before"
+		}
+		add(desc+" "+bound, mutated.Format(layout))
+	}
+}
+
+// negativeBreakCheckDigit changes the last character of a value so that the check fails
+func negativeBreakCheckDigit(str string, check func(string) bool) (string, bool) {
+	if str == "" {
+		return "", false
+	}
+	for _, ch := range exampleCheckChars {
+		candidate := str[:len(str)-1] + string(ch)
+		if candidate != str && !check(candidate) {
+			return candidate, true
+		}
+	}
+	return "", false
+}
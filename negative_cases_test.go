@@ -0,0 +1,171 @@
+package valix
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testNegativeValidator = &Validator{
+	Properties: Properties{
+		"name": {
+			Type:        JsonString,
+			Mandatory:   true,
+			NotNull:     true,
+			Constraints: Constraints{&StringLength{Minimum: 2, Maximum: 10}},
+		},
+		"age": {
+			Type:        JsonInteger,
+			Constraints: Constraints{&RangeInt{Minimum: 18, Maximum: 65}},
+		},
+		"isbn": {
+			Type:        JsonString,
+			Constraints: Constraints{&StringPresetPattern{Preset: PresetISBN13}},
+		},
+		"status": {
+			Type:        JsonString,
+			Constraints: Constraints{&StringValidToken{Tokens: []string{"active", "inactive"}}},
+		},
+		"tags": {
+			Type: JsonArray,
+			Constraints: Constraints{
+				&ArrayUnique{},
+				&ArrayOf{Type: "string", Constraints: Constraints{&StringMaxLength{Value: 5}}},
+			},
+		},
+		"when": {
+			Type:        JsonString,
+			Constraints: Constraints{&DatetimeRange{Minimum: "2022-01-01T00:00:00Z", Maximum: "2022-12-31T00:00:00Z"}},
+		},
+		"address": {
+			Type:      JsonObject,
+			Mandatory: true,
+			ObjectValidator: &Validator{
+				Properties: Properties{
+					"postcode": {
+						Type:      JsonString,
+						Mandatory: true,
+						NotNull:   true,
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestGenerateNegativeCases(t *testing.T) {
+	valid := map[string]interface{}{
+		"name":    "Bilbo",
+		"age":     33,
+		"isbn":    "9780261102217",
+		"status":  "active",
+		"tags":    []interface{}{"a", "b"},
+		"when":    "2022-06-01T00:00:00Z",
+		"address": map[string]interface{}{"postcode": "SW1A 1AA"},
+	}
+	cases, err := GenerateNegativeCases(testNegativeValidator, valid)
+	require.NoError(t, err)
+
+	names := map[string]*NegativeCase{}
+	for _, nc := range cases {
+		names[nc.Name] = nc
+		ok, violations, _ := testNegativeValidator.ValidateReader(bytes.NewReader(nc.JSON()))
+		require.False(t, ok, nc.Name)
+		require.True(t, nc.Matches(violations), nc.Name)
+	}
+	expect := []struct {
+		name     string
+		path     string
+		property string
+		code     int
+	}{
+		{"unknownProperty: unknown property", "", "unknownProperty", CodeUnknownProperty},
+		{"name: missing mandatory property", "", "name", CodeMissingProperty},
+		{"name: null", "", "name", CodeValueCannotBeNull},
+		{"name: wrong type", "", "name", CodeValueExpectedType},
+		{"name: StringLength too short", "", "name", CodePropertyConstraintFail},
+		{"name: StringLength too long", "", "name", CodePropertyConstraintFail},
+		{"age: RangeInt below minimum", "", "age", CodePropertyConstraintFail},
+		{"age: RangeInt above maximum", "", "age", CodePropertyConstraintFail},
+		{"isbn: StringPresetPattern broken check digit", "", "isbn", CodePropertyConstraintFail},
+		{"status: StringValidToken invalid token", "", "status", CodePropertyConstraintFail},
+		{"tags: ArrayUnique duplicate", "", "tags", CodePropertyConstraintFail},
+		{"tags[0]: ArrayOf wrong element type", "", "tags", CodePropertyConstraintFail},
+		{"tags[0]: StringMaxLength too long", "tags", "[0]", 0},
+		{"when: DatetimeRange before 2022-01-01T00:00:00Z", "", "when", CodePropertyConstraintFail},
+		{"when: DatetimeRange after 2022-12-31T00:00:00Z", "", "when", CodePropertyConstraintFail},
+		{"address: missing mandatory property", "", "address", CodeMissingProperty},
+		{"address.unknownProperty: unknown property", "address", "unknownProperty", CodeUnknownProperty},
+		{"address.postcode: missing mandatory property", "address", "postcode", CodeMissingProperty},
+		{"address.postcode: null", "address", "postcode", CodeValueCannotBeNull},
+	}
+	for _, e := range expect {
+		nc, ok := names[e.name]
+		require.True(t, ok, e.name)
+		require.Equal(t, e.path, nc.Path, e.name)
+		require.Equal(t, e.property, nc.Property, e.name)
+		require.Equal(t, e.code, nc.Code, e.name)
+	}
+
+	// the original payload is not mutated...
+	require.Equal(t, "Bilbo", valid["name"])
+	require.Equal(t, 2, len(valid["tags"].([]interface{})))
+}
+
+func TestGenerateNegativeCasesFromGeneratedExample(t *testing.T) {
+	example, err := GenerateExample(testNegativeValidator, 0)
+	require.NoError(t, err)
+	cases, err := GenerateNegativeCases(testNegativeValidator, example)
+	require.NoError(t, err)
+	require.True(t, len(cases) > 0)
+	for _, nc := range cases {
+		ok, violations, _ := testNegativeValidator.ValidateReader(bytes.NewReader(nc.JSON()))
+		require.False(t, ok, nc.Name)
+		require.True(t, nc.Matches(violations), nc.Name)
+	}
+}
+
+func TestGenerateNegativeCasesFallback(t *testing.T) {
+	v := &Validator{
+		IgnoreUnknownProperties: true,
+		Properties: Properties{
+			"foo": {
+				Type: JsonString,
+				Constraints: Constraints{NewCustomConstraint(func(value interface{}, vcx *ValidatorContext, this *CustomConstraint) (bool, string) {
+					return value != "", this.GetMessage(vcx)
+				}, "Must not be empty")},
+			},
+		},
+	}
+	cases, err := GenerateNegativeCases(v, map[string]interface{}{"foo": "bar"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(cases))
+	require.Equal(t, "foo: wrong type", cases[0].Name)
+	require.Equal(t, "foo: CustomConstraint", cases[1].Name)
+	require.Equal(t, `{"foo":""}`, string(cases[1].JSON()))
+}
+
+func TestGenerateNegativeCasesFails(t *testing.T) {
+	_, err := GenerateNegativeCases(nil, nil)
+	require.Error(t, err)
+	require.Equal(t, errMsgNegativeNilValidator, err.Error())
+
+	_, err = GenerateNegativeCases(testNegativeValidator, map[string]interface{}{"address": map[string]interface{}{"postcode": "SW1A 1AA"}})
+	require.Error(t, err)
+	require.Equal(t, "payload for negative cases must be valid - path '', property 'name': Missing property", err.Error())
+}
+
+func FuzzNegativeCases(f *testing.F) {
+	cases, err := GenerateNegativeCases(testNegativeValidator, map[string]interface{}{
+		"name":    "Bilbo",
+		"address": map[string]interface{}{"postcode": "SW1A 1AA"},
+	})
+	require.NoError(f, err)
+	for _, nc := range cases {
+		f.Add(nc.JSON())
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _, _ = testNegativeValidator.ValidateReader(bytes.NewReader(data))
+	})
+}
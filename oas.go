@@ -1,8 +1,10 @@
 package valix
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 )
 
@@ -16,6 +18,15 @@ const (
 	tagOpenApiExample      = "example"
 	tagOpenApiExample2     = "eg"
 	tagOpenApiDeprecated   = "deprecated"
+	tagOpenApiExamples     = "examples"
+	tagOpenApiDefault      = "default"
+	tagOpenApiEnum         = "enum"
+	tagOpenApiReadOnly     = "readOnly"
+	tagOpenApiWriteOnly    = "writeOnly"
+	tagOpenApiExternalDocs = "externalDocs"
+	tagOpenApiNullable     = "nullable"
+	tagOpenApiLabel        = "label"
+	tagOpenApiExtPrefix    = "x-"
 )
 
 const (
@@ -24,11 +35,25 @@ const (
 	ptyNameOasFormat      = "format"
 	ptyNameOasExample     = "example"
 	ptyNameOasDeprecated  = "deprecated"
+	ptyNameOasReadOnly    = "readOnly"
+	ptyNameOasWriteOnly   = "writeOnly"
+	ptyNameOasExamples    = "examples"
+	ptyNameOasDefault     = "default"
+	ptyNameOasEnum        = "enum"
+	ptyNameOasExtDocs     = "externalDocs"
+	ptyNameOasExtDocsUrl  = "url"
+	ptyNameOasNullable    = "nullable"
+	ptyNameOasExtensions  = "extensions"
 
 	msgOasPrefix            = "tag " + tagOpenApi + " - "
 	msgOasUnknownTokenInTag = msgOasPrefix + "unknown token '%s'"
 	msgOasUnexpectedColon   = msgOasPrefix + "unexpected ':' colon after token '%s'"
 	msgOasExpectedString    = msgOasPrefix + "expected string (enclosed with \"\" or '') after token '%s'"
+	msgOasExpectedColon     = msgOasPrefix + "expected ':' colon after token '%s'"
+	msgOasExpectedList      = msgOasPrefix + "expected list of strings (enclosed with []) after token '%s'"
+	msgOasExpectedNamed     = msgOasPrefix + "expected named strings (enclosed with {}) after token '%s'"
+	msgOasExpectedBool      = msgOasPrefix + "expected boolean after token '%s'"
+	msgOasExpectedValue     = msgOasPrefix + "expected value after token '%s'"
 )
 
 // OasInfo is OAS (Open API Spec) information about an validator or property validator
@@ -38,6 +63,35 @@ type OasInfo struct {
 	Format      string
 	Example     string
 	Deprecated  bool
+	// ReadOnly indicates that the property is only ever sent in responses
+	//
+	// When validating requests, a read-only property is never seen as mandatory and, if present, is reported
+	// as an unwanted property
+	ReadOnly bool
+	// WriteOnly indicates that the property is only ever sent in requests
+	//
+	// When validating responses (see ResponseValidator), a write-only property is never seen as
+	// mandatory and, if present, is reported as an unwanted property
+	WriteOnly bool
+	// Examples is a map of named examples (in addition to Example)
+	Examples map[string]string
+	// Default is the default value
+	Default string
+	// Enum is the list of documented allowed values
+	Enum []string
+	// ExternalDocs is the additional external documentation
+	ExternalDocs *OasExternalDocs
+	// Nullable, when set, overrides whether the value is documented as nullable (which otherwise is
+	// determined by PropertyValidator.NotNull)
+	Nullable *bool
+	// Extensions is a map of vendor extensions (the keys must start with "x-")
+	Extensions map[string]interface{}
+}
+
+// OasExternalDocs is OAS (Open API Spec) external documentation information
+type OasExternalDocs struct {
+	URL         string
+	Description string
 }
 
 func (pv *PropertyValidator) processOasTag(fld reflect.StructField) error {
@@ -94,6 +148,12 @@ func (pv *PropertyValidator) addOasTagItem(tagItem string) (result error) {
 		if !strErr {
 			pv.OasInfo.Title = tagValue
 		}
+	case tagOpenApiLabel:
+		// the label is not OAS info, but is accepted here for convenience (see PropertyValidator.Label)...
+		strErr = !tagValueIsStr
+		if !strErr {
+			pv.Label = tagValue
+		}
 	case tagOpenApiFormat, tagOpenApiFormat2:
 		strErr = !tagValueIsStr
 		if !strErr {
@@ -107,8 +167,74 @@ func (pv *PropertyValidator) addOasTagItem(tagItem string) (result error) {
 	case tagOpenApiDeprecated:
 		colonErr = hasColon
 		pv.OasInfo.Deprecated = true
+	case tagOpenApiReadOnly:
+		colonErr = hasColon
+		pv.OasInfo.ReadOnly = true
+	case tagOpenApiWriteOnly:
+		colonErr = hasColon
+		pv.OasInfo.WriteOnly = true
+	case tagOpenApiDefault:
+		strErr = !tagValueIsStr
+		if !strErr {
+			pv.OasInfo.Default = tagValue
+		}
+	case tagOpenApiNullable:
+		nullable := true
+		if hasColon {
+			b, err := strconv.ParseBool(tagValue)
+			if err != nil || tagValueIsStr {
+				return fmt.Errorf(msgOasExpectedBool, tagToken)
+			}
+			nullable = b
+		}
+		pv.OasInfo.Nullable = &nullable
+	case tagOpenApiEnum:
+		if !hasColon {
+			return fmt.Errorf(msgOasExpectedColon, tagToken)
+		}
+		list, ok := parseOasTagStrings(tagValue, "[", "]")
+		if !ok {
+			return fmt.Errorf(msgOasExpectedList, tagToken)
+		}
+		pv.OasInfo.Enum = list
+	case tagOpenApiExamples:
+		if !hasColon {
+			return fmt.Errorf(msgOasExpectedColon, tagToken)
+		}
+		named, ok := parseOasTagNamedStrings(tagValue)
+		if !ok {
+			return fmt.Errorf(msgOasExpectedNamed, tagToken)
+		}
+		pv.OasInfo.Examples = named
+	case tagOpenApiExternalDocs:
+		if tagValueIsStr {
+			pv.OasInfo.ExternalDocs = &OasExternalDocs{URL: tagValue}
+		} else if named, ok := parseOasTagNamedStrings(tagValue); ok && hasColon {
+			pv.OasInfo.ExternalDocs = &OasExternalDocs{
+				URL:         named[ptyNameOasExtDocsUrl],
+				Description: named[ptyNameOasDescription],
+			}
+		} else {
+			return fmt.Errorf(msgOasExpectedNamed, tagToken)
+		}
 	default:
-		result = fmt.Errorf(msgOasUnknownTokenInTag, tagToken)
+		if strings.HasPrefix(tagToken, tagOpenApiExtPrefix) && len(tagToken) > len(tagOpenApiExtPrefix) {
+			if !hasColon {
+				return fmt.Errorf(msgOasExpectedColon, tagToken)
+			}
+			var value interface{} = tagValue
+			if !tagValueIsStr {
+				if err := json.Unmarshal([]byte(tagValue), &value); err != nil {
+					return fmt.Errorf(msgOasExpectedValue, tagToken)
+				}
+			}
+			if pv.OasInfo.Extensions == nil {
+				pv.OasInfo.Extensions = map[string]interface{}{}
+			}
+			pv.OasInfo.Extensions[tagToken] = value
+		} else {
+			result = fmt.Errorf(msgOasUnknownTokenInTag, tagToken)
+		}
 	}
 	if strErr {
 		result = fmt.Errorf(msgOasExpectedString, tagToken)
@@ -117,3 +243,45 @@ func (pv *PropertyValidator) addOasTagItem(tagItem string) (result error) {
 	}
 	return
 }
+
+func parseOasTagStrings(value string, open string, close string) ([]string, bool) {
+	if !strings.HasPrefix(value, open) || !strings.HasSuffix(value, close) {
+		return nil, false
+	}
+	items, err := parseCommas(value[len(open) : len(value)-len(close)])
+	if err != nil {
+		return nil, false
+	}
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		str, ok := isQuotedStr(item)
+		if !ok {
+			return nil, false
+		}
+		result = append(result, str)
+	}
+	return result, true
+}
+
+func parseOasTagNamedStrings(value string) (map[string]string, bool) {
+	if !strings.HasPrefix(value, "{") || !strings.HasSuffix(value, "}") {
+		return nil, false
+	}
+	items, err := parseCommas(value[1 : len(value)-1])
+	if err != nil {
+		return nil, false
+	}
+	result := make(map[string]string, len(items))
+	for _, item := range items {
+		cAt := firstValidColonAt(item)
+		if cAt == -1 {
+			return nil, false
+		}
+		str, ok := isQuotedStr(strings.Trim(item[cAt+1:], " "))
+		if !ok {
+			return nil, false
+		}
+		result[strings.Trim(item[:cAt], " ")] = str
+	}
+	return result, true
+}
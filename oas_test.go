@@ -55,3 +55,63 @@ func TestOasTagItemUnexpectedColon(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, fmt.Sprintf(msgOasUnexpectedColon, "deprecated"), err.Error())
 }
+
+func TestValidatorForWithExtendedOasTag(t *testing.T) {
+	type myStruct struct {
+		Foo string `json:"foo" oas:"examples:{first:'a',second:'b, c'},default:'a',enum:['a','b, c'],readOnly,writeOnly,nullable:false,externalDocs:{url:'https://example.com/docs',description:'More info'},x-foo:'bar',x-num:1,x-obj:{\"a\":true}"`
+		Bar string `json:"bar" oas:"externalDocs:'https://example.com',nullable"`
+	}
+	v, err := ValidatorFor(myStruct{}, nil)
+	require.NoError(t, err)
+
+	info := v.Properties["foo"].OasInfo
+	require.Equal(t, map[string]string{"first": "a", "second": "b, c"}, info.Examples)
+	require.Equal(t, "a", info.Default)
+	require.Equal(t, []string{"a", "b, c"}, info.Enum)
+	require.True(t, info.ReadOnly)
+	require.True(t, info.WriteOnly)
+	require.NotNil(t, info.Nullable)
+	require.False(t, *info.Nullable)
+	require.Equal(t, &OasExternalDocs{URL: "https://example.com/docs", Description: "More info"}, info.ExternalDocs)
+	require.Equal(t, 3, len(info.Extensions))
+	require.Equal(t, "bar", info.Extensions["x-foo"])
+	require.Equal(t, float64(1), info.Extensions["x-num"])
+	require.Equal(t, map[string]interface{}{"a": true}, info.Extensions["x-obj"])
+
+	info = v.Properties["bar"].OasInfo
+	require.Equal(t, &OasExternalDocs{URL: "https://example.com"}, info.ExternalDocs)
+	require.True(t, *info.Nullable)
+}
+
+func TestOasTagItemExtendedErrors(t *testing.T) {
+	testCases := []struct {
+		tag    string
+		expect string
+	}{
+		{"enum", fmt.Sprintf(msgOasExpectedColon, "enum")},
+		{"enum:'a'", fmt.Sprintf(msgOasExpectedList, "enum")},
+		{"enum:[a]", fmt.Sprintf(msgOasExpectedList, "enum")},
+		{"examples", fmt.Sprintf(msgOasExpectedColon, "examples")},
+		{"examples:['a']", fmt.Sprintf(msgOasExpectedNamed, "examples")},
+		{"examples:{a}", fmt.Sprintf(msgOasExpectedNamed, "examples")},
+		{"examples:{a:b}", fmt.Sprintf(msgOasExpectedNamed, "examples")},
+		{"default:xxx", fmt.Sprintf(msgOasExpectedString, "default")},
+		{"readOnly:xxx", fmt.Sprintf(msgOasUnexpectedColon, "readOnly")},
+		{"writeOnly:xxx", fmt.Sprintf(msgOasUnexpectedColon, "writeOnly")},
+		{"nullable:xxx", fmt.Sprintf(msgOasExpectedBool, "nullable")},
+		{"nullable:'true'", fmt.Sprintf(msgOasExpectedBool, "nullable")},
+		{"externalDocs", fmt.Sprintf(msgOasExpectedNamed, "externalDocs")},
+		{"externalDocs:xxx", fmt.Sprintf(msgOasExpectedNamed, "externalDocs")},
+		{"x-foo", fmt.Sprintf(msgOasExpectedColon, "x-foo")},
+		{"x-foo:xxx", fmt.Sprintf(msgOasExpectedValue, "x-foo")},
+		{"x-", fmt.Sprintf(msgOasUnknownTokenInTag, "x-")},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("[%d]%s", i+1, tc.tag), func(t *testing.T) {
+			pv := &PropertyValidator{}
+			err := pv.addOasTagItem(tc.tag)
+			require.Error(t, err)
+			require.Equal(t, tc.expect, err.Error())
+		})
+	}
+}
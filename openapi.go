@@ -0,0 +1,660 @@
+package valix
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// OpenAPIVersion is the OpenAPI specification version of generated documents
+	OpenAPIVersion = "3.1.0"
+	// OpenAPIExtensionPrefix is the prefix used for valix specific extensions in generated schemas
+	OpenAPIExtensionPrefix  = "x-valix-"
+	oasComponentsSchemasRef = "#/components/schemas/"
+	oasContentTypeJson      = "application/json"
+)
+
+const (
+	oasKeyType                 = "type"
+	oasKeyFormat               = "format"
+	oasKeyTitle                = "title"
+	oasKeyDescription          = "description"
+	oasKeyExamples             = "examples"
+	oasKeyDeprecated           = "deprecated"
+	oasKeyReadOnly             = "readOnly"
+	oasKeyWriteOnly            = "writeOnly"
+	oasKeyDefault              = "default"
+	oasKeyExternalDocs         = "externalDocs"
+	oasKeyUrl                  = "url"
+	oasKeyProperties           = "properties"
+	oasKeyRequired             = "required"
+	oasKeyAdditionalProperties = "additionalProperties"
+	oasKeyItems                = "items"
+	oasKeyOneOf                = "oneOf"
+	oasKeyRef                  = "$ref"
+	oasKeyMinLength            = "minLength"
+	oasKeyMaxLength            = "maxLength"
+	oasKeyMinItems             = "minItems"
+	oasKeyMaxItems             = "maxItems"
+	oasKeyMinProperties        = "minProperties"
+	oasKeyMaxProperties        = "maxProperties"
+	oasKeyMinimum              = "minimum"
+	oasKeyMaximum              = "maximum"
+	oasKeyExclusiveMinimum     = "exclusiveMinimum"
+	oasKeyExclusiveMaximum     = "exclusiveMaximum"
+	oasKeyMultipleOf           = "multipleOf"
+	oasKeyPattern              = "pattern"
+	oasKeyEnum                 = "enum"
+	oasKeyUniqueItems          = "uniqueItems"
+	oasExtConstraints          = OpenAPIExtensionPrefix + "constraints"
+	oasExtPreset               = OpenAPIExtensionPrefix + "preset"
+	oasExtMandatoryWhen        = OpenAPIExtensionPrefix + "mandatoryWhen"
+	oasExtWhenConditions       = OpenAPIExtensionPrefix + "whenConditions"
+	oasExtUnwantedConditions   = OpenAPIExtensionPrefix + "unwantedConditions"
+	oasExtRequiredWith         = OpenAPIExtensionPrefix + "requiredWith"
+	oasExtUnwantedWith         = OpenAPIExtensionPrefix + "unwantedWith"
+	oasExtOnly                 = OpenAPIExtensionPrefix + "only"
+	oasExtOnlyConditions       = OpenAPIExtensionPrefix + "onlyConditions"
+	oasExtAllowNullItems       = OpenAPIExtensionPrefix + "allowNullItems"
+	oasExtConditionalVariants  = OpenAPIExtensionPrefix + "conditionalVariants"
+)
+
+// ToOpenAPISchema generates the OpenAPI (3.1) schema for the validator
+//
+// Built-in constraints are mapped to their equivalent schema keywords (e.g. Length to `minLength`/`maxLength`,
+// Range to `minimum`/`maximum`) - constraints that have no equivalent are listed in the `x-valix-constraints` extension
+func (v *Validator) ToOpenAPISchema() map[string]interface{} {
+	return (&oasSchemaBuilder{}).validatorSchema(v)
+}
+
+// ToOpenAPISchema generates the OpenAPI (3.1) schema for the property validator
+func (pv *PropertyValidator) ToOpenAPISchema() map[string]interface{} {
+	return (&oasSchemaBuilder{}).propertySchema(pv)
+}
+
+// OpenAPIDocument builds OpenAPI (3.1) documents - with component schemas generated from validators and
+// paths generated from the operations of an API
+type OpenAPIDocument struct {
+	// Title is the title of the API (if empty, API.Title is used)
+	Title string
+	// Version is the version of the API (if empty, API.Version is used)
+	Version string
+	// Description is the description of the API (if empty, API.Description is used)
+	Description string
+	// Schemas is the map of component schema names (key) and validators (value)
+	//
+	// Wherever one of these validators is used (in operations or as an object validator) a `$ref` to the
+	// component schema is generated
+	Schemas map[string]*Validator
+	// API is the API from which the document paths are generated (may be nil)
+	API *API
+}
+
+// Build builds the OpenAPI document
+func (d *OpenAPIDocument) Build() map[string]interface{} {
+	title, version, description := d.Title, d.Version, d.Description
+	if d.API != nil {
+		title = defaultString(title, d.API.Title)
+		version = defaultString(version, d.API.Version)
+		description = defaultString(description, d.API.Description)
+	}
+	info := map[string]interface{}{
+		oasKeyTitle: title,
+		"version":   version,
+	}
+	if description != "" {
+		info[oasKeyDescription] = description
+	}
+	builder := &oasSchemaBuilder{refs: map[*Validator]string{}}
+	for name, v := range d.Schemas {
+		builder.refs[v] = oasComponentsSchemasRef + name
+	}
+	result := map[string]interface{}{
+		"openapi": OpenAPIVersion,
+		"info":    info,
+	}
+	if len(d.Schemas) > 0 {
+		schemas := map[string]interface{}{}
+		for name, v := range d.Schemas {
+			// build the component itself (rather than a ref to itself)...
+			schemas[name] = builder.validatorSchemaNoRef(v)
+		}
+		result["components"] = map[string]interface{}{"schemas": schemas}
+	}
+	if d.API != nil {
+		result["paths"] = builder.paths(d.API)
+	}
+	return result
+}
+
+// ToJSON builds the OpenAPI document as (indented) JSON
+func (d *OpenAPIDocument) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(d.Build(), "", "  ")
+}
+
+// ToYAML builds the OpenAPI document as YAML
+func (d *OpenAPIDocument) ToYAML() ([]byte, error) {
+	return yaml.Marshal(d.Build())
+}
+
+type oasSchemaBuilder struct {
+	// refs is the map of validators to their (full) $ref
+	refs map[*Validator]string
+	// draft is set when building JSON Schema (rather than OpenAPI schema)
+	draft JSONSchemaDraft
+}
+
+func (b *oasSchemaBuilder) validatorSchema(v *Validator) map[string]interface{} {
+	if ref, ok := b.refs[v]; ok {
+		return map[string]interface{}{oasKeyRef: ref}
+	}
+	return b.validatorSchemaNoRef(v)
+}
+
+func (b *oasSchemaBuilder) validatorSchemaNoRef(v *Validator) map[string]interface{} {
+	obj := b.objectSchema(v)
+	if v.AllowArray {
+		arr := map[string]interface{}{
+			oasKeyType:  jsonTypeTokenArray,
+			oasKeyItems: obj,
+		}
+		if v.AllowNullItems {
+			arr[oasExtAllowNullItems] = true
+		}
+		if v.DisallowObject {
+			return arr
+		}
+		return map[string]interface{}{oasKeyOneOf: []interface{}{obj, arr}}
+	}
+	return obj
+}
+
+func (b *oasSchemaBuilder) objectSchema(v *Validator) map[string]interface{} {
+	result := map[string]interface{}{
+		oasKeyType: jsonTypeTokenObject,
+	}
+	if len(v.Properties) > 0 {
+		properties := map[string]interface{}{}
+		required := make([]string, 0)
+		for pn, pv := range v.Properties {
+			properties[pn] = b.propertySchema(pv)
+			if pv.Mandatory && len(pv.MandatoryWhen) == 0 {
+				required = append(required, pn)
+			}
+		}
+		result[oasKeyProperties] = properties
+		if len(required) > 0 {
+			sort.Strings(required)
+			result[oasKeyRequired] = required
+		}
+		if b.draft != "" {
+			b.addPresenceDependencies(result, v.Properties)
+		}
+	}
+	if !v.IgnoreUnknownProperties {
+		result[oasKeyAdditionalProperties] = false
+	}
+	b.addOasInfo(result, v.OasInfo)
+	b.addConstraints(result, JsonObject, v.Constraints)
+	addOasConditions(result, oasExtWhenConditions, v.WhenConditions)
+	if len(v.ConditionalVariants) > 0 {
+		variants := make([]interface{}, 0, len(v.ConditionalVariants))
+		for _, cv := range v.ConditionalVariants {
+			variants = append(variants, b.conditionalVariantSchema(cv))
+		}
+		result[oasExtConditionalVariants] = variants
+	}
+	return result
+}
+
+func (b *oasSchemaBuilder) conditionalVariantSchema(cv *ConditionalVariant) map[string]interface{} {
+	result := b.objectSchema(&Validator{
+		IgnoreUnknownProperties: true,
+		Properties:              cv.Properties,
+		Constraints:             cv.Constraints,
+		ConditionalVariants:     cv.ConditionalVariants,
+	})
+	addOasConditions(result, oasExtWhenConditions, cv.WhenConditions)
+	delete(result, oasKeyAdditionalProperties)
+	return result
+}
+
+func (b *oasSchemaBuilder) propertySchema(pv *PropertyValidator) map[string]interface{} {
+	var result map[string]interface{}
+	switch pv.Type {
+	case JsonObject:
+		if pv.ObjectValidator != nil {
+			result = copyOasSchema(b.validatorSchema(pv.ObjectValidator))
+		} else {
+			result = map[string]interface{}{oasKeyType: jsonTypeTokenObject}
+		}
+	case JsonArray:
+		result = map[string]interface{}{oasKeyType: jsonTypeTokenArray}
+		if pv.ObjectValidator != nil {
+			result[oasKeyItems] = b.validatorSchema(pv.ObjectValidator)
+		}
+	case JsonDatetime:
+		result = map[string]interface{}{oasKeyType: jsonTypeTokenString, oasKeyFormat: "date-time"}
+	case JsonString, JsonNumber, JsonInteger, JsonBoolean:
+		result = map[string]interface{}{oasKeyType: pv.Type.String()}
+	default:
+		result = map[string]interface{}{}
+		if pv.ObjectValidator != nil {
+			result = copyOasSchema(b.validatorSchema(pv.ObjectValidator))
+		}
+	}
+	if !pv.NotNull {
+		if t, ok := result[oasKeyType].(string); ok {
+			result[oasKeyType] = []interface{}{t, "null"}
+		}
+	}
+	b.addConstraints(result, pv.Type, pv.Constraints)
+	b.addOasInfo(result, pv.OasInfo)
+	if pv.Mandatory {
+		addOasConditions(result, oasExtMandatoryWhen, pv.MandatoryWhen)
+	}
+	addOasConditions(result, oasExtWhenConditions, pv.WhenConditions)
+	addOasConditions(result, oasExtUnwantedConditions, pv.UnwantedConditions)
+	if len(pv.RequiredWith) > 0 && !(b.draft != "" && isPresenceExpressible(pv.RequiredWith)) {
+		result[oasExtRequiredWith] = pv.RequiredWith.String()
+	}
+	if len(pv.UnwantedWith) > 0 && !(b.draft != "" && isPresenceExpressible(pv.UnwantedWith)) {
+		result[oasExtUnwantedWith] = pv.UnwantedWith.String()
+	}
+	if pv.Only {
+		result[oasExtOnly] = true
+	}
+	addOasConditions(result, oasExtOnlyConditions, pv.OnlyConditions)
+	return result
+}
+
+func (b *oasSchemaBuilder) addOasInfo(schema map[string]interface{}, info *OasInfo) {
+	if info == nil {
+		return
+	}
+	if info.Title != "" {
+		schema[oasKeyTitle] = info.Title
+	}
+	if info.Description != "" {
+		schema[oasKeyDescription] = info.Description
+	}
+	if info.Format != "" {
+		schema[oasKeyFormat] = info.Format
+	}
+	examples := make([]interface{}, 0, len(info.Examples)+1)
+	if info.Example != "" {
+		examples = append(examples, info.Example)
+	}
+	names := make([]string, 0, len(info.Examples))
+	for name := range info.Examples {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		examples = append(examples, info.Examples[name])
+	}
+	if len(examples) > 0 {
+		schema[oasKeyExamples] = examples
+	}
+	if info.Default != "" {
+		schema[oasKeyDefault] = info.Default
+	}
+	if _, has := schema[oasKeyEnum]; !has && len(info.Enum) > 0 {
+		enum := make([]interface{}, len(info.Enum))
+		for i, v := range info.Enum {
+			enum[i] = v
+		}
+		schema[oasKeyEnum] = enum
+	}
+	if info.ExternalDocs != nil {
+		docs := map[string]interface{}{oasKeyUrl: info.ExternalDocs.URL}
+		if info.ExternalDocs.Description != "" {
+			docs[oasKeyDescription] = info.ExternalDocs.Description
+		}
+		schema[oasKeyExternalDocs] = docs
+	}
+	if info.Nullable != nil {
+		setOasNullable(schema, *info.Nullable)
+	}
+	for k, v := range info.Extensions {
+		schema[k] = v
+	}
+	if info.Deprecated {
+		schema[oasKeyDeprecated] = true
+	}
+	if info.ReadOnly {
+		schema[oasKeyReadOnly] = true
+	}
+	if info.WriteOnly {
+		schema[oasKeyWriteOnly] = true
+	}
+}
+
+func setOasNullable(schema map[string]interface{}, nullable bool) {
+	switch t := schema[oasKeyType].(type) {
+	case string:
+		if nullable {
+			schema[oasKeyType] = []interface{}{t, "null"}
+		}
+	case []interface{}:
+		if !nullable {
+			types := make([]interface{}, 0, len(t))
+			for _, tt := range t {
+				if tt != "null" {
+					types = append(types, tt)
+				}
+			}
+			if len(types) == 1 {
+				schema[oasKeyType] = types[0]
+			} else {
+				schema[oasKeyType] = types
+			}
+		}
+	}
+}
+
+func (b *oasSchemaBuilder) addConstraints(schema map[string]interface{}, t JsonType, constraints Constraints) {
+	unmapped := make([]interface{}, 0)
+	for _, c := range constraints {
+		if c == nil {
+			continue
+		}
+		if !b.addConstraint(schema, t, c) {
+			if cj, err := constraintToJson(c); err == nil {
+				unmapped = append(unmapped, cj)
+			}
+		}
+	}
+	if len(unmapped) > 0 {
+		schema[oasExtConstraints] = unmapped
+	}
+}
+
+// addConstraint maps a constraint to schema keywords - returning false if the constraint has no equivalent
+func (b *oasSchemaBuilder) addConstraint(schema map[string]interface{}, t JsonType, constraint Constraint) bool {
+	switch c := constraint.(type) {
+	case *Length:
+		minKey, maxKey, ok := oasLengthKeys(t)
+		if !ok {
+			return false
+		}
+		schema[minKey] = c.Minimum + ternary(c.ExclusiveMin).int(1, 0)
+		if c.Maximum > 0 {
+			schema[maxKey] = c.Maximum - ternary(c.ExclusiveMax).int(1, 0)
+		}
+	case *LengthExact:
+		minKey, maxKey, ok := oasLengthKeys(t)
+		if !ok {
+			return false
+		}
+		schema[minKey] = c.Value
+		schema[maxKey] = c.Value
+	case *NotEmpty:
+		minKey, _, ok := oasLengthKeys(t)
+		if !ok {
+			return false
+		}
+		schema[minKey] = 1
+	case *StringNotEmpty:
+		schema[oasKeyMinLength] = 1
+	case *StringLength:
+		schema[oasKeyMinLength] = c.Minimum + ternary(c.ExclusiveMin).int(1, 0)
+		if c.Maximum > 0 {
+			schema[oasKeyMaxLength] = c.Maximum - ternary(c.ExclusiveMax).int(1, 0)
+		}
+	case *StringMinLength:
+		schema[oasKeyMinLength] = c.Value + ternary(c.ExclusiveMin).int(1, 0)
+	case *StringMaxLength:
+		schema[oasKeyMaxLength] = c.Value - ternary(c.ExclusiveMax).int(1, 0)
+	case *StringExactLength:
+		schema[oasKeyMinLength] = c.Value
+		schema[oasKeyMaxLength] = c.Value
+	case *StringPattern:
+		schema[oasKeyPattern] = c.Regexp.String()
+	case *StringPresetPattern:
+		schema[oasExtPreset] = c.Preset
+		if p, ok := GetRegisteredPreset(c.Preset); ok && p.GetRegexp() != nil {
+			schema[oasKeyPattern] = p.GetRegexp().String()
+		}
+	case *StringValidToken:
+		if c.IgnoreCase {
+			return false
+		}
+		enum := make([]interface{}, len(c.Tokens))
+		for i, token := range c.Tokens {
+			enum[i] = token
+		}
+		schema[oasKeyEnum] = enum
+	case *ArrayUnique:
+		if c.IgnoreCase || c.IgnoreNulls {
+			return false
+		}
+		schema[oasKeyUniqueItems] = true
+	case *ArrayOf:
+		items := map[string]interface{}{}
+		jt, ok := JsonTypeFromString(c.Type)
+		if ok {
+			items = (&PropertyValidator{Type: jt, NotNull: !c.AllowNullElement}).ToOpenAPISchema()
+		} else {
+			jt = JsonAny
+		}
+		b.addConstraints(items, jt, c.Constraints)
+		schema[oasKeyItems] = items
+	case *Range:
+		addOasMinimum(schema, c.Minimum, c.ExclusiveMin)
+		addOasMaximum(schema, c.Maximum, c.ExclusiveMax)
+	case *RangeInt:
+		addOasMinimum(schema, c.Minimum, c.ExclusiveMin)
+		addOasMaximum(schema, c.Maximum, c.ExclusiveMax)
+	case *Minimum:
+		addOasMinimum(schema, c.Value, c.ExclusiveMin)
+	case *MinimumInt:
+		addOasMinimum(schema, c.Value, c.ExclusiveMin)
+	case *Maximum:
+		addOasMaximum(schema, c.Value, c.ExclusiveMax)
+	case *MaximumInt:
+		addOasMaximum(schema, c.Value, c.ExclusiveMax)
+	case *Positive:
+		addOasMinimum(schema, 0, true)
+	case *PositiveOrZero:
+		addOasMinimum(schema, 0, false)
+	case *Negative:
+		addOasMaximum(schema, 0, true)
+	case *NegativeOrZero:
+		addOasMaximum(schema, 0, false)
+	case *MultipleOf:
+		schema[oasKeyMultipleOf] = c.Value
+	case *StringValidEmail:
+		addOasFormat(schema, "email")
+	case *StringValidUuid:
+		addOasFormat(schema, "uuid")
+	case *StringValidISODatetime:
+		addOasFormat(schema, "date-time")
+	case *StringValidISODate:
+		addOasFormat(schema, "date")
+	case *StringValidISODuration:
+		addOasFormat(schema, "duration")
+	case *NetIsURI, *NetIsURL:
+		addOasFormat(schema, "uri")
+	case *NetIsHostname:
+		addOasFormat(schema, "hostname")
+	case *NetIsIP:
+		if c.V4Only == c.V6Only {
+			return false
+		}
+		addOasFormat(schema, ternary(c.V4Only).string("ipv4", "ipv6"))
+	default:
+		return false
+	}
+	return true
+}
+
+func (b *oasSchemaBuilder) paths(api *API) map[string]interface{} {
+	result := map[string]interface{}{}
+	for _, op := range api.Operations() {
+		pathItem, ok := result[op.Path].(map[string]interface{})
+		if !ok {
+			pathItem = map[string]interface{}{}
+			result[op.Path] = pathItem
+		}
+		pathItem[strings.ToLower(op.Method)] = b.operation(op)
+	}
+	return result
+}
+
+func (b *oasSchemaBuilder) operation(op *Operation) map[string]interface{} {
+	result := map[string]interface{}{}
+	if op.OperationId != "" {
+		result["operationId"] = op.OperationId
+	}
+	if op.Summary != "" {
+		result["summary"] = op.Summary
+	}
+	if op.Description != "" {
+		result[oasKeyDescription] = op.Description
+	}
+	if len(op.Tags) > 0 {
+		result["tags"] = op.Tags
+	}
+	if op.Deprecated {
+		result[oasKeyDeprecated] = true
+	}
+	parameters := make([]interface{}, 0)
+	for _, name := range op.pathParamNames {
+		schema := map[string]interface{}{oasKeyType: jsonTypeTokenString}
+		var info *OasInfo
+		if op.PathParams != nil {
+			if pv, ok := op.PathParams.Properties[name]; ok {
+				schema = b.propertySchema(&PropertyValidator{Type: pv.Type, NotNull: true, Constraints: pv.Constraints})
+				info = pv.OasInfo
+			}
+		}
+		parameters = append(parameters, oasParameter(name, "path", true, schema, info))
+	}
+	parameters = append(parameters, b.parameters(op.Query, "query")...)
+	parameters = append(parameters, b.parameters(op.Headers, "header")...)
+	if len(parameters) > 0 {
+		result["parameters"] = parameters
+	}
+	if op.Body != nil {
+		result["requestBody"] = map[string]interface{}{
+			oasKeyRequired: true,
+			"content":      b.content(op.Body),
+		}
+	}
+	responses := map[string]interface{}{}
+	for status, v := range op.Responses {
+		response := map[string]interface{}{}
+		if status == 0 {
+			response[oasKeyDescription] = "Default response"
+			responses["default"] = response
+		} else {
+			response[oasKeyDescription] = defaultString(http.StatusText(status), strconv.Itoa(status))
+			responses[strconv.Itoa(status)] = response
+		}
+		if v != nil {
+			response["content"] = b.content(v)
+		}
+	}
+	if len(responses) == 0 {
+		responses["default"] = map[string]interface{}{oasKeyDescription: "Default response"}
+	}
+	result["responses"] = responses
+	return result
+}
+
+func (b *oasSchemaBuilder) parameters(v *Validator, in string) []interface{} {
+	result := make([]interface{}, 0)
+	if v == nil {
+		return result
+	}
+	names := make([]string, 0, len(v.Properties))
+	for name := range v.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		pv := v.Properties[name]
+		schema := b.propertySchema(pv)
+		delete(schema, oasKeyDescription)
+		result = append(result, oasParameter(name, in, pv.Mandatory && len(pv.MandatoryWhen) == 0, schema, pv.OasInfo))
+	}
+	return result
+}
+
+func (b *oasSchemaBuilder) content(v *Validator) map[string]interface{} {
+	return map[string]interface{}{
+		oasContentTypeJson: map[string]interface{}{
+			"schema": b.validatorSchema(v),
+		},
+	}
+}
+
+func oasParameter(name string, in string, required bool, schema map[string]interface{}, info *OasInfo) map[string]interface{} {
+	result := map[string]interface{}{
+		"name":   name,
+		"in":     in,
+		"schema": schema,
+	}
+	if required {
+		result[oasKeyRequired] = true
+	}
+	if info != nil {
+		if info.Description != "" {
+			result[oasKeyDescription] = info.Description
+		}
+		if info.Deprecated {
+			result[oasKeyDeprecated] = true
+		}
+	}
+	return result
+}
+
+func oasLengthKeys(t JsonType) (string, string, bool) {
+	switch t {
+	case JsonString:
+		return oasKeyMinLength, oasKeyMaxLength, true
+	case JsonArray:
+		return oasKeyMinItems, oasKeyMaxItems, true
+	case JsonObject:
+		return oasKeyMinProperties, oasKeyMaxProperties, true
+	}
+	return "", "", false
+}
+
+func addOasMinimum(schema map[string]interface{}, value interface{}, exclusive bool) {
+	schema[ternary(exclusive).string(oasKeyExclusiveMinimum, oasKeyMinimum)] = value
+}
+
+func addOasMaximum(schema map[string]interface{}, value interface{}, exclusive bool) {
+	schema[ternary(exclusive).string(oasKeyExclusiveMaximum, oasKeyMaximum)] = value
+}
+
+func addOasFormat(schema map[string]interface{}, format string) {
+	if _, ok := schema[oasKeyFormat]; !ok {
+		schema[oasKeyFormat] = format
+	}
+}
+
+func addOasConditions(schema map[string]interface{}, key string, conditions Conditions) {
+	if len(conditions) > 0 {
+		list := make([]interface{}, len(conditions))
+		for i, c := range conditions {
+			list[i] = c
+		}
+		schema[key] = list
+	}
+}
+
+func copyOasSchema(schema map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(schema))
+	for k, v := range schema {
+		result[k] = v
+	}
+	return result
+}
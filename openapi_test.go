@@ -0,0 +1,340 @@
+package valix
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestValidator_ToOpenAPISchema(t *testing.T) {
+	v := &Validator{
+		OasInfo: &OasInfo{Title: "Person", Description: "A person"},
+		Properties: Properties{
+			"name": {
+				Type:        JsonString,
+				NotNull:     true,
+				Mandatory:   true,
+				Constraints: Constraints{&StringLength{Minimum: 1, Maximum: 10, ExclusiveMax: true}},
+				OasInfo:     &OasInfo{Description: "The name", Example: "Bilbo"},
+			},
+			"age": {
+				Type:        JsonInteger,
+				Constraints: Constraints{&RangeInt{Minimum: 0, Maximum: 150, ExclusiveMin: true}},
+			},
+			"code": {
+				Type:          JsonString,
+				NotNull:       true,
+				Mandatory:     true,
+				MandatoryWhen: Conditions{"foo"},
+				Constraints: Constraints{
+					&StringPattern{Regexp: *regexp.MustCompile(`^[A-Z]+$`)},
+					&StringUppercase{},
+				},
+			},
+			"status": {
+				Type:        JsonString,
+				NotNull:     true,
+				Constraints: Constraints{&StringValidToken{Tokens: []string{"active", "inactive"}}},
+				OasInfo:     &OasInfo{ReadOnly: true},
+			},
+			"tags": {
+				Type:    JsonArray,
+				NotNull: true,
+				Constraints: Constraints{
+					&ArrayUnique{},
+					&NotEmpty{},
+					&ArrayOf{Type: "string", Constraints: Constraints{&StringNotEmpty{}}},
+				},
+			},
+			"email": {
+				Type:        JsonString,
+				NotNull:     true,
+				Constraints: Constraints{&StringValidEmail{}},
+			},
+			"created": {
+				Type:    JsonDatetime,
+				NotNull: true,
+			},
+			"score": {
+				Type:        JsonNumber,
+				NotNull:     true,
+				Constraints: Constraints{&Positive{}, &MultipleOf{Value: 5}},
+			},
+			"address": {
+				Type:    JsonObject,
+				NotNull: true,
+				ObjectValidator: &Validator{
+					IgnoreUnknownProperties: true,
+					Properties: Properties{
+						"street": {Type: JsonString},
+					},
+				},
+			},
+		},
+	}
+	schema := v.ToOpenAPISchema()
+	require.Equal(t, "object", schema["type"])
+	require.Equal(t, "Person", schema["title"])
+	require.Equal(t, "A person", schema["description"])
+	require.Equal(t, false, schema["additionalProperties"])
+	require.Equal(t, []string{"name"}, schema["required"])
+	pties := schema["properties"].(map[string]interface{})
+	require.Equal(t, 9, len(pties))
+
+	name := pties["name"].(map[string]interface{})
+	require.Equal(t, "string", name["type"])
+	require.Equal(t, 1, name["minLength"])
+	require.Equal(t, 9, name["maxLength"])
+	require.Equal(t, "The name", name["description"])
+	require.Equal(t, []interface{}{"Bilbo"}, name["examples"])
+
+	age := pties["age"].(map[string]interface{})
+	require.Equal(t, []interface{}{"integer", "null"}, age["type"])
+	require.Equal(t, int64(0), age["exclusiveMinimum"])
+	require.Equal(t, int64(150), age["maximum"])
+
+	code := pties["code"].(map[string]interface{})
+	require.Equal(t, `^[A-Z]+$`, code["pattern"])
+	require.Equal(t, []interface{}{"foo"}, code["x-valix-mandatoryWhen"])
+	unmapped := code["x-valix-constraints"].([]interface{})
+	require.Equal(t, 1, len(unmapped))
+	require.Equal(t, "StringUppercase", unmapped[0].(map[string]interface{})["name"])
+
+	status := pties["status"].(map[string]interface{})
+	require.Equal(t, []interface{}{"active", "inactive"}, status["enum"])
+	require.Equal(t, true, status["readOnly"])
+
+	tags := pties["tags"].(map[string]interface{})
+	require.Equal(t, "array", tags["type"])
+	require.Equal(t, true, tags["uniqueItems"])
+	require.Equal(t, 1, tags["minItems"])
+	items := tags["items"].(map[string]interface{})
+	require.Equal(t, "string", items["type"])
+	require.Equal(t, 1, items["minLength"])
+
+	require.Equal(t, "email", pties["email"].(map[string]interface{})["format"])
+	require.Equal(t, "date-time", pties["created"].(map[string]interface{})["format"])
+
+	score := pties["score"].(map[string]interface{})
+	require.Equal(t, 0, score["exclusiveMinimum"])
+	require.Equal(t, int64(5), score["multipleOf"])
+
+	address := pties["address"].(map[string]interface{})
+	require.Equal(t, "object", address["type"])
+	_, hasAdditional := address["additionalProperties"]
+	require.False(t, hasAdditional)
+	require.Equal(t, []interface{}{"string", "null"}, address["properties"].(map[string]interface{})["street"].(map[string]interface{})["type"])
+}
+
+func TestValidator_ToOpenAPISchemaArrays(t *testing.T) {
+	v := &Validator{AllowArray: true, DisallowObject: true, AllowNullItems: true}
+	schema := v.ToOpenAPISchema()
+	require.Equal(t, "array", schema["type"])
+	require.Equal(t, true, schema["x-valix-allowNullItems"])
+	require.Equal(t, "object", schema["items"].(map[string]interface{})["type"])
+
+	v = &Validator{AllowArray: true}
+	schema = v.ToOpenAPISchema()
+	require.Equal(t, 2, len(schema["oneOf"].([]interface{})))
+}
+
+func TestPropertyValidator_ToOpenAPISchemaLengths(t *testing.T) {
+	schema := (&PropertyValidator{Type: JsonObject, NotNull: true, Constraints: Constraints{&Length{Minimum: 1, Maximum: 3}}}).ToOpenAPISchema()
+	require.Equal(t, 1, schema["minProperties"])
+	require.Equal(t, 3, schema["maxProperties"])
+	schema = (&PropertyValidator{Type: JsonArray, NotNull: true, Constraints: Constraints{&LengthExact{Value: 2}}}).ToOpenAPISchema()
+	require.Equal(t, 2, schema["minItems"])
+	require.Equal(t, 2, schema["maxItems"])
+	schema = (&PropertyValidator{Type: JsonAny, Constraints: Constraints{&Length{Minimum: 1}}}).ToOpenAPISchema()
+	_, hasType := schema["type"]
+	require.False(t, hasType)
+	require.Equal(t, 1, len(schema["x-valix-constraints"].([]interface{})))
+}
+
+func TestPropertyValidator_ToOpenAPISchemaArrayOfItemConstraints(t *testing.T) {
+	schema := (&PropertyValidator{
+		Type:    JsonArray,
+		NotNull: true,
+		Constraints: Constraints{
+			&ArrayOf{Type: "string", Constraints: Constraints{&NotEmpty{}, &Length{Minimum: 2}}},
+		},
+	}).ToOpenAPISchema()
+	items := schema["items"].(map[string]interface{})
+	require.Equal(t, "string", items["type"])
+	require.Equal(t, 2, items["minLength"])
+	_, hasUnmapped := items["x-valix-constraints"]
+	require.False(t, hasUnmapped)
+
+	schema = (&PropertyValidator{
+		Type:        JsonArray,
+		NotNull:     true,
+		Constraints: Constraints{&ArrayOf{Constraints: Constraints{&Length{Minimum: 2}}}},
+	}).ToOpenAPISchema()
+	items = schema["items"].(map[string]interface{})
+	require.Equal(t, 1, len(items["x-valix-constraints"].([]interface{})))
+}
+
+func TestPropertyValidator_ToOpenAPISchemaOasInfo(t *testing.T) {
+	notNullable := false
+	pv := &PropertyValidator{
+		Type: JsonString,
+		OasInfo: &OasInfo{
+			Example:      "a",
+			Examples:     map[string]string{"second": "c", "first": "b"},
+			Default:      "a",
+			Enum:         []string{"a", "b", "c"},
+			ExternalDocs: &OasExternalDocs{URL: "https://example.com/docs"},
+			Nullable:     &notNullable,
+			Extensions:   map[string]interface{}{"x-foo": "bar"},
+		},
+	}
+	schema := pv.ToOpenAPISchema()
+	require.Equal(t, "string", schema["type"])
+	require.Equal(t, []interface{}{"a", "b", "c"}, schema["examples"])
+	require.Equal(t, "a", schema["default"])
+	require.Equal(t, []interface{}{"a", "b", "c"}, schema["enum"])
+	require.Equal(t, map[string]interface{}{"url": "https://example.com/docs"}, schema["externalDocs"])
+	require.Equal(t, "bar", schema["x-foo"])
+
+	// enum constraint takes precedence...
+	pv.Constraints = Constraints{&StringValidToken{Tokens: []string{"x", "y"}}}
+	schema = pv.ToOpenAPISchema()
+	require.Equal(t, []interface{}{"x", "y"}, schema["enum"])
+
+	nullable := true
+	pv = &PropertyValidator{Type: JsonString, NotNull: true, OasInfo: &OasInfo{Nullable: &nullable}}
+	schema = pv.ToOpenAPISchema()
+	require.Equal(t, []interface{}{"string", "null"}, schema["type"])
+}
+
+func testOpenAPIDocument(t *testing.T) *OpenAPIDocument {
+	foo := &Validator{
+		Properties: Properties{
+			"id":   {Type: JsonString, NotNull: true, Mandatory: true, OasInfo: &OasInfo{ReadOnly: true}},
+			"name": {Type: JsonString, NotNull: true, Mandatory: true},
+		},
+	}
+	api := &API{Title: "Foos API", Version: "1.0.0"}
+	api.MustAddOperation(&Operation{
+		Method:      "GET",
+		Path:        "/foos",
+		OperationId: "listFoos",
+		Tags:        []string{"foos"},
+		Query: &Validator{
+			Properties: Properties{
+				"limit": {Type: JsonInteger, Constraints: Constraints{&Positive{}}, OasInfo: &OasInfo{Description: "Max results"}},
+			},
+		},
+		Responses: map[int]*Validator{
+			http.StatusOK: {AllowArray: true, DisallowObject: true, Properties: foo.Properties},
+		},
+	}).MustAddOperation(&Operation{
+		Method:      "POST",
+		Path:        "/foos",
+		OperationId: "createFoo",
+		Headers: &Validator{
+			Properties: Properties{
+				"X-Request-Id": {Type: JsonString, Mandatory: true},
+			},
+		},
+		Body: foo,
+		Responses: map[int]*Validator{
+			http.StatusCreated: foo,
+			0:                  nil,
+		},
+	}).MustAddOperation(&Operation{
+		Method: "GET",
+		Path:   "/foos/{fooId}",
+		PathParams: &Validator{
+			Properties: Properties{
+				"fooId": {Type: JsonString, Constraints: Constraints{&StringValidUuid{}}},
+			},
+		},
+		Responses: map[int]*Validator{
+			http.StatusOK: foo,
+		},
+	}).MustAddOperation(&Operation{
+		Method:     "DELETE",
+		Path:       "/foos/{fooId}",
+		Deprecated: true,
+	})
+	return &OpenAPIDocument{
+		Description: "All about foos",
+		Schemas:     map[string]*Validator{"Foo": foo},
+		API:         api,
+	}
+}
+
+func TestOpenAPIDocument_Build(t *testing.T) {
+	doc := testOpenAPIDocument(t).Build()
+	require.Equal(t, OpenAPIVersion, doc["openapi"])
+	info := doc["info"].(map[string]interface{})
+	require.Equal(t, "Foos API", info["title"])
+	require.Equal(t, "1.0.0", info["version"])
+	require.Equal(t, "All about foos", info["description"])
+
+	schemas := doc["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	fooSchema := schemas["Foo"].(map[string]interface{})
+	require.Equal(t, "object", fooSchema["type"])
+	require.Equal(t, []string{"id", "name"}, fooSchema["required"])
+
+	paths := doc["paths"].(map[string]interface{})
+	require.Equal(t, 2, len(paths))
+	foos := paths["/foos"].(map[string]interface{})
+	list := foos["get"].(map[string]interface{})
+	require.Equal(t, "listFoos", list["operationId"])
+	require.Equal(t, []string{"foos"}, list["tags"])
+	params := list["parameters"].([]interface{})
+	require.Equal(t, 1, len(params))
+	limit := params[0].(map[string]interface{})
+	require.Equal(t, "limit", limit["name"])
+	require.Equal(t, "query", limit["in"])
+	require.Equal(t, "Max results", limit["description"])
+	_, required := limit["required"]
+	require.False(t, required)
+
+	create := foos["post"].(map[string]interface{})
+	header := create["parameters"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "header", header["in"])
+	require.Equal(t, true, header["required"])
+	body := create["requestBody"].(map[string]interface{})
+	bodySchema := body["content"].(map[string]interface{})["application/json"].(map[string]interface{})["schema"].(map[string]interface{})
+	require.Equal(t, "#/components/schemas/Foo", bodySchema["$ref"])
+	responses := create["responses"].(map[string]interface{})
+	require.Equal(t, "Created", responses["201"].(map[string]interface{})["description"])
+	require.Equal(t, "Default response", responses["default"].(map[string]interface{})["description"])
+
+	fooPath := paths["/foos/{fooId}"].(map[string]interface{})
+	get := fooPath["get"].(map[string]interface{})
+	pathParam := get["parameters"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "fooId", pathParam["name"])
+	require.Equal(t, "path", pathParam["in"])
+	require.Equal(t, true, pathParam["required"])
+	require.Equal(t, "uuid", pathParam["schema"].(map[string]interface{})["format"])
+	del := fooPath["delete"].(map[string]interface{})
+	require.Equal(t, true, del["deprecated"])
+	require.Equal(t, "string", del["parameters"].([]interface{})[0].(map[string]interface{})["schema"].(map[string]interface{})["type"])
+}
+
+func TestOpenAPIDocument_ToJSONAndYAML(t *testing.T) {
+	doc := testOpenAPIDocument(t)
+	data, err := doc.ToJSON()
+	require.NoError(t, err)
+	obj := map[string]interface{}{}
+	err = json.Unmarshal(data, &obj)
+	require.NoError(t, err)
+	require.Equal(t, OpenAPIVersion, obj["openapi"])
+
+	data, err = doc.ToYAML()
+	require.NoError(t, err)
+	require.True(t, strings.Contains(string(data), "openapi: 3.1.0\n"))
+	yobj := map[string]interface{}{}
+	err = yaml.Unmarshal(data, &yobj)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(yobj["paths"].(map[string]interface{})))
+}
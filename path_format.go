@@ -0,0 +1,95 @@
+package valix
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PathFormat determines how property paths are rendered - in Violation.Path, ValidatorContext.CurrentPath
+// and ValidatorContext.AncestorPath
+//
+// The path format used for a validation is determined by the Validator.PathFormat of the validator
+// on which validation is started (see also Validator.WithPathFormat) - or, per validation, by the WithPathFormat
+// validate option
+//
+// Note: Violation.Property is not affected by the path format (array indices are always rendered as "[n]")
+type PathFormat int
+
+const (
+	// PathFormatDotted renders paths in dotted notation - e.g. "foo.bar[0].baz" (the default)
+	//
+	// Note: property names are not escaped - so paths may be ambiguous where property names contain dots or brackets
+	PathFormatDotted PathFormat = iota
+	// PathFormatJsonPointer renders paths as RFC 6901 JSON Pointers - e.g. "/foo/bar/0/baz"
+	PathFormatJsonPointer
+	// PathFormatJsonPath renders paths as bracket-quoted JSONPath - e.g. "$['foo']['bar'][0]['baz']"
+	PathFormatJsonPath
+)
+
+const (
+	pathFormatTokenDotted      = "dotted"
+	pathFormatTokenJsonPointer = "jsonPointer"
+	pathFormatTokenJsonPath    = "jsonPath"
+)
+
+var pathFormatTokensList = pathFormatTokenDotted + "," + pathFormatTokenJsonPointer + "," + pathFormatTokenJsonPath
+
+// String returns the token for the path format (i.e. "dotted", "jsonPointer" or "jsonPath")
+func (f PathFormat) String() string {
+	switch f {
+	case PathFormatJsonPointer:
+		return pathFormatTokenJsonPointer
+	case PathFormatJsonPath:
+		return pathFormatTokenJsonPath
+	}
+	return pathFormatTokenDotted
+}
+
+// PathFormatFromString returns the PathFormat for the token (case-insensitive)
+func PathFormatFromString(str string) (PathFormat, bool) {
+	switch strings.ToLower(str) {
+	case strings.ToLower(pathFormatTokenDotted):
+		return PathFormatDotted, true
+	case strings.ToLower(pathFormatTokenJsonPointer):
+		return PathFormatJsonPointer, true
+	case strings.ToLower(pathFormatTokenJsonPath):
+		return PathFormatJsonPath, true
+	}
+	return PathFormatDotted, false
+}
+
+// rootPath returns the path of the root in the path format
+func (f PathFormat) rootPath() string {
+	if f == PathFormatJsonPath {
+		return "$"
+	}
+	return ""
+}
+
+// join returns the path of the property (a string name or int array index) within the parent path
+func (f PathFormat) join(path string, property interface{}) string {
+	switch pty := property.(type) {
+	case string:
+		switch f {
+		case PathFormatJsonPointer:
+			return path + "/" + jsonPointerEscaper.Replace(pty)
+		case PathFormatJsonPath:
+			return path + "['" + jsonPathEscaper.Replace(pty) + "']"
+		}
+		if path != "" {
+			return path + "." + pty
+		}
+		return pty
+	case int:
+		if f == PathFormatJsonPointer {
+			return path + "/" + strconv.Itoa(pty)
+		}
+		return path + "[" + strconv.Itoa(pty) + "]"
+	}
+	return path
+}
+
+var (
+	jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+	jsonPathEscaper    = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+)
@@ -0,0 +1,204 @@
+package valix
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathFormat_Join(t *testing.T) {
+	testCases := []struct {
+		format   PathFormat
+		path     string
+		property interface{}
+		expect   string
+	}{
+		{PathFormatDotted, "", "foo", "foo"},
+		{PathFormatDotted, "foo", "bar", "foo.bar"},
+		{PathFormatDotted, "foo", 0, "foo[0]"},
+		{PathFormatDotted, "foo", "a.b", "foo.a.b"},
+		{PathFormatJsonPointer, "", "foo", "/foo"},
+		{PathFormatJsonPointer, "/foo", "bar", "/foo/bar"},
+		{PathFormatJsonPointer, "/foo", 0, "/foo/0"},
+		{PathFormatJsonPointer, "", "a/b", "/a~1b"},
+		{PathFormatJsonPointer, "", "m~n", "/m~0n"},
+		{PathFormatJsonPointer, "", "~/", "/~0~1"},
+		{PathFormatJsonPointer, "", "", "/"},
+		{PathFormatJsonPath, "$", "foo", "$['foo']"},
+		{PathFormatJsonPath, "$['foo']", "bar", "$['foo']['bar']"},
+		{PathFormatJsonPath, "$['foo']", 0, "$['foo'][0]"},
+		{PathFormatJsonPath, "$", "it's", `$['it\'s']`},
+		{PathFormatJsonPath, "$", `a\b`, `$['a\\b']`},
+		{PathFormatJsonPath, "$", "a.b[0]", "$['a.b[0]']"},
+	}
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("[%d]%s:%v", i+1, tc.format, tc.property), func(t *testing.T) {
+			require.Equal(t, tc.expect, tc.format.join(tc.path, tc.property))
+		})
+	}
+}
+
+func TestPathFormat_StringAndFromString(t *testing.T) {
+	for _, f := range []PathFormat{PathFormatDotted, PathFormatJsonPointer, PathFormatJsonPath} {
+		pf, ok := PathFormatFromString(f.String())
+		require.True(t, ok)
+		require.Equal(t, f, pf)
+	}
+	pf, ok := PathFormatFromString("JSONPOINTER")
+	require.True(t, ok)
+	require.Equal(t, PathFormatJsonPointer, pf)
+	_, ok = PathFormatFromString("unknown")
+	require.False(t, ok)
+}
+
+func TestPathFormat_UnmarshalJSON(t *testing.T) {
+	var f PathFormat
+	require.NoError(t, json.Unmarshal([]byte(`"jsonPath"`), &f))
+	require.Equal(t, PathFormatJsonPath, f)
+	require.NoError(t, json.Unmarshal([]byte(`1`), &f))
+	require.Equal(t, PathFormatJsonPointer, f)
+	err := json.Unmarshal([]byte(`"xxx"`), &f)
+	require.Error(t, err)
+	require.Equal(t, "value for PathFormat expected string (dotted,jsonPointer,jsonPath) or integer (0 to 2)", err.Error())
+	require.Error(t, json.Unmarshal([]byte(`3`), &f))
+
+	v := &Validator{}
+	require.NoError(t, json.Unmarshal([]byte(`{"pathFormat": "jsonPointer"}`), v))
+	require.Equal(t, PathFormatJsonPointer, v.PathFormat)
+}
+
+func TestValidatorPathFormats(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"a/b": {
+				Type: JsonArray,
+				ObjectValidator: &Validator{
+					AllowArray: true,
+					Properties: Properties{
+						"it's": {
+							Type: JsonObject,
+							ObjectValidator: &Validator{
+								Properties: Properties{
+									"c": {Type: JsonString, Mandatory: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	obj := map[string]interface{}{
+		"a/b": []interface{}{
+			map[string]interface{}{
+				"it's": map[string]interface{}{},
+			},
+		},
+	}
+	testCases := []struct {
+		format PathFormat
+		expect string
+	}{
+		{PathFormatDotted, "a/b[0].it's"},
+		{PathFormatJsonPointer, "/a~1b/0/it's"},
+		{PathFormatJsonPath, `$['a/b'][0]['it\'s']`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.format.String(), func(t *testing.T) {
+			ok, violations := v.WithPathFormat(tc.format).Validate(obj)
+			require.False(t, ok)
+			require.Equal(t, 1, len(violations))
+			require.Equal(t, tc.expect, violations[0].Path)
+			require.Equal(t, "c", violations[0].Property)
+		})
+	}
+	// original validator is not altered...
+	require.Equal(t, PathFormatDotted, v.PathFormat)
+}
+
+func TestValidatorPathFormatRootViolations(t *testing.T) {
+	v := &Validator{
+		PathFormat: PathFormatJsonPath,
+		Properties: Properties{
+			"foo": {Type: JsonString, Mandatory: true},
+		},
+	}
+	ok, violations := v.Validate(map[string]interface{}{})
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "$", violations[0].Path)
+
+	ok, violations = v.WithPathFormat(PathFormatJsonPointer).Validate(map[string]interface{}{})
+	require.False(t, ok)
+	require.Equal(t, "", violations[0].Path)
+}
+
+func TestWithPathFormatOption(t *testing.T) {
+	v := &Validator{
+		PathFormat: PathFormatJsonPath,
+		Properties: Properties{
+			"foo": {
+				Type: JsonObject,
+				ObjectValidator: &Validator{
+					Properties: Properties{
+						"bar": {Type: JsonString, Mandatory: true},
+					},
+				},
+			},
+		},
+	}
+	obj := map[string]interface{}{"foo": map[string]interface{}{}}
+	ok, violations := v.Validate(obj)
+	require.False(t, ok)
+	require.Equal(t, "$['foo']", violations[0].Path)
+
+	ok, violations = v.ValidateWith(obj, WithPathFormat(PathFormatJsonPointer))
+	require.False(t, ok)
+	require.Equal(t, "/foo", violations[0].Path)
+
+	ok, violations, _ = v.ValidateStringWith(`{"foo": {}}`, WithPathFormat(PathFormatDotted))
+	require.False(t, ok)
+	require.Equal(t, "foo", violations[0].Path)
+
+	ok, violations = (&PropertyValidator{Type: JsonArray, Constraints: Constraints{&ArrayOf{Type: "string"}}}).
+		ValidateWith([]interface{}{1}, WithPathFormat(PathFormatJsonPath))
+	require.False(t, ok)
+	require.Equal(t, "$", violations[0].Path)
+	// the validator is not altered...
+	require.Equal(t, PathFormatJsonPath, v.PathFormat)
+}
+
+func TestValidatorContextPathsWithPathFormat(t *testing.T) {
+	currentPath := ""
+	ancestorPath := ""
+	v := &Validator{
+		PathFormat: PathFormatJsonPointer,
+		Properties: Properties{
+			"foo": {
+				Type: JsonObject,
+				ObjectValidator: &Validator{
+					Properties: Properties{
+						"bar": {
+							Type: JsonString,
+							Constraints: Constraints{
+								NewCustomConstraint(func(value interface{}, vcx *ValidatorContext, this *CustomConstraint) (bool, string) {
+									currentPath = vcx.CurrentPath()
+									if p, ok := vcx.AncestorPath(0); ok {
+										ancestorPath = *p
+									}
+									return true, ""
+								}, ""),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	ok, _ := v.Validate(map[string]interface{}{"foo": map[string]interface{}{"bar": "x"}})
+	require.True(t, ok)
+	require.Equal(t, "/foo", currentPath)
+	require.Equal(t, "", ancestorPath)
+}
@@ -123,6 +123,17 @@ func (r *presetRegistry) reset() {
 	r.namedPresets = getBuiltInPresets()
 }
 
+// all returns a copy of the registered presets
+func (r *presetRegistry) all() map[string]Preset {
+	defer r.sync.Unlock()
+	r.sync.Lock()
+	result := make(map[string]Preset, len(r.namedPresets))
+	for token, p := range r.namedPresets {
+		result[token] = p
+	}
+	return result
+}
+
 func (r *presetRegistry) get(token string) (Preset, bool) {
 	defer r.sync.Unlock()
 	r.sync.Lock()
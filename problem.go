@@ -0,0 +1,226 @@
+package valix
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	// ProblemContentType is the content type of problem details documents (see RFC 9457 & RFC 7807)
+	ProblemContentType = "application/problem+json"
+	// ProblemTypeBlank is the default problem type URI - used when no specific problem type is configured
+	ProblemTypeBlank                   = "about:blank"
+	msgProblemTitleBadRequest          = "Request could not be understood"
+	msgProblemTitleUnprocessableEntity = "Request failed validation"
+)
+
+// DefaultProblemRenderer is the ProblemRenderer used by WriteProblem - replace with your own if necessary
+var DefaultProblemRenderer = &ProblemRenderer{}
+
+func getDefaultProblemRenderer() *ProblemRenderer {
+	if DefaultProblemRenderer != nil {
+		return DefaultProblemRenderer
+	}
+	return &ProblemRenderer{}
+}
+
+// Problem is a problem details document (as defined by RFC 9457, formerly RFC 7807) - with an
+// additional "errors" extension member listing each of the violations
+type Problem struct {
+	// Type is the URI reference that identifies the problem type
+	Type string `json:"type"`
+	// Title is the short, human-readable (and translated) summary of the problem type
+	Title string `json:"title"`
+	// Status is the HTTP status code - either 400 (Bad Request) or 422 (Unprocessable Entity)
+	Status int `json:"status"`
+	// Detail is the human-readable explanation specific to this occurrence of the problem
+	Detail string `json:"detail,omitempty"`
+	// Instance is the URI reference that identifies the specific occurrence of the problem
+	Instance string `json:"instance,omitempty"`
+	// Errors is the extension member listing each violation
+	Errors []*ProblemError `json:"errors,omitempty"`
+}
+
+// ProblemError is an item in the Problem.Errors extension member - and represents a single Violation
+type ProblemError struct {
+	// Type is the URI reference that identifies the violation type (only set if a type URI is configured for the violation code)
+	Type string `json:"type,omitempty"`
+	// Property is the name of the property that failed validation
+	Property string `json:"property"`
+	// Path is the path to the property that failed validation
+	Path string `json:"path"`
+	// Message is the violation message
+	Message string `json:"message"`
+	// Severity is the violation severity (see Violation.Severity)
+	Severity Severity `json:"severity"`
+	// Code is the violation code (see Violation.Code)
+	Code int `json:"code,omitempty"`
+	// Constraint is the name of the constraint that failed (see Violation.Constraint)
+	Constraint string `json:"constraint,omitempty"`
+	// Parameters is the parameters of the constraint that failed (see Violation.Parameters)
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	// Value is the rejected value (see Violation.Value)
+	Value interface{} `json:"value,omitempty"`
+	// Position is the position of the offending value in the raw JSON (see Violation.Position)
+	Position *SourcePosition `json:"position,omitempty"`
+}
+
+// ProblemRenderer renders violations into problem details documents (see Problem)
+type ProblemRenderer struct {
+	// TypeURIs is a map of violation codes (e.g. CodeMissingProperty) to problem type URIs
+	//
+	// Where all violations rendered share the same code, and a type URI is found for that code, the
+	// type URI is used as the Problem.Type.  Each ProblemError.Type is also set from this map
+	TypeURIs map[int]string
+	// BadRequestType is the problem type URI used for 400 Bad Request problems (if empty, "about:blank" is used)
+	BadRequestType string
+	// UnprocessableEntityType is the problem type URI used for 422 Unprocessable Entity problems (if empty, "about:blank" is used)
+	UnprocessableEntityType string
+	// BadRequestTitle is the title used for 400 Bad Request problems (if empty, the default title is used)
+	//
+	// Note: the title is translated (using I18nContext.TranslateMessage)
+	BadRequestTitle string
+	// UnprocessableEntityTitle is the title used for 422 Unprocessable Entity problems (if empty, the default title is used)
+	//
+	// Note: the title is translated (using I18nContext.TranslateMessage)
+	UnprocessableEntityTitle string
+	// I18n is the I18n provider used to determine the I18nContext from the request (see ProblemRenderer.RenderRequest) -
+	// if nil, DefaultI18nProvider is used
+	//
+	// Note: API.Handler always uses the I18n provider of the operation's validators (see Validator.I18n)
+	I18n I18n
+}
+
+// Render renders the violations as a Problem
+//
+// The status of the problem is 400 (Bad Request) if any of the error violations has Violation.BadRequest set - otherwise
+// it is 422 (Unprocessable Entity)
+//
+// Only error violations (see Violation.IsError) determine the status, detail and type of the problem - any warnings
+// are only included in the Problem.Errors
+//
+// Note: If there are no error violations, nil is returned
+func (pr *ProblemRenderer) Render(tcx I18nContext, violations []*Violation) *Problem {
+	if !hasErrorViolations(violations) {
+		return nil
+	}
+	useTcx := obtainI18nContext(tcx)
+	badRequest := false
+	for _, v := range violations {
+		badRequest = badRequest || (v.BadRequest && v.IsError())
+	}
+	result := &Problem{
+		Status: ternary(badRequest).int(http.StatusBadRequest, http.StatusUnprocessableEntity),
+		Title: useTcx.TranslateMessage(ternary(badRequest).string(
+			defaultString(pr.BadRequestTitle, msgProblemTitleBadRequest),
+			defaultString(pr.UnprocessableEntityTitle, msgProblemTitleUnprocessableEntity))),
+		Type: ternary(badRequest).string(
+			defaultString(pr.BadRequestType, ProblemTypeBlank),
+			defaultString(pr.UnprocessableEntityType, ProblemTypeBlank)),
+		Errors: make([]*ProblemError, 0, len(violations)),
+	}
+	sorted := make([]*Violation, len(violations))
+	copy(sorted, violations)
+	SortViolationsByPathAndProperty(sorted)
+	commonCode, allSame, firstError := 0, true, true
+	for _, v := range sorted {
+		pe := &ProblemError{
+			Property:   v.Property,
+			Path:       v.Path,
+			Message:    v.Message,
+			Severity:   v.Severity,
+			Constraint: v.Constraint,
+			Parameters: v.Parameters,
+			Value:      v.Value,
+			Position:   v.Position,
+		}
+		if code, ok := violationCode(v); ok {
+			pe.Code = code
+			pe.Type = pr.TypeURIs[code]
+		}
+		if v.IsError() {
+			if firstError {
+				result.Detail = v.Message
+				commonCode = pe.Code
+				firstError = false
+			} else {
+				allSame = allSame && pe.Code == commonCode
+			}
+		}
+		result.Errors = append(result.Errors, pe)
+	}
+	if allSame {
+		if uri, ok := pr.TypeURIs[commonCode]; ok && uri != "" {
+			result.Type = uri
+		}
+	}
+	return result
+}
+
+// RenderRequest renders the violations as a Problem - using the supplied request to determine the I18nContext
+// (i.e. translations according to the request 'Accept-Language' header) and the Problem.Instance
+//
+// The I18nContext is obtained from ProblemRenderer.I18n (if set), otherwise from DefaultI18nProvider
+func (pr *ProblemRenderer) RenderRequest(req *http.Request, violations []*Violation) *Problem {
+	return pr.renderRequest(req, pr.i18nProvider().ContextFromRequest(req), violations)
+}
+
+func (pr *ProblemRenderer) renderRequest(req *http.Request, tcx I18nContext, violations []*Violation) *Problem {
+	result := pr.Render(tcx, violations)
+	if result != nil && req.URL != nil {
+		result.Instance = req.URL.Path
+	}
+	return result
+}
+
+// Write renders the violations as a Problem and writes it to the response writer (setting the
+// 'Content-Type' header to "application/problem+json" and the response status code)
+//
+// Note: If there are no error violations, nothing is written
+func (pr *ProblemRenderer) Write(w http.ResponseWriter, req *http.Request, violations []*Violation) error {
+	return pr.write(w, req, pr.i18nProvider().ContextFromRequest(req), violations)
+}
+
+func (pr *ProblemRenderer) i18nProvider() I18n {
+	if pr.I18n != nil {
+		return pr.I18n
+	}
+	return obtainI18nProvider()
+}
+
+func (pr *ProblemRenderer) write(w http.ResponseWriter, req *http.Request, tcx I18nContext, violations []*Violation) error {
+	if problem := pr.renderRequest(req, tcx, violations); problem != nil {
+		return problem.Write(w)
+	}
+	return nil
+}
+
+// Write writes the problem to the response writer (setting the 'Content-Type' header to
+// "application/problem+json" and the response status code)
+func (p *Problem) Write(w http.ResponseWriter) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteProblem renders the violations as a Problem (using the DefaultProblemRenderer) and writes it to the response writer
+func WriteProblem(w http.ResponseWriter, req *http.Request, violations []*Violation) error {
+	return getDefaultProblemRenderer().Write(w, req, violations)
+}
+
+// violationCode returns the numeric code of the violation (i.e. Violation.Code or the first item in Violation.Codes - if it is an int)
+func violationCode(v *Violation) (int, bool) {
+	if v.Code != 0 {
+		return v.Code, true
+	} else if len(v.Codes) > 0 {
+		if code, ok := v.Codes[0].(int); ok {
+			return code, true
+		}
+	}
+	return 0, false
+}
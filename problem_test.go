@@ -0,0 +1,144 @@
+package valix
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProblemRenderer_Render(t *testing.T) {
+	pr := &ProblemRenderer{}
+	p := pr.Render(nil, []*Violation{})
+	require.Nil(t, p)
+
+	v := &Validator{
+		Properties: Properties{
+			"foo": {Type: JsonString, Mandatory: true},
+			"bar": {Type: JsonString, Mandatory: true},
+		},
+	}
+	ok, violations := v.Validate(map[string]interface{}{})
+	require.False(t, ok)
+	p = pr.Render(nil, violations)
+	require.NotNil(t, p)
+	require.Equal(t, http.StatusUnprocessableEntity, p.Status)
+	require.Equal(t, ProblemTypeBlank, p.Type)
+	require.Equal(t, msgProblemTitleUnprocessableEntity, p.Title)
+	require.Equal(t, msgMissingProperty, p.Detail)
+	require.Equal(t, 2, len(p.Errors))
+	require.Equal(t, "bar", p.Errors[0].Property)
+	require.Equal(t, "foo", p.Errors[1].Property)
+	require.Equal(t, CodeMissingProperty, p.Errors[0].Code)
+	require.Equal(t, "", p.Errors[0].Type)
+
+	pr.TypeURIs = map[int]string{
+		CodeMissingProperty: "https://example.com/problems/missing-property",
+	}
+	p = pr.Render(nil, violations)
+	require.Equal(t, "https://example.com/problems/missing-property", p.Type)
+	require.Equal(t, "https://example.com/problems/missing-property", p.Errors[0].Type)
+
+	violations = append(violations, NewViolation("baz", "", "other", CodeUnknownProperty))
+	p = pr.Render(nil, violations)
+	require.Equal(t, ProblemTypeBlank, p.Type)
+	require.Equal(t, 3, len(p.Errors))
+}
+
+func TestProblemRenderer_RenderIgnoresWarnings(t *testing.T) {
+	pr := &ProblemRenderer{}
+	warning := &Violation{Property: "aaa", Message: "deprecated", Severity: SeverityWarning, BadRequest: true, Code: CodeDeprecatedProperty}
+	require.Nil(t, pr.Render(nil, []*Violation{warning}))
+
+	violation := NewViolation("foo", "", "missing", CodeMissingProperty)
+	pr.TypeURIs = map[int]string{
+		CodeMissingProperty: "https://example.com/problems/missing-property",
+	}
+	p := pr.Render(nil, []*Violation{warning, violation})
+	require.NotNil(t, p)
+	require.Equal(t, http.StatusUnprocessableEntity, p.Status)
+	require.Equal(t, "missing", p.Detail)
+	require.Equal(t, "https://example.com/problems/missing-property", p.Type)
+	require.Equal(t, 2, len(p.Errors))
+	require.Equal(t, SeverityWarning, p.Errors[0].Severity)
+}
+
+func TestProblemRenderer_RenderBadRequest(t *testing.T) {
+	pr := &ProblemRenderer{
+		BadRequestType:  "https://example.com/problems/bad-request",
+		BadRequestTitle: "Bad!",
+	}
+	ok, violations, _ := (&Validator{}).ValidateString(`not json`)
+	require.False(t, ok)
+	p := pr.Render(nil, violations)
+	require.Equal(t, http.StatusBadRequest, p.Status)
+	require.Equal(t, "https://example.com/problems/bad-request", p.Type)
+	require.Equal(t, "Bad!", p.Title)
+	require.Equal(t, CodeUnableToDecode, p.Errors[0].Code)
+}
+
+func TestProblemRenderer_RenderRequestTranslates(t *testing.T) {
+	req, _ := http.NewRequest("POST", "http://example.com/foos", strings.NewReader(`{}`))
+	req.Header.Set("Accept-Language", "fr")
+	v := &Validator{
+		Properties: Properties{
+			"foo": {Type: JsonString, Mandatory: true},
+		},
+	}
+	ok, violations, _ := v.RequestValidate(req)
+	require.False(t, ok)
+	p := (&ProblemRenderer{}).RenderRequest(req, violations)
+	require.Equal(t, "La requête a échoué à la validation", p.Title)
+	require.Equal(t, "Propriété manquante", p.Detail)
+	require.Equal(t, "/foos", p.Instance)
+}
+
+func TestWriteProblem(t *testing.T) {
+	req, _ := http.NewRequest("POST", "http://example.com/foos", strings.NewReader(`{}`))
+	w := httptest.NewRecorder()
+	err := WriteProblem(w, req, []*Violation{})
+	require.NoError(t, err)
+	require.Equal(t, 0, w.Body.Len())
+
+	w = httptest.NewRecorder()
+	err = WriteProblem(w, req, []*Violation{NewViolation("foo", "", msgMissingProperty, CodeMissingProperty)})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.Equal(t, ProblemContentType, w.Header().Get("Content-Type"))
+	obj := map[string]interface{}{}
+	err = json.Unmarshal(w.Body.Bytes(), &obj)
+	require.NoError(t, err)
+	require.Equal(t, ProblemTypeBlank, obj["type"])
+	require.Equal(t, float64(http.StatusUnprocessableEntity), obj["status"])
+	errs := obj["errors"].([]interface{})
+	require.Equal(t, 1, len(errs))
+	e := errs[0].(map[string]interface{})
+	require.Equal(t, "foo", e["property"])
+	require.Equal(t, "", e["path"])
+	require.Equal(t, msgMissingProperty, e["message"])
+	require.Equal(t, float64(CodeMissingProperty), e["code"])
+}
+
+func TestProblemRenderer_RenderIncludesConstraintMetadata(t *testing.T) {
+	v := &Validator{
+		IncludeRejectedValues: true,
+		Properties: Properties{
+			"foo": {Type: JsonString, Constraints: Constraints{&StringMinLength{Value: 3}}},
+		},
+	}
+	ok, violations := v.Validate(map[string]interface{}{"foo": "a"})
+	require.False(t, ok)
+	p := (&ProblemRenderer{}).Render(nil, violations)
+	require.Equal(t, 1, len(p.Errors))
+	require.Equal(t, CodePropertyConstraintFail, p.Errors[0].Code)
+	require.Equal(t, "StringMinLength", p.Errors[0].Constraint)
+	require.Equal(t, map[string]interface{}{"minimum": 3}, p.Errors[0].Parameters)
+	require.Equal(t, "a", p.Errors[0].Value)
+
+	data, err := json.Marshal(p.Errors[0])
+	require.NoError(t, err)
+	require.Equal(t, `{"property":"foo","path":"","message":"`+p.Errors[0].Message+`","severity":"error","code":42299,"constraint":"StringMinLength","parameters":{"minimum":3},"value":"a"}`, string(data))
+}
@@ -181,6 +181,12 @@ type PropertyValidator struct {
 	OnlyConditions Conditions
 	// OnlyMessage is the violation message to use when the Only or OnlyConditions fails (i.e. the property is not the only property)
 	OnlyMessage string
+	// Label is the display name of the property (e.g. "Postcode") - available to violation messages as the "{label}"
+	// placeholder (see also ValidatorContext.CurrentLabel)
+	//
+	// The label is a translatable token (i.e. it is translated using I18nContext.TranslateToken) - if the label is
+	// empty, the property name is used
+	Label string
 	// OasInfo is additional information (for OpenAPI Specification)
 	OasInfo *OasInfo
 }
@@ -223,12 +229,31 @@ func CreatePropertyValidator(v8nTags ...string) *PropertyValidator {
 
 // Validate validates a value
 func (pv *PropertyValidator) Validate(value interface{}, initialConditions ...string) (bool, []*Violation) {
-	vcx := newValidatorContext(value, nil, false, obtainI18nProvider().DefaultContext())
-	vcx.setInitialConditions(initialConditions...)
+	return pv.ValidateWith(value, WithConditions(initialConditions...))
+}
+
+// ValidateWith is the same as PropertyValidator.Validate - except that it accepts validate options (see ValidateOption)
+func (pv *PropertyValidator) ValidateWith(value interface{}, options ...ValidateOption) (bool, []*Violation) {
+	value, _ = nativeToJson(value)
+	settings := newValidateSettings(options)
+	vcx := newValidatorContext(value, nil, false, settings.i18nContext(obtainI18nProvider(), nil))
+	vcx.applySettings(settings)
 	pv.validate(value, vcx)
 	return vcx.ok, vcx.violations
 }
 
+// ValidateErr is the same as PropertyValidator.Validate - except that it returns an error (a *ValidationError)
+// if validation fails
+func (pv *PropertyValidator) ValidateErr(value interface{}, initialConditions ...string) error {
+	return pv.ValidateErrWith(value, WithConditions(initialConditions...))
+}
+
+// ValidateErrWith is the same as PropertyValidator.ValidateErr - except that it accepts validate options
+// (see ValidateOption)
+func (pv *PropertyValidator) ValidateErrWith(value interface{}, options ...ValidateOption) error {
+	return newValidationError(pv.ValidateWith(value, options...))
+}
+
 func (pv *PropertyValidator) validate(value interface{}, vcx *ValidatorContext) {
 	if value == nil && pv.NotNull {
 		vcx.addUnTranslatedViolationForCurrent(msgValueCannotBeNull, CodeValueCannotBeNull)
@@ -286,8 +311,9 @@ func checkNumeric(value interface{}, isInt bool) bool {
 		if f, err := nVal.Float64(); err == nil {
 			ok = !isInt || (math.Trunc(f) == f)
 		}
-	} else {
-		_, ok = value.(int)
+	} else if f, fOk, _ := coerceToFloat(value); fOk {
+		// other Go numeric types (e.g. int64, float32 or named numeric types)...
+		ok = !isInt || (math.Trunc(f) == f)
 	}
 	return ok
 }
@@ -346,7 +372,7 @@ func (pv *PropertyValidator) checkConstraints(vcx *ValidatorContext) {
 					vcx.CeaseFurtherIf(pv.StopOnFirst)
 				}
 				// the message is already translated by the constraint!...
-				vcx.addTranslatedViolationForCurrent(msg, CodePropertyConstraintFail, i, constraint)
+				vcx.addConstraintViolationForCurrent(msg, constraint, CodePropertyConstraintFail, i, constraint)
 			}
 			if !vcx.continueAll || !vcx.continuePty() {
 				return
@@ -41,6 +41,7 @@ func (pv *PropertyValidator) ToV8nTagString(options *V8nTagStringOptions) string
 func (pv *PropertyValidator) v8nBasics(options V8nTagStringOptions) []string {
 	result := []string{v8nTagAndValue(tagTokenType, pv.Type.String())}
 	result = append(result, pv.v8nNotNull()...)
+	result = append(result, pv.v8nLabel()...)
 	result = append(result, pv.v8nOrder()...)
 	result = append(result, pv.v8nMandatory()...)
 	result = append(result, pv.v8nOnly()...)
@@ -55,6 +56,13 @@ func (pv *PropertyValidator) v8nBasics(options V8nTagStringOptions) []string {
 	return result
 }
 
+func (pv *PropertyValidator) v8nLabel() (result []string) {
+	if pv.Label != "" {
+		result = []string{v8nTagAndValue(tagTokenLabel, safeQuotes(pv.Label))}
+	}
+	return
+}
+
 func (pv *PropertyValidator) v8nOrder() (result []string) {
 	if pv.Order != 0 {
 		result = []string{fmt.Sprintf("%s:%d", tagTokenOrder, pv.Order)}
@@ -141,6 +149,9 @@ func (pv *PropertyValidator) v8nConstraints(options V8nTagStringOptions) (result
 }
 
 func v8nConstraintToString(constraint Constraint, options V8nTagStringOptions, noAmp bool) string {
+	if w, ok := constraint.(*WarningOnly); ok && w.Constraint != nil && !w.Info {
+		return ternary(noAmp).string("", "&") + "?" + strings.TrimPrefix(v8nConstraintToString(w.Constraint, options, true), "&")
+	}
 	if cstr, is := v8nIsConditionalConstraint(constraint, options); is {
 		return cstr
 	}
@@ -528,6 +539,7 @@ var abbreviatedConstraintNames = map[string]string{
 	"StringValidCardNumber":           "strvcn",
 	"StringExactLength":               "strxlen",
 	"MultipleOf":                      "xof",
+	"WarningOnly":                     "warn",
 }
 
 var regexOption = cmp.FilterValues(regexCompareFilter, cmp.Comparer(regexComparator))
@@ -88,6 +88,17 @@ func TestPropertyValidator_ToV8nTagString_OnlyWithMessageDoubleQuotes(t *testing
 	require.Equal(t, fmt.Sprintf(`%s:%s, %s, %s:'%s'`, tagTokenType, jsonTypeTokenAny, tagTokenOnly, tagTokenOnlyMsg, `This does not have single quotes but has "doubles"`), str)
 }
 
+func TestPropertyValidator_ToV8nTagString_Label(t *testing.T) {
+	pv := &PropertyValidator{
+		Label: "Postcode",
+	}
+	str := pv.ToV8nTagString(nil)
+	require.Equal(t, fmt.Sprintf(`%s:%s, %s:%s`, tagTokenType, jsonTypeTokenAny, tagTokenLabel, `'Postcode'`), str)
+	pv2, err := NewPropertyValidator(str)
+	require.NoError(t, err)
+	require.Equal(t, "Postcode", pv2.Label)
+}
+
 func TestPropertyValidator_ToV8nTagString_OnlySingleCondition(t *testing.T) {
 	pv := &PropertyValidator{
 		OnlyConditions: []string{"foo"},
@@ -707,3 +718,19 @@ func (c *testV8nBadConstraint) Check(v interface{}, vcx *ValidatorContext) (bool
 func (c *testV8nBadConstraint) GetMessage(tcx I18nContext) string {
 	return ""
 }
+
+func TestPropertyValidator_ToV8nTagString_WithWarningOnly(t *testing.T) {
+	pv := &PropertyValidator{
+		Constraints: Constraints{
+			&WarningOnly{Constraint: &StringMaxLength{Value: 3}},
+		},
+	}
+	str := pv.ToV8nTagString(nil)
+	require.Contains(t, str, "&?StringMaxLength{3}")
+
+	upv := &PropertyValidator{}
+	require.NoError(t, upv.processV8nTagValue("Foo", "foo", str))
+	w, ok := upv.Constraints[0].(*WarningOnly)
+	require.True(t, ok)
+	require.Equal(t, 3, w.Constraint.(*StringMaxLength).Value)
+}
@@ -16,6 +16,22 @@ const (
 	fmtMsgQueryParamType             = "Query param must be of type %[1]s"
 )
 
+// paramKind determines the messages and codes used when converting request param values (i.e. query params,
+// path params or headers) to their expected types
+type paramKind struct {
+	msgMultiNotAllowed  string
+	codeMultiNotAllowed int
+	fmtMsgType          string
+	codeInvalidType     int
+}
+
+var queryParamKind = &paramKind{
+	msgMultiNotAllowed:  msgQueryParamMultiNotAllowed,
+	codeMultiNotAllowed: CodeRequestQueryParamMultiNotAllowed,
+	fmtMsgType:          fmtMsgQueryParamType,
+	codeInvalidType:     CodeRequestQueryParamInvalidType,
+}
+
 // RequestQueryValidate Performs validation on the request query (http.Request.URL.Query) of the supplied http.Request
 //
 // If the validation of the request query fails, false is returned and the returned violations
@@ -23,11 +39,18 @@ const (
 //
 // If the validation is successful, the validated query (as JSON object) is also returned
 func (v *Validator) RequestQueryValidate(req *http.Request, initialConditions ...string) (bool, []*Violation, interface{}) {
-	i18ctx := obtainI18nProvider().ContextFromRequest(req)
+	return v.RequestQueryValidateWith(req, WithConditions(initialConditions...))
+}
+
+// RequestQueryValidateWith is the same as Validator.RequestQueryValidate - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) RequestQueryValidateWith(req *http.Request, options ...ValidateOption) (bool, []*Violation, interface{}) {
+	settings := newValidateSettings(options)
+	i18ctx := settings.i18nContext(v.i18nProvider(), req)
 	if obj, violations := v.queryParamsToObject(req, i18ctx); len(violations) == 0 {
 		vcx := newValidatorContext(obj, v, v.StopOnFirst, i18ctx)
 		vcx.setConditionsFromRequest(req)
-		vcx.setInitialConditions(initialConditions...)
+		vcx.applySettings(settings)
 		v.validateObjectOrArray(vcx, obj, true)
 		return vcx.ok, vcx.violations, obj
 	} else {
@@ -35,14 +58,34 @@ func (v *Validator) RequestQueryValidate(req *http.Request, initialConditions ..
 	}
 }
 
+// RequestQueryValidateErr is the same as Validator.RequestQueryValidate - except that it returns an error
+// (a *ValidationError) if validation fails
+func (v *Validator) RequestQueryValidateErr(req *http.Request, initialConditions ...string) (interface{}, error) {
+	return v.RequestQueryValidateErrWith(req, WithConditions(initialConditions...))
+}
+
+// RequestQueryValidateErrWith is the same as Validator.RequestQueryValidateErr - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) RequestQueryValidateErrWith(req *http.Request, options ...ValidateOption) (interface{}, error) {
+	ok, violations, obj := v.RequestQueryValidateWith(req, options...)
+	return obj, newValidationError(ok, violations)
+}
+
 // RequestQueryValidateInto performs validation on the request query (http.Request.URL.Query) of the supplied http.Request
 // and, if validation successful, attempts to unmarshall the query params into the supplied value
 func (v *Validator) RequestQueryValidateInto(req *http.Request, value interface{}, initialConditions ...string) (bool, []*Violation, interface{}) {
-	i18ctx := obtainI18nProvider().ContextFromRequest(req)
+	return v.RequestQueryValidateIntoWith(req, value, WithConditions(initialConditions...))
+}
+
+// RequestQueryValidateIntoWith is the same as Validator.RequestQueryValidateInto - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) RequestQueryValidateIntoWith(req *http.Request, value interface{}, options ...ValidateOption) (bool, []*Violation, interface{}) {
+	settings := newValidateSettings(options)
+	i18ctx := settings.i18nContext(v.i18nProvider(), req)
 	if obj, violations := v.queryParamsToObject(req, i18ctx); len(violations) == 0 {
 		vcx := newValidatorContext(obj, v, v.StopOnFirst, i18ctx)
 		vcx.setConditionsFromRequest(req)
-		vcx.setInitialConditions(initialConditions...)
+		vcx.applySettings(settings)
 		v.validateObjectOrArray(vcx, obj, true)
 		if !vcx.ok {
 			return vcx.ok, vcx.violations, obj
@@ -61,13 +104,26 @@ func (v *Validator) RequestQueryValidateInto(req *http.Request, value interface{
 	}
 }
 
+// RequestQueryValidateIntoErr is the same as Validator.RequestQueryValidateInto - except that it returns an error
+// (a *ValidationError) if validation fails
+func (v *Validator) RequestQueryValidateIntoErr(req *http.Request, value interface{}, initialConditions ...string) (interface{}, error) {
+	return v.RequestQueryValidateIntoErrWith(req, value, WithConditions(initialConditions...))
+}
+
+// RequestQueryValidateIntoErrWith is the same as Validator.RequestQueryValidateIntoErr - except that it accepts
+// validate options (see ValidateOption)
+func (v *Validator) RequestQueryValidateIntoErrWith(req *http.Request, value interface{}, options ...ValidateOption) (interface{}, error) {
+	ok, violations, obj := v.RequestQueryValidateIntoWith(req, value, options...)
+	return obj, newValidationError(ok, violations)
+}
+
 func (v *Validator) queryParamsToObject(req *http.Request, i18ctx I18nContext) (map[string]interface{}, []*Violation) {
 	result := map[string]interface{}{}
 	tmpVcx := newEmptyValidatorContext(i18ctx)
 	values := req.URL.Query()
 	for k, vs := range values {
 		if pty, ok := v.Properties[k]; ok {
-			if useV, violations := convertQueryParamValues(vs, k, pty, i18ctx); len(violations) == 0 {
+			if useV, violations := convertParamValues(vs, k, pty, queryParamKind, i18ctx); len(violations) == 0 {
 				result[k] = useV
 			} else {
 				tmpVcx.violations = append(tmpVcx.violations, violations...)
@@ -99,7 +155,7 @@ func (v *Validator) queryParamsToObject(req *http.Request, i18ctx I18nContext) (
 	return result, tmpVcx.violations
 }
 
-func convertQueryParamValues(values []string, name string, pty *PropertyValidator, i18ctx I18nContext) (interface{}, []*Violation) {
+func convertParamValues(values []string, name string, pty *PropertyValidator, kind *paramKind, i18ctx I18nContext) (interface{}, []*Violation) {
 	var result interface{} = nil
 	violations := make([]*Violation, 0)
 	switch pty.Type {
@@ -118,7 +174,7 @@ func convertQueryParamValues(values []string, name string, pty *PropertyValidato
 		}
 		arrResult := make([]interface{}, len(values))
 		for i, ev := range values {
-			r, violation := convertQueryParamValue(ev, name, elementType, i18ctx)
+			r, violation := convertParamValue(ev, name, elementType, kind, i18ctx)
 			arrResult[i] = r
 			if violation != nil {
 				violations = append(violations, violation)
@@ -134,11 +190,11 @@ func convertQueryParamValues(values []string, name string, pty *PropertyValidato
 		result = arrResult
 	default:
 		if len(values) > 1 {
-			violation := NewViolation(name, "", defaultMessage(i18ctx, "", msgQueryParamMultiNotAllowed), CodeRequestQueryParamMultiNotAllowed)
+			violation := NewViolation(name, "", defaultMessage(i18ctx, "", kind.msgMultiNotAllowed), kind.codeMultiNotAllowed)
 			violation.BadRequest = true
 			violations = append(violations, violation)
 		} else {
-			r, violation := convertQueryParamValue(values[0], name, pty.Type, i18ctx)
+			r, violation := convertParamValue(values[0], name, pty.Type, kind, i18ctx)
 			result = r
 			if violation != nil {
 				violations = append(violations, violation)
@@ -148,7 +204,7 @@ func convertQueryParamValues(values []string, name string, pty *PropertyValidato
 	return result, violations
 }
 
-func convertQueryParamValue(value string, name string, t JsonType, i18ctx I18nContext) (interface{}, *Violation) {
+func convertParamValue(value string, name string, t JsonType, kind *paramKind, i18ctx I18nContext) (interface{}, *Violation) {
 	var result interface{} = nil
 	var violation *Violation = nil
 	switch t {
@@ -170,7 +226,7 @@ func convertQueryParamValue(value string, name string, t JsonType, i18ctx I18nCo
 		} else if b, err := strconv.ParseBool(value); err == nil {
 			result = b
 		} else {
-			violation = NewViolation(name, "", defaultMessage(i18ctx, "", fmtMsgQueryParamType, "boolean"), CodeRequestQueryParamInvalidType)
+			violation = NewViolation(name, "", defaultMessage(i18ctx, "", kind.fmtMsgType, "boolean"), kind.codeInvalidType)
 			violation.BadRequest = true
 		}
 	case JsonObject:
@@ -180,7 +236,7 @@ func convertQueryParamValue(value string, name string, t JsonType, i18ctx I18nCo
 			if err := json.Unmarshal([]byte(value), &obj); err == nil {
 				result = obj
 			} else {
-				violation = NewViolation(name, "", defaultMessage(i18ctx, "", fmtMsgQueryParamType, "object"), CodeRequestQueryParamInvalidType)
+				violation = NewViolation(name, "", defaultMessage(i18ctx, "", kind.fmtMsgType, "object"), kind.codeInvalidType)
 				violation.BadRequest = true
 			}
 		}
@@ -192,7 +248,7 @@ func convertQueryParamValue(value string, name string, t JsonType, i18ctx I18nCo
 			if err := json.Unmarshal([]byte(value), &arr); err == nil {
 				result = arr
 			} else {
-				violation = NewViolation(name, "", defaultMessage(i18ctx, "", fmtMsgQueryParamType, "array"), CodeRequestQueryParamInvalidType)
+				violation = NewViolation(name, "", defaultMessage(i18ctx, "", kind.fmtMsgType, "array"), kind.codeInvalidType)
 				violation.BadRequest = true
 			}
 		}
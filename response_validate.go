@@ -0,0 +1,216 @@
+package valix
+
+import (
+	"bytes"
+	"log"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// ResponseValidationMode determines what a ResponseValidator does when a response fails validation
+type ResponseValidationMode int
+
+const (
+	// ResponseValidationReport only reports violations (to ResponseValidator.OnViolations) - the response is written unchanged
+	ResponseValidationReport ResponseValidationMode = iota
+	// ResponseValidationLog reports violations and logs them (to ResponseValidator.Logger) - the response is written unchanged
+	ResponseValidationLog
+	// ResponseValidationFail reports violations and replaces the response with a 500 (Internal Server Error) problem details document
+	ResponseValidationFail
+)
+
+const (
+	msgResponseFailedValidation = "Response failed validation"
+)
+
+// ResponseValidator validates outgoing JSON responses against validators (e.g. for contract testing handlers
+// against the documented schema)
+//
+// When validating responses, the direction of read-only and write-only properties is reversed (see OasInfo.ReadOnly
+// and OasInfo.WriteOnly) - so that the same validators used for requests can also be used for responses
+type ResponseValidator struct {
+	// Validators is the map of HTTP status code (key) and the Validator (value) used to validate responses with that status
+	//
+	// A key of zero is the default validator - used for any status code not specifically present
+	Validators map[int]*Validator
+	// Mode determines what happens when a response fails validation (default is ResponseValidationReport)
+	Mode ResponseValidationMode
+	// OnViolations, if set, is called whenever a response fails validation
+	OnViolations func(req *http.Request, status int, violations []*Violation)
+	// Logger is the logger used when Mode is ResponseValidationLog (if nil, the standard logger is used)
+	Logger *log.Logger
+}
+
+// Validate validates the response body for the given status code
+//
+// If there is no validator for the status code, the response is always seen as valid
+func (rv *ResponseValidator) Validate(req *http.Request, status int, body []byte) (bool, []*Violation) {
+	v := rv.validatorFor(status)
+	if v == nil {
+		return true, nil
+	}
+	var tcx I18nContext
+	if req != nil {
+		tcx = v.i18nProvider().ContextFromRequest(req)
+	}
+	decoder := getDefaultDecoderProvider().NewDecoder(bytes.NewReader(body), v.UseNumber)
+	var obj interface{} = reflect.Interface
+	if err := decoder.Decode(&obj); err != nil {
+		vcx := newEmptyValidatorContext(tcx)
+		vcx.AddViolation(newBadRequestViolation(vcx, msgUnableToDecode, CodeUnableToDecode, err))
+		return vcx.ok, vcx.violations
+	}
+	vcx := newValidatorContext(obj, v, v.StopOnFirst, tcx)
+	vcx.response = true
+	v.validateObjectOrArray(vcx, obj, false)
+	return vcx.ok, vcx.violations
+}
+
+// ValidateErr is the same as ResponseValidator.Validate - except that it returns an error (a *ValidationError)
+// if validation fails
+func (rv *ResponseValidator) ValidateErr(req *http.Request, status int, body []byte) error {
+	return newValidationError(rv.Validate(req, status, body))
+}
+
+func (rv *ResponseValidator) validatorFor(status int) *Validator {
+	if v, ok := rv.Validators[status]; ok {
+		return v
+	}
+	return rv.Validators[0]
+}
+
+// Wrap wraps the response writer - buffering the response so that it can be validated
+//
+// Note: ResponseValidatingWriter.Finish must be called once the handler has written the response
+func (rv *ResponseValidator) Wrap(w http.ResponseWriter, req *http.Request) *ResponseValidatingWriter {
+	return &ResponseValidatingWriter{
+		writer:    w,
+		request:   req,
+		validator: rv,
+		status:    http.StatusOK,
+	}
+}
+
+// Handler is middleware that validates the responses of the next handler
+func (rv *ResponseValidator) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		rvw := rv.Wrap(w, req)
+		next.ServeHTTP(rvw, req)
+		_ = rvw.Finish()
+	})
+}
+
+func (rv *ResponseValidator) report(req *http.Request, status int, violations []*Violation) {
+	if rv.OnViolations != nil {
+		rv.OnViolations(req, status, violations)
+	}
+	if rv.Mode == ResponseValidationLog {
+		logger := rv.Logger
+		if logger == nil {
+			logger = log.Default()
+		}
+		for _, v := range violations {
+			logger.Printf("response (status %d) failed validation: property %q, path %q: %s", status, v.Property, v.Path, v.Message)
+		}
+	}
+}
+
+// ResponseValidatingWriter is the http.ResponseWriter returned by ResponseValidator.Wrap
+type ResponseValidatingWriter struct {
+	writer     http.ResponseWriter
+	request    *http.Request
+	validator  *ResponseValidator
+	status     int
+	body       bytes.Buffer
+	finished   bool
+	violations []*Violation
+}
+
+// Header returns the header map of the underlying response writer
+func (w *ResponseValidatingWriter) Header() http.Header {
+	return w.writer.Header()
+}
+
+// WriteHeader records the status code (the status is not written until Finish is called)
+func (w *ResponseValidatingWriter) WriteHeader(statusCode int) {
+	w.status = statusCode
+}
+
+// Write buffers the response body (the body is not written until Finish is called)
+func (w *ResponseValidatingWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+// Violations returns the violations found when the response was validated (only available after Finish has been called)
+func (w *ResponseValidatingWriter) Violations() []*Violation {
+	return w.violations
+}
+
+// Finish validates the buffered response and then writes it to the underlying response writer
+//
+// Only JSON responses (i.e. 'Content-Type' of "application/json", any "+json" suffix or not set) are validated - responses
+// with a status that has no body (i.e. 1xx, 204 and 304) or with an empty body and no 'Content-Type' are not validated
+//
+// If the response fails validation and the mode is ResponseValidationFail, the response is replaced
+// by a 500 (Internal Server Error) problem details document
+func (w *ResponseValidatingWriter) Finish() error {
+	if w.finished {
+		return nil
+	}
+	w.finished = true
+	if w.shouldValidate() {
+		if ok, violations := w.validator.Validate(w.request, w.status, w.body.Bytes()); !ok {
+			w.violations = violations
+			w.validator.report(w.request, w.status, violations)
+			if w.validator.Mode == ResponseValidationFail {
+				return w.writeFailure()
+			}
+		}
+	}
+	w.writer.WriteHeader(w.status)
+	if w.body.Len() == 0 {
+		return nil
+	}
+	_, err := w.writer.Write(w.body.Bytes())
+	return err
+}
+
+func (w *ResponseValidatingWriter) writeFailure() error {
+	var tcx I18nContext
+	if w.request != nil {
+		tcx = w.validator.validatorFor(w.status).i18nProvider().ContextFromRequest(w.request)
+	}
+	problem := getDefaultProblemRenderer().Render(tcx, w.violations)
+	problem.Status = http.StatusInternalServerError
+	problem.Title = obtainI18nContext(tcx).TranslateMessage(msgResponseFailedValidation)
+	if w.request != nil && w.request.URL != nil {
+		problem.Instance = w.request.URL.Path
+	}
+	w.writer.Header().Del("Content-Length")
+	return problem.Write(w.writer)
+}
+
+func (w *ResponseValidatingWriter) shouldValidate() bool {
+	if !statusAllowsBody(w.status) {
+		return false
+	}
+	contentType := w.writer.Header().Get("Content-Type")
+	if contentType == "" && w.body.Len() == 0 {
+		return false
+	}
+	return isJsonContentType(contentType)
+}
+
+func statusAllowsBody(status int) bool {
+	return !((status >= 100 && status < 200) || status == http.StatusNoContent || status == http.StatusNotModified)
+}
+
+func isJsonContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mt, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mt == "application/json" || strings.HasSuffix(mt, "+json"))
+}
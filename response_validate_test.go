@@ -0,0 +1,257 @@
+package valix
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testResponseValidator = &Validator{
+	Properties: Properties{
+		"id": {
+			Type:      JsonString,
+			Mandatory: true,
+			OasInfo:   &OasInfo{ReadOnly: true},
+		},
+		"name": {
+			Type:      JsonString,
+			Mandatory: true,
+		},
+		"password": {
+			Type:      JsonString,
+			Mandatory: true,
+			OasInfo:   &OasInfo{WriteOnly: true},
+		},
+	},
+}
+
+func TestReadOnlyPropertyNotMandatoryInRequest(t *testing.T) {
+	ok, violations := testResponseValidator.Validate(jsonObject(`{"name": "foo", "password": "secret"}`))
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+
+	ok, violations = testResponseValidator.Validate(jsonObject(`{"id": "1", "name": "foo", "password": "secret"}`))
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, msgUnwantedProperty, violations[0].Message)
+	require.Equal(t, CodeUnwantedProperty, violations[0].Codes[0])
+	require.Equal(t, "id", violations[0].Property)
+
+	ok, violations = testResponseValidator.Validate(jsonObject(`{"name": "foo"}`))
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, msgMissingProperty, violations[0].Message)
+	require.Equal(t, "password", violations[0].Property)
+}
+
+func TestResponseValidator_Validate(t *testing.T) {
+	rv := &ResponseValidator{
+		Validators: map[int]*Validator{
+			http.StatusOK: testResponseValidator,
+		},
+	}
+	ok, violations := rv.Validate(nil, http.StatusOK, []byte(`{"id": "1", "name": "foo"}`))
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+
+	ok, violations = rv.Validate(nil, http.StatusOK, []byte(`{"name": "foo"}`))
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, msgMissingProperty, violations[0].Message)
+	require.Equal(t, "id", violations[0].Property)
+
+	ok, violations = rv.Validate(nil, http.StatusOK, []byte(`{"id": "1", "name": "foo", "password": "secret"}`))
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, msgUnwantedProperty, violations[0].Message)
+	require.Equal(t, CodeUnwantedProperty, violations[0].Codes[0])
+	require.Equal(t, "password", violations[0].Property)
+
+	ok, violations = rv.Validate(nil, http.StatusOK, []byte(`not json`))
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, CodeUnableToDecode, violations[0].Codes[0])
+
+	// no validator for status...
+	ok, _ = rv.Validate(nil, http.StatusNotFound, []byte(`not json`))
+	require.True(t, ok)
+	// default validator...
+	rv.Validators[0] = &Validator{DisallowObject: true}
+	ok, _ = rv.Validate(nil, http.StatusNotFound, []byte(`{}`))
+	require.False(t, ok)
+}
+
+func TestResponseValidator_Translates(t *testing.T) {
+	rv := &ResponseValidator{
+		Validators: map[int]*Validator{
+			0: testResponseValidator,
+		},
+	}
+	req, _ := http.NewRequest("GET", "http://example.com/foos/1", nil)
+	req.Header.Set("Accept-Language", "fr")
+	ok, violations := rv.Validate(req, http.StatusOK, []byte(`{"name": "foo"}`))
+	require.False(t, ok)
+	require.Equal(t, "Propriété manquante", violations[0].Message)
+}
+
+func testResponseValidatorHandler(status int, contentType string, body string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if contentType != "" {
+			w.Header().Set("Content-Type", contentType)
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	})
+}
+
+func TestResponseValidator_HandlerReport(t *testing.T) {
+	reported := 0
+	reportedStatus := 0
+	rv := &ResponseValidator{
+		Validators: map[int]*Validator{
+			http.StatusCreated: testResponseValidator,
+		},
+		OnViolations: func(req *http.Request, status int, violations []*Violation) {
+			reported += len(violations)
+			reportedStatus = status
+		},
+	}
+	req, _ := http.NewRequest("POST", "http://example.com/foos", nil)
+	w := httptest.NewRecorder()
+	rv.Handler(testResponseValidatorHandler(http.StatusCreated, "application/json", `{"name":"foo"}`)).ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, `{"name":"foo"}`, w.Body.String())
+	require.Equal(t, 1, reported)
+	require.Equal(t, http.StatusCreated, reportedStatus)
+
+	// non-json not validated...
+	reported = 0
+	w = httptest.NewRecorder()
+	rv.Handler(testResponseValidatorHandler(http.StatusCreated, "text/plain", `not json`)).ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, `not json`, w.Body.String())
+	require.Equal(t, 0, reported)
+
+	// +json suffix validated...
+	w = httptest.NewRecorder()
+	rv.Handler(testResponseValidatorHandler(http.StatusCreated, "application/vnd.foo+json; charset=utf-8", `{}`)).ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, 2, reported)
+}
+
+func TestResponseValidator_HandlerLog(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	rv := &ResponseValidator{
+		Validators: map[int]*Validator{
+			0: testResponseValidator,
+		},
+		Mode:   ResponseValidationLog,
+		Logger: log.New(buffer, "", 0),
+	}
+	req, _ := http.NewRequest("GET", "http://example.com/foos/1", nil)
+	w := httptest.NewRecorder()
+	rv.Handler(testResponseValidatorHandler(http.StatusOK, "", `{"name":"foo"}`)).ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"name":"foo"}`, w.Body.String())
+	require.True(t, strings.Contains(buffer.String(), `response (status 200) failed validation: property "id"`))
+}
+
+func TestResponseValidator_HandlerFail(t *testing.T) {
+	rv := &ResponseValidator{
+		Validators: map[int]*Validator{
+			0: testResponseValidator,
+		},
+		Mode: ResponseValidationFail,
+	}
+	req, _ := http.NewRequest("GET", "http://example.com/foos/1", nil)
+	w := httptest.NewRecorder()
+	rv.Handler(testResponseValidatorHandler(http.StatusOK, "application/json", `{"name":"foo"}`)).ServeHTTP(w, req)
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.Equal(t, ProblemContentType, w.Header().Get("Content-Type"))
+	obj := map[string]interface{}{}
+	err := json.Unmarshal(w.Body.Bytes(), &obj)
+	require.NoError(t, err)
+	require.Equal(t, msgResponseFailedValidation, obj["title"])
+	require.Equal(t, float64(http.StatusInternalServerError), obj["status"])
+	require.Equal(t, "/foos/1", obj["instance"])
+	require.Equal(t, 1, len(obj["errors"].([]interface{})))
+
+	// valid response passes through...
+	w = httptest.NewRecorder()
+	rv.Handler(testResponseValidatorHandler(http.StatusOK, "application/json", `{"id":"1","name":"foo"}`)).ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"id":"1","name":"foo"}`, w.Body.String())
+}
+
+func TestResponseValidatingWriter(t *testing.T) {
+	rv := &ResponseValidator{
+		Validators: map[int]*Validator{
+			0: testResponseValidator,
+		},
+	}
+	req, _ := http.NewRequest("GET", "http://example.com/foos/1", nil)
+	w := httptest.NewRecorder()
+	rvw := rv.Wrap(w, req)
+	rvw.Header().Set("X-Foo", "bar")
+	_, _ = rvw.Write([]byte(`{"name":"foo","password":"secret"}`))
+	require.Equal(t, 0, w.Body.Len())
+	require.Nil(t, rvw.Violations())
+	err := rvw.Finish()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "bar", w.Header().Get("X-Foo"))
+	require.Equal(t, 2, len(rvw.Violations()))
+	// finishing again does nothing...
+	err = rvw.Finish()
+	require.NoError(t, err)
+	require.Equal(t, `{"name":"foo","password":"secret"}`, w.Body.String())
+}
+
+func TestResponseValidatingWriter_SkipsEmptyResponses(t *testing.T) {
+	rv := &ResponseValidator{
+		Validators: map[int]*Validator{
+			0: testResponseValidator,
+		},
+		Mode: ResponseValidationFail,
+	}
+	req, _ := http.NewRequest("GET", "http://example.com/foos/1", nil)
+	testCases := []struct {
+		status      int
+		contentType string
+		body        string
+	}{
+		{http.StatusOK, "", ""},
+		{http.StatusNoContent, "", ""},
+		{http.StatusNotModified, "", ""},
+		{http.StatusNotModified, "application/json", ""},
+		{http.StatusContinue, "application/json", ""},
+	}
+	for _, tc := range testCases {
+		w := httptest.NewRecorder()
+		rvw := rv.Wrap(w, req)
+		if tc.contentType != "" {
+			rvw.Header().Set("Content-Type", tc.contentType)
+		}
+		rvw.WriteHeader(tc.status)
+		_, _ = rvw.Write([]byte(tc.body))
+		err := rvw.Finish()
+		require.NoError(t, err)
+		require.Equal(t, tc.status, w.Code)
+		require.Nil(t, rvw.Violations())
+	}
+
+	// empty body with JSON content type is still validated...
+	w := httptest.NewRecorder()
+	rvw := rv.Wrap(w, req)
+	rvw.Header().Set("Content-Type", "application/json")
+	err := rvw.Finish()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.Equal(t, 1, len(rvw.Violations()))
+}
@@ -0,0 +1,540 @@
+package valix
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	msgSchemaOneOfMultiple = "Value must match only one of the expected schemas"
+)
+
+const (
+	msgSchemaPrefix             = "schema "
+	msgSchemaUnsupportedKeyword = msgSchemaPrefix + "'%s' - unsupported keyword '%s'"
+	msgSchemaInvalidKeyword     = msgSchemaPrefix + "'%s' - invalid value for keyword '%s'"
+	msgSchemaUnresolvedRef      = msgSchemaPrefix + "'%s' - unable to resolve $ref '%s'"
+	msgSchemaRecursiveRef       = msgSchemaPrefix + "'%s' - unsupported recursive $ref '%s'"
+	msgSchemaNotObject          = msgSchemaPrefix + "'%s' - must be an object (or array of objects) schema"
+	msgSchemaErrors             = "unable to build validator from schema: %s"
+)
+
+// ValidatorFromJSONSchema builds a Validator from a JSON Schema document (as unmarshalled JSON)
+//
+// The schema must describe a JSON object (or an array of objects).  The keywords `type`, `required`, `properties`,
+// `additionalProperties` (true/false only), `items`, `enum`, `const`, `format`, `pattern`, `minLength`, `maxLength`,
+// `minimum`, `maximum`, `exclusiveMinimum`, `exclusiveMaximum`, `multipleOf`, `minItems`, `maxItems`, `uniqueItems`,
+// `minProperties`, `maxProperties`, `oneOf`, `anyOf`, `allOf`, `$ref` and `nullable` are mapped onto validators and
+// the built-in constraints - annotations (e.g. `title`, `description`) are mapped onto OasInfo.  Any other keyword
+// is reported as an error
+func ValidatorFromJSONSchema(doc map[string]interface{}) (*Validator, error) {
+	return newSchemaImporter(doc).build("#", doc)
+}
+
+// ValidatorFromOpenAPI builds a Validator from the schema in an OpenAPI document (as unmarshalled JSON) at
+// the given ref - e.g. "#/components/schemas/Order"
+//
+// (see ValidatorFromJSONSchema for details of supported keywords)
+func ValidatorFromOpenAPI(doc map[string]interface{}, ref string) (*Validator, error) {
+	si := newSchemaImporter(doc)
+	schema, ok := si.resolve(ref)
+	if !ok {
+		return nil, fmt.Errorf(msgSchemaErrors, fmt.Sprintf(msgSchemaUnresolvedRef, "#", ref))
+	}
+	return si.build(ref, schema)
+}
+
+var schemaAnnotationKeywords = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "$defs": true, "definitions": true, "$anchor": true,
+	"title": true, "description": true, "example": true, "examples": true, "default": true,
+	"deprecated": true, "readOnly": true, "writeOnly": true, "nullable": true, "externalDocs": true,
+	"discriminator": true, "xml": true, "contentMediaType": true, "contentEncoding": true,
+}
+
+type schemaImporter struct {
+	root       map[string]interface{}
+	validators map[string]*Validator
+	resolving  map[string]bool
+	errors     []string
+}
+
+func newSchemaImporter(root map[string]interface{}) *schemaImporter {
+	return &schemaImporter{
+		root:       root,
+		validators: map[string]*Validator{},
+		resolving:  map[string]bool{},
+		errors:     make([]string, 0),
+	}
+}
+
+func (si *schemaImporter) build(at string, schema map[string]interface{}) (*Validator, error) {
+	pv := si.propertyValidator(at, schema)
+	var result *Validator
+	if pv.ObjectValidator != nil && (pv.Type == JsonObject || pv.Type == JsonArray) {
+		result = pv.ObjectValidator
+	} else {
+		si.addError(msgSchemaNotObject, at)
+	}
+	if len(si.errors) > 0 {
+		return nil, fmt.Errorf(msgSchemaErrors, strings.Join(si.errors, "; "))
+	}
+	return result, nil
+}
+
+func (si *schemaImporter) addError(format string, args ...interface{}) {
+	si.errors = append(si.errors, fmt.Sprintf(format, args...))
+}
+
+func (si *schemaImporter) resolve(ref string) (map[string]interface{}, bool) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, false
+	}
+	var current interface{} = si.root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#"), "/")[1:] {
+		if ut, err := url.PathUnescape(token); err == nil {
+			token = ut
+		}
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[token]; !ok {
+			return nil, false
+		}
+	}
+	result, ok := current.(map[string]interface{})
+	return result, ok
+}
+
+// objectValidator builds (or returns the already built) validator for an object schema - validators for
+// refs are shared (which also allows for recursive schemas)
+func (si *schemaImporter) objectValidator(at string, ref string, schema map[string]interface{}) *Validator {
+	if ref != "" {
+		if v, ok := si.validators[ref]; ok {
+			return v
+		}
+	}
+	result := &Validator{IgnoreUnknownProperties: true}
+	if ref != "" {
+		si.validators[ref] = result
+	}
+	required := map[string]bool{}
+	if rv, ok := schema["required"]; ok {
+		if ra, ok := rv.([]interface{}); ok {
+			for _, r := range ra {
+				if rs, ok := r.(string); ok {
+					required[rs] = true
+				} else {
+					si.addError(msgSchemaInvalidKeyword, at, "required")
+				}
+			}
+		} else {
+			si.addError(msgSchemaInvalidKeyword, at, "required")
+		}
+	}
+	result.Properties = Properties{}
+	if pv, ok := schema["properties"]; ok {
+		if pm, ok := pv.(map[string]interface{}); ok {
+			for pn, ps := range pm {
+				if psm, ok := ps.(map[string]interface{}); ok {
+					pty := si.propertyValidator(at+"/properties/"+pn, psm)
+					pty.Mandatory = required[pn]
+					result.Properties[pn] = pty
+				} else {
+					si.addError(msgSchemaInvalidKeyword, at+"/properties/"+pn, "properties")
+				}
+			}
+		} else {
+			si.addError(msgSchemaInvalidKeyword, at, "properties")
+		}
+	}
+	for rn := range required {
+		if _, ok := result.Properties[rn]; !ok {
+			result.Properties[rn] = &PropertyValidator{Type: JsonAny, Mandatory: true}
+		}
+	}
+	if ap, ok := schema["additionalProperties"]; ok {
+		if apb, ok := ap.(bool); ok {
+			result.IgnoreUnknownProperties = apb
+		} else {
+			si.addError(msgSchemaUnsupportedKeyword, at, "additionalProperties")
+		}
+	}
+	return result
+}
+
+func (si *schemaImporter) propertyValidator(at string, schema map[string]interface{}) *PropertyValidator {
+	ref := ""
+	if rv, ok := schema["$ref"]; ok {
+		if rs, ok := rv.(string); ok {
+			if v, ok := si.validators[rs]; ok && len(schema) == 1 {
+				return &PropertyValidator{Type: JsonObject, NotNull: true, ObjectValidator: v}
+			} else if si.resolving[rs] {
+				si.addError(msgSchemaRecursiveRef, at, rs)
+				return &PropertyValidator{}
+			} else if resolved, ok := si.resolve(rs); ok {
+				if len(schema) == 1 {
+					// only cache refs without sibling keywords...
+					ref = rs
+				}
+				si.resolving[rs] = true
+				defer delete(si.resolving, rs)
+				at = rs
+				schema = mergeRefSiblings(resolved, schema)
+			} else {
+				si.addError(msgSchemaUnresolvedRef, at, rs)
+				return &PropertyValidator{}
+			}
+		} else {
+			si.addError(msgSchemaInvalidKeyword, at, "$ref")
+			return &PropertyValidator{}
+		}
+	}
+	result := &PropertyValidator{Type: JsonAny}
+	nullable := si.addSchemaType(at, schema, result)
+	if nb, ok := schema["nullable"].(bool); ok && nb {
+		nullable = true
+	}
+	result.NotNull = !nullable
+	if result.Type == JsonObject {
+		result.ObjectValidator = si.objectValidator(at, ref, schema)
+	}
+	constraints := Constraints{}
+	keys := make([]string, 0, len(schema))
+	for k := range schema {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		kv := schema[k]
+		switch k {
+		case "type", "$ref", "properties", "required", "additionalProperties":
+			// already dealt with
+		case "items":
+			si.addItems(at, kv, result, &constraints)
+		case "enum", "const":
+			values, ok := kv.([]interface{})
+			if k == "const" {
+				values, ok = []interface{}{kv}, true
+			}
+			if tokens, allStr := schemaStrings(values); ok && allStr {
+				constraints = append(constraints, &StringValidToken{Tokens: tokens})
+			} else {
+				si.addError(msgSchemaUnsupportedKeyword, at, k)
+			}
+		case "pattern":
+			if ps, ok := kv.(string); ok {
+				if rx, err := regexp.Compile(ps); err == nil {
+					constraints = append(constraints, &StringPattern{Regexp: *rx})
+				} else {
+					si.addError(msgSchemaInvalidKeyword, at, k)
+				}
+			} else {
+				si.addError(msgSchemaInvalidKeyword, at, k)
+			}
+		case "format":
+			if fs, ok := kv.(string); ok {
+				if c := schemaFormatConstraint(fs); c != nil {
+					constraints = append(constraints, c)
+				}
+				ensureOasInfo(result).Format = fs
+			} else {
+				si.addError(msgSchemaInvalidKeyword, at, k)
+			}
+		case "minLength":
+			if i, ok := schemaInt(kv); ok {
+				constraints = append(constraints, &StringMinLength{Value: int(i), UseRuneLen: true})
+			} else {
+				si.addError(msgSchemaInvalidKeyword, at, k)
+			}
+		case "maxLength":
+			if i, ok := schemaInt(kv); ok {
+				constraints = append(constraints, &StringMaxLength{Value: int(i), UseRuneLen: true})
+			} else {
+				si.addError(msgSchemaInvalidKeyword, at, k)
+			}
+		case "minItems", "minProperties", "maxItems", "maxProperties":
+			if i, ok := schemaInt(kv); ok {
+				if strings.HasPrefix(k, "min") {
+					constraints = append(constraints, &Length{Minimum: int(i)})
+				} else {
+					constraints = append(constraints, &Length{Maximum: int(i)})
+				}
+			} else {
+				si.addError(msgSchemaInvalidKeyword, at, k)
+			}
+		case "uniqueItems":
+			if b, ok := kv.(bool); ok {
+				if b {
+					constraints = append(constraints, &ArrayUnique{})
+				}
+			} else {
+				si.addError(msgSchemaInvalidKeyword, at, k)
+			}
+		case "minimum", "exclusiveMinimum":
+			if f, ok, _ := coerceToFloat(kv); ok {
+				exc := k == "exclusiveMinimum"
+				if !exc {
+					exc, _ = schema["exclusiveMinimum"].(bool)
+				}
+				constraints = append(constraints, &Minimum{Value: f, ExclusiveMin: exc})
+			} else if _, isBool := kv.(bool); !isBool || k != "exclusiveMinimum" {
+				si.addError(msgSchemaInvalidKeyword, at, k)
+			}
+		case "maximum", "exclusiveMaximum":
+			if f, ok, _ := coerceToFloat(kv); ok {
+				exc := k == "exclusiveMaximum"
+				if !exc {
+					exc, _ = schema["exclusiveMaximum"].(bool)
+				}
+				constraints = append(constraints, &Maximum{Value: f, ExclusiveMax: exc})
+			} else if _, isBool := kv.(bool); !isBool || k != "exclusiveMaximum" {
+				si.addError(msgSchemaInvalidKeyword, at, k)
+			}
+		case "multipleOf":
+			if i, ok := schemaInt(kv); ok && i > 0 {
+				constraints = append(constraints, &MultipleOf{Value: i})
+			} else {
+				si.addError(msgSchemaUnsupportedKeyword, at, k)
+			}
+		case "oneOf", "anyOf", "allOf":
+			if branches, ok := kv.([]interface{}); ok && len(branches) > 0 {
+				c := &schemaComposition{kind: k, branches: make([]*PropertyValidator, 0, len(branches))}
+				for i, b := range branches {
+					if bm, ok := b.(map[string]interface{}); ok {
+						c.branches = append(c.branches, si.propertyValidator(fmt.Sprintf("%s/%s/%d", at, k, i), bm))
+					} else {
+						si.addError(msgSchemaInvalidKeyword, at, k)
+					}
+				}
+				constraints = append(constraints, c)
+			} else {
+				si.addError(msgSchemaInvalidKeyword, at, k)
+			}
+		case "title":
+			ensureOasInfo(result).Title, _ = kv.(string)
+		case "description":
+			ensureOasInfo(result).Description, _ = kv.(string)
+		case "example":
+			if s, ok := kv.(string); ok {
+				ensureOasInfo(result).Example = s
+			}
+		case "deprecated":
+			ensureOasInfo(result).Deprecated, _ = kv.(bool)
+		case "readOnly":
+			ensureOasInfo(result).ReadOnly, _ = kv.(bool)
+		case "writeOnly":
+			ensureOasInfo(result).WriteOnly, _ = kv.(bool)
+		default:
+			if !schemaAnnotationKeywords[k] && !strings.HasPrefix(k, "x-") {
+				si.addError(msgSchemaUnsupportedKeyword, at, k)
+			}
+		}
+	}
+	if len(constraints) > 0 {
+		if result.Type == JsonObject {
+			result.ObjectValidator.Constraints = append(result.ObjectValidator.Constraints, constraints...)
+		} else {
+			result.Constraints = constraints
+		}
+	}
+	return result
+}
+
+// addSchemaType sets the property type from the schema `type` keyword - returning whether the type allows null
+func (si *schemaImporter) addSchemaType(at string, schema map[string]interface{}, pv *PropertyValidator) bool {
+	types := make([]string, 0)
+	switch tv := schema["type"].(type) {
+	case nil:
+		if _, ok := schema["properties"]; ok {
+			types = append(types, jsonTypeTokenObject)
+		} else {
+			// no type - any (including null)
+			return true
+		}
+	case string:
+		types = append(types, tv)
+	case []interface{}:
+		ts, ok := schemaStrings(tv)
+		if !ok {
+			si.addError(msgSchemaInvalidKeyword, at, "type")
+		}
+		types = append(types, ts...)
+	default:
+		si.addError(msgSchemaInvalidKeyword, at, "type")
+	}
+	nullable := false
+	nonNull := make([]string, 0, len(types))
+	for _, t := range types {
+		if t == "null" {
+			nullable = true
+		} else {
+			nonNull = append(nonNull, t)
+		}
+	}
+	switch len(nonNull) {
+	case 0:
+		if nullable {
+			pv.Constraints = append(pv.Constraints, &IsNull{})
+		}
+	case 1:
+		if jt, ok := JsonTypeFromString(nonNull[0]); ok && nonNull[0] != jsonTypeTokenDatetime && nonNull[0] != jsonTypeTokenAny {
+			pv.Type = jt
+		} else {
+			si.addError(msgSchemaInvalidKeyword, at, "type")
+		}
+	default:
+		si.addError(msgSchemaUnsupportedKeyword, at, "type")
+	}
+	return nullable
+}
+
+func (si *schemaImporter) addItems(at string, items interface{}, pv *PropertyValidator, constraints *Constraints) {
+	im, ok := items.(map[string]interface{})
+	if !ok {
+		si.addError(msgSchemaUnsupportedKeyword, at, "items")
+		return
+	}
+	ipv := si.propertyValidator(at+"/items", im)
+	if ipv.Type == JsonObject && ipv.ObjectValidator != nil {
+		v := ipv.ObjectValidator
+		// copy so that the (possibly shared) object validator is not altered...
+		cv := *v
+		cv.AllowArray = true
+		cv.DisallowObject = true
+		cv.AllowNullItems = !ipv.NotNull
+		pv.ObjectValidator = &cv
+	} else {
+		*constraints = append(*constraints, &ArrayOf{
+			Type:             ipv.Type.String(),
+			AllowNullElement: !ipv.NotNull,
+			Constraints:      ipv.Constraints,
+		})
+	}
+}
+
+// schemaComposition is the constraint used for the JSON Schema `oneOf`, `anyOf` and `allOf` keywords - each
+// branch is checked by validating the value against the branch property validator
+type schemaComposition struct {
+	kind     string
+	branches []*PropertyValidator
+}
+
+// Check implements Constraint.Check
+func (c *schemaComposition) Check(v interface{}, vcx *ValidatorContext) (bool, string) {
+	matched := 0
+	firstMsg := ""
+	for _, b := range c.branches {
+		if ok, msg := checkSchemaBranch(b, v, vcx); ok {
+			matched++
+		} else if c.kind == "allOf" {
+			return false, msg
+		} else if firstMsg == "" {
+			firstMsg = msg
+		}
+	}
+	if c.kind == "oneOf" && matched > 1 {
+		return false, c.GetMessage(vcx)
+	} else if c.kind != "allOf" && matched == 0 {
+		return false, firstMsg
+	}
+	return true, ""
+}
+
+// GetMessage implements the Constraint.GetMessage
+func (c *schemaComposition) GetMessage(tcx I18nContext) string {
+	if c.kind == "oneOf" {
+		return obtainI18nContext(tcx).TranslateMessage(msgSchemaOneOfMultiple)
+	}
+	return ""
+}
+
+// MarshalJSON marshals the composition (so that validators built from schemas can still be marshalled)
+func (c *schemaComposition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"Kind":     c.kind,
+		"Branches": c.branches,
+	})
+}
+
+func checkSchemaBranch(pv *PropertyValidator, v interface{}, vcx *ValidatorContext) (bool, string) {
+	const valueProperty = "value"
+	obj := map[string]interface{}{valueProperty: v}
+	sv := &Validator{IgnoreUnknownProperties: true, Properties: Properties{valueProperty: pv}}
+	svcx := newValidatorContext(obj, sv, true, vcx.i18nContext)
+	sv.validate(obj, svcx)
+	if svcx.ok {
+		return true, ""
+	}
+	return false, svcx.violations[0].Message
+}
+
+func schemaFormatConstraint(format string) Constraint {
+	switch format {
+	case "date-time":
+		return &StringValidISODatetime{}
+	case "date":
+		return &StringValidISODate{}
+	case "duration":
+		return &StringValidISODuration{}
+	case "email":
+		return &StringValidEmail{}
+	case "uuid":
+		return &StringValidUuid{}
+	case "uri":
+		return &NetIsURI{}
+	case "hostname":
+		return &NetIsHostname{}
+	case "ipv4":
+		return &NetIsIP{V4Only: true}
+	case "ipv6":
+		return &NetIsIP{V6Only: true}
+	}
+	// other formats are only annotations...
+	return nil
+}
+
+func mergeRefSiblings(resolved map[string]interface{}, schema map[string]interface{}) map[string]interface{} {
+	if len(schema) == 1 {
+		return resolved
+	}
+	result := make(map[string]interface{}, len(resolved)+len(schema))
+	for k, v := range resolved {
+		result[k] = v
+	}
+	for k, v := range schema {
+		if k != "$ref" {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+func ensureOasInfo(pv *PropertyValidator) *OasInfo {
+	if pv.OasInfo == nil {
+		pv.OasInfo = &OasInfo{}
+	}
+	return pv.OasInfo
+}
+
+func schemaInt(v interface{}) (int64, bool) {
+	i, ok, _ := coerceToInt(v)
+	return i, ok && i >= 0
+}
+
+func schemaStrings(values []interface{}) ([]string, bool) {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			return result, false
+		}
+		result = append(result, s)
+	}
+	return result, true
+}
@@ -0,0 +1,196 @@
+package valix
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorFromJSONSchema(t *testing.T) {
+	doc := jsonObject(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title": "Person",
+		"type": "object",
+		"required": ["name", "id"],
+		"additionalProperties": false,
+		"properties": {
+			"name": {"type": "string", "minLength": 1, "maxLength": 10, "description": "The name"},
+			"age": {"type": ["integer", "null"], "minimum": 0, "exclusiveMaximum": 150},
+			"status": {"type": "string", "enum": ["active", "inactive"]},
+			"ref": {"type": "string", "format": "uuid"},
+			"code": {"type": "string", "pattern": "^[A-Z]+$", "x-other": true},
+			"tags": {"type": "array", "items": {"type": "string", "minLength": 1}, "uniqueItems": true, "minItems": 1},
+			"address": {"$ref": "#/$defs/Address"},
+			"addresses": {"type": "array", "items": {"$ref": "#/$defs/Address"}}
+		},
+		"$defs": {
+			"Address": {
+				"type": "object",
+				"required": ["street"],
+				"properties": {
+					"street": {"type": "string"}
+				}
+			}
+		}
+	}`)
+	v, err := ValidatorFromJSONSchema(doc)
+	require.NoError(t, err)
+	require.False(t, v.IgnoreUnknownProperties)
+	require.Equal(t, 9, len(v.Properties))
+	require.True(t, v.Properties["name"].Mandatory)
+	require.Equal(t, JsonString, v.Properties["name"].Type)
+	require.True(t, v.Properties["name"].NotNull)
+	require.Equal(t, "The name", v.Properties["name"].OasInfo.Description)
+	require.True(t, v.Properties["id"].Mandatory)
+	require.Equal(t, JsonAny, v.Properties["id"].Type)
+	require.Equal(t, JsonInteger, v.Properties["age"].Type)
+	require.False(t, v.Properties["age"].NotNull)
+	require.Equal(t, "uuid", v.Properties["ref"].OasInfo.Format)
+	require.Equal(t, JsonObject, v.Properties["address"].Type)
+	require.True(t, v.Properties["address"].ObjectValidator.IgnoreUnknownProperties)
+	require.True(t, v.Properties["addresses"].ObjectValidator.AllowArray)
+	require.True(t, v.Properties["addresses"].ObjectValidator.DisallowObject)
+	// shared ref validator not altered...
+	require.False(t, v.Properties["address"].ObjectValidator.AllowArray)
+
+	ok, violations := v.Validate(jsonObject(`{
+		"id": 1,
+		"name": "Bilbo",
+		"age": null,
+		"status": "active",
+		"ref": "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"code": "ABC",
+		"tags": ["a", "b"],
+		"address": {"street": "Bagshot Row", "other": true},
+		"addresses": [{"street": "Bagshot Row"}]
+	}`))
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+
+	ok, violations = v.Validate(jsonObject(`{
+		"name": "",
+		"age": 150,
+		"status": "unknown",
+		"code": "abc",
+		"ref": "x",
+		"tags": ["a", "a", ""],
+		"address": {},
+		"addresses": [{}],
+		"unknown": true
+	}`))
+	require.False(t, ok)
+	SortViolationsByPathAndProperty(violations)
+	props := make([]string, len(violations))
+	for i, vi := range violations {
+		props[i] = vi.Path + ":" + vi.Property
+	}
+	require.Equal(t, []string{
+		":age", ":code", ":id", ":name", ":ref", ":status", ":tags", ":unknown",
+		"address:street", "addresses[0]:street", "tags:[2]",
+	}, props)
+}
+
+func TestValidatorFromJSONSchemaCompositions(t *testing.T) {
+	doc := jsonObject(`{
+		"type": "object",
+		"properties": {
+			"one": {"oneOf": [{"type": "string"}, {"type": "integer", "minimum": 0}, {"type": "number", "maximum": 10}]},
+			"any": {"anyOf": [{"type": "string"}, {"type": "boolean"}]},
+			"all": {"allOf": [{"type": "string", "minLength": 2}, {"maxLength": 3}]}
+		}
+	}`)
+	v, err := ValidatorFromJSONSchema(doc)
+	require.NoError(t, err)
+	ok, _ := v.Validate(jsonObject(`{"one": "a", "any": true, "all": "ab"}`))
+	require.True(t, ok)
+	ok, _ = v.Validate(jsonObject(`{"one": 20}`))
+	require.True(t, ok)
+	ok, violations := v.Validate(jsonObject(`{"one": 5}`))
+	require.False(t, ok)
+	require.Equal(t, msgSchemaOneOfMultiple, violations[0].Message)
+	ok, violations = v.Validate(jsonObject(`{"any": 1}`))
+	require.False(t, ok)
+	require.Equal(t, "Value expected to be of type string", violations[0].Message)
+	ok, _ = v.Validate(jsonObject(`{"all": "abcd"}`))
+	require.False(t, ok)
+	ok, _ = v.Validate(jsonObject(`{"all": "a"}`))
+	require.False(t, ok)
+
+	// can still be marshalled...
+	_, err = json.Marshal(v)
+	require.NoError(t, err)
+}
+
+func TestValidatorFromJSONSchemaErrors(t *testing.T) {
+	_, err := ValidatorFromJSONSchema(jsonObject(`{"type": "string"}`))
+	require.Error(t, err)
+	require.Equal(t, "unable to build validator from schema: schema '#' - must be an object (or array of objects) schema", err.Error())
+
+	_, err = ValidatorFromJSONSchema(jsonObject(`{
+		"type": "object",
+		"properties": {
+			"foo": {"type": "string", "contains": {}},
+			"bar": {"$ref": "#/$defs/Missing"},
+			"baz": {"type": ["string", "integer"]},
+			"qux": {"type": "object", "additionalProperties": {"type": "string"}},
+			"quux": {"enum": [1, 2]}
+		}
+	}`))
+	require.Error(t, err)
+	msg := err.Error()
+	require.Contains(t, msg, "schema '#/properties/foo' - unsupported keyword 'contains'")
+	require.Contains(t, msg, "schema '#/properties/bar' - unable to resolve $ref '#/$defs/Missing'")
+	require.Contains(t, msg, "schema '#/properties/baz' - unsupported keyword 'type'")
+	require.Contains(t, msg, "schema '#/properties/qux' - unsupported keyword 'additionalProperties'")
+	require.Contains(t, msg, "schema '#/properties/quux' - unsupported keyword 'enum'")
+}
+
+func TestValidatorFromOpenAPI(t *testing.T) {
+	doc := jsonObject(`{
+		"openapi": "3.0.3",
+		"components": {
+			"schemas": {
+				"Order": {
+					"type": "object",
+					"required": ["id"],
+					"properties": {
+						"id": {"type": "string", "format": "uuid"},
+						"created": {"type": "string", "format": "date-time", "readOnly": true},
+						"note": {"type": "string", "nullable": true},
+						"quantity": {"type": "integer", "minimum": 1, "exclusiveMinimum": true, "multipleOf": 2},
+						"parent": {"$ref": "#/components/schemas/Order"}
+					}
+				},
+				"Orders": {
+					"type": "array",
+					"items": {"$ref": "#/components/schemas/Order"}
+				}
+			}
+		}
+	}`)
+	v, err := ValidatorFromOpenAPI(doc, "#/components/schemas/Order")
+	require.NoError(t, err)
+	require.True(t, v.Properties["created"].OasInfo.ReadOnly)
+	require.False(t, v.Properties["note"].NotNull)
+	require.True(t, v.Properties["parent"].ObjectValidator.Properties["parent"].ObjectValidator == v.Properties["parent"].ObjectValidator)
+
+	ok, _ := v.Validate(jsonObject(`{"id": "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "note": null, "quantity": 4, "parent": {"id": "6ba7b810-9dad-11d1-80b4-00c04fd430c8"}}`))
+	require.True(t, ok)
+	ok, violations := v.Validate(jsonObject(`{"id": "not a uuid", "quantity": 1, "parent": {"id": "6ba7b810-9dad-11d1-80b4-00c04fd430c8"}}`))
+	require.False(t, ok)
+	require.Equal(t, 3, len(violations))
+	// read-only property not allowed in request...
+	ok, violations = v.Validate(jsonObject(`{"id": "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "created": "2022-01-01T00:00:00Z"}`))
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "created", violations[0].Property)
+
+	v, err = ValidatorFromOpenAPI(doc, "#/components/schemas/Orders")
+	require.NoError(t, err)
+	require.True(t, v.AllowArray)
+	require.True(t, v.DisallowObject)
+
+	_, err = ValidatorFromOpenAPI(doc, "#/components/schemas/Unknown")
+	require.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package valix
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Severity is the severity of a Violation (see Violation.Severity)
+//
+// Only violations with SeverityError cause validation to fail - violations with SeverityWarning or SeverityInfo
+// are reported as warnings (see WithWarnings), separately from the returned violations, and do not affect the
+// validation ok result
+type Severity int
+
+const (
+	// SeverityError is the severity of violations that cause validation to fail (the default)
+	SeverityError Severity = iota
+	// SeverityWarning is the severity of violations that are reported as warnings (e.g. use of deprecated properties
+	// or failing WarningOnly constraints) - and do not cause validation to fail
+	SeverityWarning
+	// SeverityInfo is the severity of violations that are reported as information only - and do not cause validation to fail
+	SeverityInfo
+)
+
+const (
+	severityTokenError   = "error"
+	severityTokenWarning = "warning"
+	severityTokenInfo    = "info"
+)
+
+// String returns the token for the severity (i.e. "error", "warning" or "info")
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return severityTokenWarning
+	case SeverityInfo:
+		return severityTokenInfo
+	}
+	return severityTokenError
+}
+
+// SeverityFromString returns the Severity for the token (case-insensitive)
+func SeverityFromString(str string) (Severity, bool) {
+	switch strings.ToLower(str) {
+	case severityTokenError:
+		return SeverityError, true
+	case severityTokenWarning:
+		return SeverityWarning, true
+	case severityTokenInfo:
+		return SeverityInfo, true
+	}
+	return SeverityError, false
+}
+
+func (s Severity) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + s.String() + `"`), nil
+}
+
+func (s *Severity) UnmarshalJSON(data []byte) error {
+	str := string(data[:])
+	if strings.HasPrefix(str, `"`) && strings.HasSuffix(str, `"`) {
+		if v, ok := SeverityFromString(str[1 : len(str)-1]); ok {
+			*s = v
+			return nil
+		}
+	} else if i, err := strconv.ParseInt(str, 10, 32); err == nil {
+		sv := Severity(i)
+		if sv >= SeverityError && sv <= SeverityInfo {
+			*s = sv
+			return nil
+		}
+	}
+	return fmt.Errorf("value for Severity expected string (%s,%s,%s) or integer (%d to %d)",
+		severityTokenError, severityTokenWarning, severityTokenInfo, SeverityError, SeverityInfo)
+}
+
+// IsError returns whether the violation is an error (i.e. has SeverityError and therefore caused validation to fail)
+func (v *Violation) IsError() bool {
+	return v.Severity == SeverityError
+}
+
+// SplitViolations splits violations into errors (violations with SeverityError) and
+// warnings (violations with SeverityWarning or SeverityInfo)
+func SplitViolations(violations []*Violation) (errors []*Violation, warnings []*Violation) {
+	errors = make([]*Violation, 0, len(violations))
+	warnings = make([]*Violation, 0)
+	for _, v := range violations {
+		if v.IsError() {
+			errors = append(errors, v)
+		} else {
+			warnings = append(warnings, v)
+		}
+	}
+	return
+}
+
+// hasErrorViolations returns whether any of the violations is an error (i.e. has SeverityError)
+func hasErrorViolations(violations []*Violation) bool {
+	for _, v := range violations {
+		if v.IsError() {
+			return true
+		}
+	}
+	return false
+}
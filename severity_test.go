@@ -0,0 +1,131 @@
+package valix
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeverity_StringAndFromString(t *testing.T) {
+	for _, s := range []Severity{SeverityError, SeverityWarning, SeverityInfo} {
+		sv, ok := SeverityFromString(s.String())
+		require.True(t, ok)
+		require.Equal(t, s, sv)
+	}
+	sv, ok := SeverityFromString("WARNING")
+	require.True(t, ok)
+	require.Equal(t, SeverityWarning, sv)
+	_, ok = SeverityFromString("unknown")
+	require.False(t, ok)
+}
+
+func TestSeverity_MarshalUnmarshalJSON(t *testing.T) {
+	data, err := json.Marshal(SeverityInfo)
+	require.NoError(t, err)
+	require.Equal(t, `"info"`, string(data))
+
+	var s Severity
+	require.NoError(t, json.Unmarshal([]byte(`"warning"`), &s))
+	require.Equal(t, SeverityWarning, s)
+	require.NoError(t, json.Unmarshal([]byte(`2`), &s))
+	require.Equal(t, SeverityInfo, s)
+	err = json.Unmarshal([]byte(`"xxx"`), &s)
+	require.Error(t, err)
+	require.Equal(t, "value for Severity expected string (error,warning,info) or integer (0 to 2)", err.Error())
+	require.Error(t, json.Unmarshal([]byte(`3`), &s))
+}
+
+func TestSplitViolations(t *testing.T) {
+	violations := []*Violation{
+		NewEmptyViolation("a"),
+		{Message: "b", Severity: SeverityWarning},
+		{Message: "c", Severity: SeverityInfo},
+		NewEmptyViolation("d"),
+	}
+	errs, warnings := SplitViolations(violations)
+	require.Equal(t, 2, len(errs))
+	require.Equal(t, "a", errs[0].Message)
+	require.Equal(t, "d", errs[1].Message)
+	require.Equal(t, 2, len(warnings))
+	require.Equal(t, "b", warnings[0].Message)
+	require.Equal(t, "c", warnings[1].Message)
+	require.True(t, hasErrorViolations(violations))
+	require.False(t, hasErrorViolations(warnings))
+}
+
+func TestDeprecatedPropertyWarning(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"foo": {Type: JsonString, OasInfo: &OasInfo{Deprecated: true}},
+			"bar": {Type: JsonString, Mandatory: true},
+		},
+	}
+	ok, violations := v.Validate(map[string]interface{}{"bar": "x"})
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+
+	var warnings []*Violation
+	ok, violations = v.ValidateWith(map[string]interface{}{"foo": "x", "bar": "x"}, WithWarnings(&warnings))
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+	require.Equal(t, 1, len(warnings))
+	require.Equal(t, SeverityWarning, warnings[0].Severity)
+	require.Equal(t, CodeDeprecatedProperty, warnings[0].Code)
+	require.Equal(t, msgDeprecatedProperty, warnings[0].Message)
+	require.Equal(t, "foo", warnings[0].Property)
+
+	warnings = nil
+	ok, violations = v.ValidateWith(map[string]interface{}{"foo": "x"}, WithWarnings(&warnings))
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, CodeMissingProperty, violations[0].Code)
+	require.Equal(t, 1, len(warnings))
+}
+
+func TestWarningsReportedSeparately(t *testing.T) {
+	v := &Validator{
+		IncludeSourcePositions: true,
+		Properties: Properties{
+			"foo": {Type: JsonString, OasInfo: &OasInfo{Deprecated: true}},
+		},
+	}
+	var warnings []*Violation
+	ok, violations, _ := v.ValidateStringWith(`{"foo": "x"}`, WithWarnings(&warnings))
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+	require.Equal(t, 1, len(warnings))
+	require.NotNil(t, warnings[0].Position)
+
+	req, _ := http.NewRequest("POST", "/", strings.NewReader(`{"foo": "x"}`))
+	warnings = nil
+	ok, violations, _ = v.RequestValidateWith(req, WithWarnings(&warnings))
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+	require.Equal(t, 1, len(warnings))
+
+	warnings = nil
+	ok, violations = (&PropertyValidator{Constraints: Constraints{&WarningOnly{Constraint: &StringMinLength{Value: 2}}}}).ValidateWith("x", WithWarnings(&warnings))
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+	require.Equal(t, 1, len(warnings))
+}
+
+func TestWarningsDoNotStopOnFirst(t *testing.T) {
+	v := &Validator{
+		StopOnFirst: true,
+		// the warning property must be checked first...
+		Properties: Properties{
+			"foo": {Type: JsonString, Order: 1, Constraints: Constraints{&WarningOnly{Constraint: &StringMaxLength{Value: 1}}}},
+			"bar": {Type: JsonString, Order: 2, Mandatory: true},
+		},
+	}
+	var warnings []*Violation
+	ok, violations := v.ValidateWith(map[string]interface{}{"foo": "xx"}, WithWarnings(&warnings))
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, CodeMissingProperty, violations[0].Code)
+	require.Equal(t, 1, len(warnings))
+}
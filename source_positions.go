@@ -0,0 +1,200 @@
+package valix
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// SourcePosition is a position in raw JSON (see Violation.Position)
+type SourcePosition struct {
+	// Offset is the (zero based) byte offset
+	Offset int64 `json:"offset"`
+	// Line is the (one based) line number
+	Line int `json:"line"`
+	// Column is the (one based) column number - in characters (runes) from the start of the line
+	Column int `json:"column"`
+}
+
+// sourcePositions records the positions of every value (properties and array elements) in raw JSON
+type sourcePositions struct {
+	data       []byte
+	lineStarts []int
+	positions  map[string]*SourcePosition
+	// failOffset is the offset at which scanning the JSON failed (-1 if the JSON scanned ok)
+	failOffset int64
+}
+
+// readSourcePositions reads the reader fully (returning a reader over the read data) - if the validator is not
+// including source positions, the original reader is returned (and the returned source positions is nil)
+func (v *Validator) readSourcePositions(r io.Reader) (io.Reader, *sourcePositions, error) {
+	if !v.IncludeSourcePositions || r == nil {
+		return r, nil, nil
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bytes.NewReader(data), v.newSourcePositions(data), nil
+}
+
+// newSourcePositions returns the source positions for the raw JSON - or nil if the validator is not
+// including source positions
+func (v *Validator) newSourcePositions(data []byte) *sourcePositions {
+	if !v.IncludeSourcePositions {
+		return nil
+	}
+	result := &sourcePositions{
+		data:       data,
+		lineStarts: []int{0},
+		positions:  map[string]*SourcePosition{},
+		failOffset: -1,
+	}
+	for i, b := range data {
+		if b == '\n' {
+			result.lineStarts = append(result.lineStarts, i+1)
+		}
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := result.scan(decoder, []interface{}{}); err != nil {
+		var se *json.SyntaxError
+		if errors.As(err, &se) {
+			result.failOffset = result.syntaxErrorOffset(se)
+		} else {
+			result.failOffset = decoder.InputOffset()
+		}
+	}
+	return result
+}
+
+func (sp *sourcePositions) scan(decoder *json.Decoder, location []interface{}) error {
+	sp.positions[locationKey(location)] = sp.positionAt(sp.skipSeparators(decoder.InputOffset()))
+	tkn, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tkn.(json.Delim); ok {
+		switch delim {
+		case '{':
+			for decoder.More() {
+				keyTkn, err := decoder.Token()
+				if err != nil {
+					return err
+				}
+				name, _ := keyTkn.(string)
+				if err = sp.scan(decoder, childLocation(location, name)); err != nil {
+					return err
+				}
+			}
+		case '[':
+			for i := 0; decoder.More(); i++ {
+				if err = sp.scan(decoder, childLocation(location, i)); err != nil {
+					return err
+				}
+			}
+		}
+		// consume the closing delimiter...
+		if _, err = decoder.Token(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// skipSeparators returns the offset of the start of the next value (i.e. skipping whitespace, colons and commas)
+func (sp *sourcePositions) skipSeparators(offset int64) int64 {
+	for offset < int64(len(sp.data)) && strings.IndexByte(" \t\r\n:,", sp.data[offset]) != -1 {
+		offset++
+	}
+	return offset
+}
+
+func (sp *sourcePositions) positionAt(offset int64) *SourcePosition {
+	if offset > int64(len(sp.data)) {
+		offset = int64(len(sp.data))
+	}
+	line := sort.Search(len(sp.lineStarts), func(i int) bool {
+		return int64(sp.lineStarts[i]) > offset
+	})
+	lineStart := sp.lineStarts[line-1]
+	return &SourcePosition{
+		Offset: offset,
+		Line:   line,
+		Column: utf8.RuneCount(sp.data[lineStart:offset]) + 1,
+	}
+}
+
+// apply sets the Violation.Position on each of the violations
+//
+// Violations for values that are not present (e.g. missing properties) are given the position of the nearest
+// present ancestor (e.g. the object that is missing the property)
+func (sp *sourcePositions) apply(violations []*Violation) {
+	if sp == nil {
+		return
+	}
+	for _, v := range violations {
+		if code, _ := violationCode(v); code == CodeUnableToDecode || code == CodeUnableToDecodeRequest {
+			v.Position = sp.failPosition(v)
+		} else if v.location != nil {
+			for l := len(v.location); l >= 0 && v.Position == nil; l-- {
+				v.Position = sp.positions[locationKey(v.location[:l])]
+			}
+		}
+	}
+}
+
+// applyContext applies the positions to the violations and warnings of the context
+func (sp *sourcePositions) applyContext(vcx *ValidatorContext) {
+	sp.apply(vcx.violations)
+	sp.apply(vcx.warnings)
+}
+
+func (sp *sourcePositions) failPosition(v *Violation) *SourcePosition {
+	for _, err := range v.Unwrap() {
+		var se *json.SyntaxError
+		if errors.As(err, &se) {
+			return sp.positionAt(sp.syntaxErrorOffset(se))
+		}
+	}
+	if sp.failOffset != -1 {
+		return sp.positionAt(sp.failOffset)
+	}
+	return sp.positionAt(int64(len(sp.data)))
+}
+
+// syntaxErrorOffset returns the offset of the offending character (json.SyntaxError.Offset is the number of bytes
+// read - i.e. the offset after the offending character) - or the end of the data for unexpected end of input
+func (sp *sourcePositions) syntaxErrorOffset(se *json.SyntaxError) int64 {
+	if se.Offset >= int64(len(sp.data)) && strings.Contains(se.Error(), "unexpected end") {
+		return int64(len(sp.data))
+	} else if se.Offset > 0 {
+		return se.Offset - 1
+	}
+	return 0
+}
+
+func childLocation(location []interface{}, key interface{}) []interface{} {
+	result := make([]interface{}, len(location), len(location)+1)
+	copy(result, location)
+	return append(result, key)
+}
+
+func locationKey(location []interface{}) string {
+	var sb strings.Builder
+	for _, key := range location {
+		switch k := key.(type) {
+		case int:
+			sb.WriteString("[" + strconv.Itoa(k) + "]")
+		case string:
+			sb.WriteString("." + strconv.Quote(k))
+		}
+	}
+	return sb.String()
+}
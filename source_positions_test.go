@@ -0,0 +1,141 @@
+package valix
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testPositionsValidator = &Validator{
+	IncludeSourcePositions: true,
+	Properties: Properties{
+		"name": {Type: JsonString, Mandatory: true},
+		"items": {
+			Type: JsonArray,
+			ObjectValidator: &Validator{
+				AllowArray:     true,
+				DisallowObject: true,
+				Properties: Properties{
+					"sku": {Type: JsonString, Mandatory: true, Constraints: Constraints{&StringMaxLength{Value: 3}}},
+				},
+			},
+		},
+	},
+}
+
+const testPositionsJson = `{
+  "name": "foo",
+  "items": [
+    {"sku": "abc"},
+    {"sku": "abcd"},
+    {}
+  ]
+}`
+
+func TestValidatorSourcePositions(t *testing.T) {
+	ok, violations, _ := testPositionsValidator.ValidateString(testPositionsJson)
+	require.False(t, ok)
+	require.Equal(t, 2, len(violations))
+	byPath := ViolationsByPath(violations)
+
+	pos := byPath["items[1].sku"][0].Position
+	require.NotNil(t, pos)
+	require.Equal(t, &SourcePosition{Offset: int64(strings.Index(testPositionsJson, `"abcd"`)), Line: 5, Column: 13}, pos)
+
+	// missing property gets position of containing object...
+	pos = byPath["items[2].sku"][0].Position
+	require.NotNil(t, pos)
+	require.Equal(t, &SourcePosition{Offset: int64(strings.Index(testPositionsJson, `{}`)), Line: 6, Column: 5}, pos)
+
+	data, err := json.Marshal(byPath["items[1].sku"][0])
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"position":{"offset":64,"line":5,"column":13}`)
+}
+
+func TestValidatorSourcePositions_NotIncluded(t *testing.T) {
+	v := testPositionsValidator.Clone()
+	v.IncludeSourcePositions = false
+	ok, violations, _ := v.ValidateString(testPositionsJson)
+	require.False(t, ok)
+	for _, violation := range violations {
+		require.Nil(t, violation.Position)
+	}
+	data, err := json.Marshal(violations[0])
+	require.NoError(t, err)
+	require.NotContains(t, string(data), `"position"`)
+}
+
+func TestValidatorSourcePositions_RootAndUnicode(t *testing.T) {
+	v := &Validator{
+		IncludeSourcePositions: true,
+		Properties: Properties{
+			"é": {Type: JsonString, Constraints: Constraints{&StringMaxLength{Value: 1}}},
+		},
+	}
+	ok, violations, _ := v.ValidateString("\n  {\"é\": \"ab\", \"foo\": true}")
+	require.False(t, ok)
+	require.Equal(t, 2, len(violations))
+	byPath := ViolationsByPath(violations)
+	require.Equal(t, &SourcePosition{Offset: 10, Line: 2, Column: 9}, byPath["é"][0].Position)
+	require.Equal(t, &SourcePosition{Offset: 23, Line: 2, Column: 22}, byPath["foo"][0].Position)
+
+	ok, violations, _ = v.ValidateString(`{}`, "")
+	require.True(t, ok)
+	require.Equal(t, 0, len(violations))
+}
+
+func TestValidatorSourcePositions_DecodeErrors(t *testing.T) {
+	ok, violations, _ := testPositionsValidator.ValidateString("{\n  \"name\": x\n}")
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, CodeUnableToDecode, violations[0].Code)
+	require.Equal(t, &SourcePosition{Offset: 12, Line: 2, Column: 11}, violations[0].Position)
+
+	ok, violations, _ = testPositionsValidator.ValidateString("{\n  \"name\": \"x\"")
+	require.False(t, ok)
+	require.Equal(t, CodeUnableToDecode, violations[0].Code)
+	require.Equal(t, &SourcePosition{Offset: 15, Line: 2, Column: 14}, violations[0].Position)
+
+	ok, violations, _ = testPositionsValidator.ValidateStringInto(`[1,`, &struct{}{})
+	require.False(t, ok)
+	require.Equal(t, CodeUnableToDecode, violations[0].Code)
+	require.Equal(t, int64(3), violations[0].Position.Offset)
+}
+
+func TestValidatorSourcePositions_Requests(t *testing.T) {
+	req, _ := http.NewRequest("POST", "http://example.com", strings.NewReader(testPositionsJson))
+	ok, violations, _ := testPositionsValidator.RequestValidate(req)
+	require.False(t, ok)
+	require.Equal(t, 2, len(violations))
+	for _, violation := range violations {
+		require.NotNil(t, violation.Position)
+	}
+
+	req, _ = http.NewRequest("POST", "http://example.com", strings.NewReader(testPositionsJson))
+	ok, violations, _ = testPositionsValidator.RequestValidateInto(req, &map[string]interface{}{})
+	require.False(t, ok)
+	require.Equal(t, 2, len(violations))
+	for _, violation := range violations {
+		require.NotNil(t, violation.Position)
+	}
+
+	req, _ = http.NewRequest("POST", "http://example.com", strings.NewReader(`{"name": }`))
+	ok, violations, _ = testPositionsValidator.RequestValidate(req)
+	require.False(t, ok)
+	require.Equal(t, CodeUnableToDecodeRequest, violations[0].Code)
+	require.Equal(t, &SourcePosition{Offset: 9, Line: 1, Column: 10}, violations[0].Position)
+
+	req, _ = http.NewRequest("POST", "http://example.com", nil)
+	ok, violations, _ = testPositionsValidator.RequestValidate(req)
+	require.False(t, ok)
+	require.Equal(t, CodeRequestBodyEmpty, violations[0].Code)
+	require.Nil(t, violations[0].Position)
+}
+
+func TestLocationKey(t *testing.T) {
+	require.Equal(t, "", locationKey([]interface{}{}))
+	require.Equal(t, `."foo"[1]."a.b"`, locationKey([]interface{}{"foo", 1, "a.b"}))
+}
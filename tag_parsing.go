@@ -207,6 +207,14 @@ func buildConstraintFromTagValue(tagValue string) (Constraint, error) {
 	if strings.HasPrefix(useValue, "&") {
 		useValue = useValue[1:]
 	}
+	if strings.HasPrefix(useValue, "?") {
+		// warning-only constraint...
+		c, err := buildConstraintFromTagValue(useValue[1:])
+		if err != nil {
+			return nil, err
+		}
+		return &WarningOnly{Constraint: c}, nil
+	}
 	isConditional := false
 	var conditions Conditions
 	var others OthersExpr
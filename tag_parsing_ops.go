@@ -31,6 +31,7 @@ const (
 	tagTokenUnwantedWithAltMsg = "-msg"
 	tagTokenStopOnFirst        = "stop_on_first"
 	tagTokenStopOnFirstAlt     = "stop1st"
+	tagTokenLabel              = "label"
 	// object level tag items...
 	tagTokenObjPrefix                  = "obj."
 	tagTokenObjIgnoreUnknownProperties = tagTokenObjPrefix + "ignoreUnknownProperties"
@@ -67,6 +68,7 @@ var tagExpectsColon = map[string]bool{
 	tagTokenRequiredWithAltMsg:         true,
 	tagTokenUnwantedWithMsg:            true,
 	tagTokenUnwantedWithAltMsg:         true,
+	tagTokenLabel:                      true,
 	tagTokenObjIgnoreUnknownProperties: false,
 	tagTokenObjUnknownProperties:       true,
 	tagTokenObjOrdered:                 false,
@@ -163,6 +165,14 @@ var tagTokenOperations = map[string]tagTokenOperation{
 		}
 		return nil
 	},
+	tagTokenLabel: func(pv *PropertyValidator, hasColon bool, tagValue string) error {
+		if unq, ok := isQuotedStr(tagValue); ok {
+			pv.Label = unq
+		} else {
+			pv.Label = tagValue
+		}
+		return nil
+	},
 	tagTokenType: func(pv *PropertyValidator, hasColon bool, tagValue string) error {
 		ty, ok := JsonTypeFromString(tagValue)
 		if !ok {
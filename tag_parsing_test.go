@@ -443,6 +443,18 @@ func TestPropertyValidator_AddTagItemOnly(t *testing.T) {
 	require.Equal(t, "foo bar", pv.OnlyMessage)
 }
 
+func TestPropertyValidator_AddTagItemLabel(t *testing.T) {
+	pv := &PropertyValidator{}
+	err := pv.addTagItem("", "", tagTokenLabel)
+	require.Error(t, err)
+	err = pv.addTagItem("", "", tagTokenLabel+":Post code")
+	require.NoError(t, err)
+	require.Equal(t, "Post code", pv.Label)
+	err = pv.addTagItem("", "", tagTokenLabel+":'Postcode'")
+	require.NoError(t, err)
+	require.Equal(t, "Postcode", pv.Label)
+}
+
 func TestPropertyValidator_AddTagItemStopOnFirst(t *testing.T) {
 	pv := &PropertyValidator{}
 	require.False(t, pv.StopOnFirst)
@@ -21,6 +21,9 @@ const (
 	ptyNameStopOnFirst             = "stopOnFirst"
 	ptyNameUseNumber               = "useNumber"
 	ptyNameOrderedPropertyChecks   = "orderedPropertyChecks"
+	ptyNameIncludeRejectedValues   = "includeRejectedValues"
+	ptyNameIncludeSourcePositions  = "includeSourcePositions"
+	ptyNamePathFormat              = "pathFormat"
 	ptyNameWhenConditions          = "whenConditions"
 	ptyNameOthersExpr              = "othersExpr"
 	ptyNameMandatoryWhen           = "mandatoryWhen"
@@ -35,6 +38,7 @@ const (
 	ptyNameRequiredWithMessage     = "requiredWithMessage"
 	ptyNameUnwantedWith            = "unwantedWith"
 	ptyNameUnwantedWithMessage     = "unwantedWithMessage"
+	ptyNameLabel                   = "label"
 	ptyNameObjectValidator         = "objectValidator"
 	ptyNameName                    = "name"
 	ptyNameFields                  = "fields"
@@ -53,6 +57,9 @@ const (
 	msgUnknownConstraintName           = "Unknown constraint name '%s'"
 	msgFieldExpectedType               = "Field '%s' expected type %s (found type %s)"
 	msgFieldsUnmarshalable             = "Unable to unmarshal fields"
+	msgOasExamplesStrings              = "Examples must all be strings"
+	msgOasExtensionsPrefix             = "Extension names must start with 'x-'"
+	msgOasExternalDocs                 = "External docs may only have string properties 'url' and 'description'"
 )
 
 var ValidatorValidator *Validator
@@ -81,6 +88,42 @@ func init() {
 			ptyNameOasDeprecated: {
 				Type: JsonBoolean,
 			},
+			ptyNameOasReadOnly: {
+				Type: JsonBoolean,
+			},
+			ptyNameOasWriteOnly: {
+				Type: JsonBoolean,
+			},
+			ptyNameOasExamples: {
+				Type: JsonObject,
+				Constraints: Constraints{
+					NewCustomConstraint(oasExamplesCheck, msgOasExamplesStrings),
+				},
+			},
+			ptyNameOasDefault: {
+				Type: JsonString,
+			},
+			ptyNameOasEnum: {
+				Type: JsonArray,
+				Constraints: Constraints{
+					&ArrayOf{Type: JsonString.String(), AllowNullElement: false},
+				},
+			},
+			ptyNameOasExtDocs: {
+				Type: JsonObject,
+				Constraints: Constraints{
+					NewCustomConstraint(oasExternalDocsCheck, msgOasExternalDocs),
+				},
+			},
+			ptyNameOasNullable: {
+				Type: JsonBoolean,
+			},
+			ptyNameOasExtensions: {
+				Type: JsonObject,
+				Constraints: Constraints{
+					NewCustomConstraint(oasExtensionsCheck, msgOasExtensionsPrefix),
+				},
+			},
 		},
 	}
 	constraintValidator = &Validator{
@@ -240,6 +283,11 @@ func init() {
 				Mandatory: false,
 				NotNull:   false,
 			},
+			ptyNameLabel: {
+				Type:      JsonString,
+				Mandatory: false,
+				NotNull:   false,
+			},
 			ptyNameOasInfo: {
 				Type:            JsonObject,
 				Mandatory:       false,
@@ -341,6 +389,31 @@ func init() {
 				Mandatory: false,
 				NotNull:   true,
 			},
+			ptyNameIncludeRejectedValues: {
+				Type:      JsonBoolean,
+				Mandatory: false,
+				NotNull:   true,
+			},
+			ptyNameIncludeSourcePositions: {
+				Type:      JsonBoolean,
+				Mandatory: false,
+				NotNull:   true,
+			},
+			ptyNamePathFormat: {
+				Type:      JsonString,
+				Mandatory: false,
+				NotNull:   true,
+				Constraints: Constraints{
+					&StringValidToken{
+						Tokens: []string{
+							PathFormatDotted.String(),
+							PathFormatJsonPointer.String(),
+							PathFormatJsonPath.String(),
+						},
+						IgnoreCase: true,
+					},
+				},
+			},
 			ptyNameWhenConditions: {
 				Type:      JsonArray,
 				Mandatory: false,
@@ -421,6 +494,39 @@ func constraintNameCheck(value interface{}, vcx *ValidatorContext, this *CustomC
 	return result, msg
 }
 
+func oasExamplesCheck(value interface{}, vcx *ValidatorContext, this *CustomConstraint) (bool, string) {
+	if m, ok := value.(map[string]interface{}); ok {
+		for _, v := range m {
+			if _, ok := v.(string); !ok {
+				return false, this.GetMessage(vcx)
+			}
+		}
+	}
+	return true, ""
+}
+
+func oasExternalDocsCheck(value interface{}, vcx *ValidatorContext, this *CustomConstraint) (bool, string) {
+	if m, ok := value.(map[string]interface{}); ok {
+		for k, v := range m {
+			if _, ok := v.(string); !ok || (k != ptyNameOasExtDocsUrl && k != ptyNameOasDescription) {
+				return false, this.GetMessage(vcx)
+			}
+		}
+	}
+	return true, ""
+}
+
+func oasExtensionsCheck(value interface{}, vcx *ValidatorContext, this *CustomConstraint) (bool, string) {
+	if m, ok := value.(map[string]interface{}); ok {
+		for k := range m {
+			if !strings.HasPrefix(k, tagOpenApiExtPrefix) {
+				return false, this.GetMessage(vcx)
+			}
+		}
+	}
+	return true, ""
+}
+
 func constraintFieldsCheck(value interface{}, vcx *ValidatorContext, this *CustomConstraint) (bool, string) {
 	if mv, mOk := value.(map[string]interface{}); mOk {
 		parent, pOk := vcx.AncestorValue(0)
@@ -671,6 +777,23 @@ func (jt *JsonType) UnmarshalJSON(data []byte) error {
 	return fmt.Errorf("value for JsonType expected string (%s) or integer (%d to %d)", jsonTypeTokensList, JsonAny, JsonArray)
 }
 
+func (f *PathFormat) UnmarshalJSON(data []byte) error {
+	str := string(data[:])
+	if strings.HasPrefix(str, `"`) && strings.HasSuffix(str, `"`) {
+		if v, ok := PathFormatFromString(str[1 : len(str)-1]); ok {
+			*f = v
+			return nil
+		}
+	} else if i, err := strconv.ParseInt(str, 10, 32); err == nil {
+		fv := PathFormat(i)
+		if fv >= PathFormatDotted && fv <= PathFormatJsonPath {
+			*f = fv
+			return nil
+		}
+	}
+	return fmt.Errorf("value for PathFormat expected string (%s) or integer (%d to %d)", pathFormatTokensList, PathFormatDotted, PathFormatJsonPath)
+}
+
 func (c *StringPattern) UnmarshalJSON(data []byte) error {
 	obj := map[string]interface{}{}
 	err := json.Unmarshal(data, &obj)
@@ -794,3 +917,27 @@ func (c *SetConditionIf) UnmarshalJSON(data []byte) error {
 	}
 	return nil
 }
+
+func (c *WarningOnly) UnmarshalJSON(data []byte) error {
+	obj := map[string]interface{}{}
+	_ = json.Unmarshal(data, &obj)
+	if raw, ok := obj["Constraint"]; ok && raw != nil {
+		if v, ok := raw.(map[string]interface{}); ok {
+			if wrapped, err := unmarshalConstraint(v); err == nil {
+				c.Constraint = wrapped
+			} else {
+				return err
+			}
+		} else {
+			return fmt.Errorf(errMsgFieldExpectedType, "Constraint", "object")
+		}
+	}
+	if raw, ok := obj["Info"]; ok {
+		if v, ok := raw.(bool); ok {
+			c.Info = v
+		} else {
+			return fmt.Errorf(errMsgFieldExpectedType, "Info", "bool")
+		}
+	}
+	return nil
+}
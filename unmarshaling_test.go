@@ -457,7 +457,7 @@ func TestValidatorValidationWithBadJson(t *testing.T) {
 					ptyNameType:        "string",
 					ptyNameNotNull:     true,
 					ptyNameMandatory:   true,
-					ptyNameConstraints: []string{},
+					ptyNameConstraints: []string{"should be objects"},
 				},
 			},
 		},
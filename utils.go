@@ -67,8 +67,18 @@ func coerceToFloat(value interface{}) (f float64, ok bool, isNumber bool) {
 			ok = false
 		}
 	default:
-		ok = false
-		isNumber = false
+		// named numeric types (e.g. type Age int)...
+		switch rv := reflect.ValueOf(value); rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			f = float64(rv.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			f = float64(rv.Uint())
+		case reflect.Float32, reflect.Float64:
+			f = rv.Float()
+		default:
+			ok = false
+			isNumber = false
+		}
 	}
 	ok = ok && !math.IsNaN(f)
 	return
@@ -109,8 +119,24 @@ func coerceToInt(value interface{}) (i int64, ok bool, isNumber bool) {
 			ok = false
 		}
 	default:
-		ok = false
-		isNumber = false
+		// named numeric types (e.g. type Age int)...
+		switch rv := reflect.ValueOf(value); rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			i = rv.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			i = int64(rv.Uint())
+		case reflect.Float32, reflect.Float64:
+			f := rv.Float()
+			if !math.IsNaN(f) && !math.IsInf(f, 0) {
+				i = int64(f)
+				ok = math.Trunc(f) == f
+			} else {
+				ok = false
+			}
+		default:
+			ok = false
+			isNumber = false
+		}
 	}
 	return
 }
@@ -150,6 +176,13 @@ func (b ternary) int(t, f int) int {
 	return f
 }
 
+func (b ternary) float64(t, f float64) float64 {
+	if b {
+		return t
+	}
+	return f
+}
+
 func caseInsensitive(s string, insensitive bool) string {
 	if insensitive {
 		return strings.ToUpper(s)
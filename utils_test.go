@@ -322,6 +322,8 @@ func TestIfTernary(t *testing.T) {
 	require.Equal(t, "no", ternary(false).string("yes", "no"))
 	require.Equal(t, 1, ternary(true).int(1, 2))
 	require.Equal(t, 2, ternary(false).int(1, 2))
+	require.Equal(t, 1.5, ternary(true).float64(1.5, 2.5))
+	require.Equal(t, 2.5, ternary(false).float64(1.5, 2.5))
 }
 
 func TestTime_UnmarshalJSON(t *testing.T) {
@@ -0,0 +1,155 @@
+package valix
+
+import "net/http"
+
+// ValidateOption is an option that can be passed to any of the option taking validate methods (e.g.
+// Validator.ValidateWith, Validator.RequestValidateWith, PropertyValidator.ValidateWith, API.RequestValidateWith etc.)
+//
+// Options are obtained using any of: WithConditions, WithLanguage, WithI18nContext, WithTranslator, WithPathFormat
+// or WithWarnings
+//
+// Example:
+//
+//	ok, violations := myValidator.ValidateWith(obj, valix.WithConditions("myCondition"), valix.WithLanguage("fr", "CA"))
+type ValidateOption func(s *validateSettings)
+
+// I18nLanguageProvider is an optional interface that an I18n provider can implement to provide an I18nContext
+// for a specific language and region - used when the WithLanguage option is passed to a validate method
+//
+// If the I18n provider does not implement this interface, the default I18nContext for the language and region is used
+type I18nLanguageProvider interface {
+	LanguageContext(lang string, region string) I18nContext
+}
+
+// WithConditions is a validate option that sets initial conditions (see ValidatorContext.SetCondition)
+func WithConditions(conditions ...string) ValidateOption {
+	return func(s *validateSettings) {
+		s.conditions = append(s.conditions, conditions...)
+	}
+}
+
+// WithLanguage is a validate option that sets the language and region (e.g. "fr" and "CA") used for violation
+// messages - the region may be empty
+//
+// This is useful where the language cannot be determined from a request (e.g. background jobs or gRPC handlers)
+func WithLanguage(lang string, region string) ValidateOption {
+	return func(s *validateSettings) {
+		s.lang = lang
+		s.region = region
+	}
+}
+
+// WithI18nContext is a validate option that sets the I18nContext used for validation
+//
+// When specified, this takes precedence over any WithLanguage or WithTranslator options
+func WithI18nContext(tcx I18nContext) ValidateOption {
+	return func(s *validateSettings) {
+		s.tcx = tcx
+	}
+}
+
+// WithTranslator is a validate option that sets the Translator used for violation messages (instead of
+// DefaultTranslator)
+//
+// The language and region are taken from any WithLanguage option - otherwise from the I18n provider
+func WithTranslator(translator Translator) ValidateOption {
+	return func(s *validateSettings) {
+		s.translator = translator
+	}
+}
+
+// WithPathFormat is a validate option that sets the PathFormat used for violation paths (instead of the
+// Validator.PathFormat of the validator on which validation is started)
+//
+// Example:
+//
+//	ok, violations := myValidator.ValidateWith(obj, valix.WithPathFormat(valix.PathFormatJsonPointer))
+func WithPathFormat(format PathFormat) ValidateOption {
+	return func(s *validateSettings) {
+		s.pathFormat = &format
+	}
+}
+
+// WithWarnings is a validate option that collects warnings (i.e. violations with SeverityWarning or SeverityInfo)
+// into the supplied slice - warnings are never included in the violations returned by the validate methods
+//
+// Example:
+//
+//	var warnings []*valix.Violation
+//	ok, violations := myValidator.ValidateWith(obj, valix.WithWarnings(&warnings))
+func WithWarnings(warnings *[]*Violation) ValidateOption {
+	return func(s *validateSettings) {
+		s.warnings = warnings
+	}
+}
+
+type validateSettings struct {
+	conditions []string
+	tcx        I18nContext
+	lang       string
+	region     string
+	translator Translator
+	warnings   *[]*Violation
+	pathFormat *PathFormat
+}
+
+func newValidateSettings(options []ValidateOption) *validateSettings {
+	result := &validateSettings{
+		conditions: make([]string, 0),
+	}
+	for _, o := range options {
+		if o != nil {
+			o(result)
+		}
+	}
+	return result
+}
+
+// applySettings sets the initial conditions, warnings collection and path format of the context from the settings
+func (vc *ValidatorContext) applySettings(s *validateSettings) {
+	vc.setInitialConditions(s.conditions...)
+	vc.warningsTo = s.warnings
+	if s.pathFormat != nil {
+		root := vc.pathStack[0]
+		root.format = *s.pathFormat
+		root.path = root.format.rootPath()
+	}
+}
+
+// i18nContext determines the I18nContext to be used - from the options, otherwise from the provider
+// (using the request, if not nil)
+func (s *validateSettings) i18nContext(provider I18n, req *http.Request) I18nContext {
+	if s.tcx != nil {
+		return s.tcx
+	}
+	if s.lang == "" && s.translator == nil {
+		return providerContext(provider, req)
+	}
+	lang, region := s.lang, s.region
+	if lang == "" {
+		tcx := obtainI18nContext(providerContext(provider, req))
+		lang, region = tcx.Language(), tcx.Region()
+	}
+	if s.translator != nil {
+		return newTranslatorI18nContext(lang, region, s.translator)
+	}
+	if lp, ok := provider.(I18nLanguageProvider); ok {
+		return lp.LanguageContext(lang, region)
+	}
+	return newDefaultI18nContext(lang, region)
+}
+
+func providerContext(provider I18n, req *http.Request) I18nContext {
+	if req != nil {
+		return provider.ContextFromRequest(req)
+	}
+	return provider.DefaultContext()
+}
+
+// i18nProvider returns the I18n provider for the validator - Validator.I18n, if set, otherwise DefaultI18nProvider
+func (v *Validator) i18nProvider() I18n {
+	if v != nil && v.I18n != nil {
+		return v.I18n
+	}
+	return obtainI18nProvider()
+}
@@ -0,0 +1,284 @@
+package valix
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewValidateSettings(t *testing.T) {
+	tcx := newDefaultI18nContext("de", "")
+	s := newValidateSettings([]ValidateOption{WithConditions("a"), WithConditions("b", "c"), nil, WithLanguage("fr", "CA"), WithI18nContext(tcx)})
+	require.Equal(t, []string{"a", "b", "c"}, s.conditions)
+	require.Equal(t, "fr", s.lang)
+	require.Equal(t, "CA", s.region)
+	require.Equal(t, tcx, s.tcx)
+	require.Nil(t, s.translator)
+}
+
+func TestValidateSettings_I18nContext(t *testing.T) {
+	s := newValidateSettings(nil)
+	tcx := s.i18nContext(obtainI18nProvider(), nil)
+	require.Equal(t, DefaultLanguage, tcx.Language())
+
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.Header.Set("Accept-Language", "de")
+	tcx = s.i18nContext(obtainI18nProvider(), req)
+	require.Equal(t, "de", tcx.Language())
+
+	s = newValidateSettings([]ValidateOption{WithLanguage("fr", "ca")})
+	tcx = s.i18nContext(obtainI18nProvider(), req)
+	require.Equal(t, "fr", tcx.Language())
+	require.Equal(t, "CA", tcx.Region())
+
+	s = newValidateSettings([]ValidateOption{WithTranslator(&testPrefixTranslator{})})
+	tcx = s.i18nContext(obtainI18nProvider(), req)
+	require.Equal(t, "de", tcx.Language())
+	require.Equal(t, "de:foo", tcx.TranslateMessage("foo"))
+
+	explicit := newDefaultI18nContext("it", "")
+	s = newValidateSettings([]ValidateOption{WithLanguage("fr", ""), WithTranslator(&testPrefixTranslator{}), WithI18nContext(explicit)})
+	require.Equal(t, explicit, s.i18nContext(obtainI18nProvider(), req))
+}
+
+func TestValidateSettings_I18nContext_LanguageProvider(t *testing.T) {
+	s := newValidateSettings([]ValidateOption{WithLanguage("fr", "")})
+	// provider that implements I18nLanguageProvider...
+	tcx := s.i18nContext(&testLanguageI18nProvider{}, nil)
+	require.Equal(t, "fr:foo", tcx.TranslateMessage("foo"))
+	// provider that does not implement I18nLanguageProvider...
+	tcx = s.i18nContext(&testFixedI18nProvider{}, nil)
+	require.Equal(t, "fr", tcx.Language())
+	require.Equal(t, "foo", tcx.TranslateMessage("foo"))
+}
+
+func TestValidate_WithLanguage(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"foo": {Mandatory: true},
+		},
+	}
+	ok, violations := v.Validate(map[string]interface{}{})
+	require.False(t, ok)
+	require.Equal(t, msgMissingProperty, violations[0].Message)
+
+	frMsg := newDefaultI18nContext("fr", "").TranslateMessage(msgMissingProperty)
+	require.NotEqual(t, msgMissingProperty, frMsg)
+	ok, violations = v.ValidateWith(map[string]interface{}{}, WithLanguage("fr", "CA"))
+	require.False(t, ok)
+	require.Equal(t, frMsg, violations[0].Message)
+
+	ok, violations, _ = v.ValidateStringWith(`{}`, WithLanguage("fr", ""))
+	require.False(t, ok)
+	require.Equal(t, frMsg, violations[0].Message)
+
+	ok, violations, _ = v.ValidateStringWith(`{`, WithLanguage("fr", ""))
+	require.False(t, ok)
+	require.Equal(t, newDefaultI18nContext("fr", "").TranslateMessage(msgUnableToDecode), violations[0].Message)
+
+	err := v.ValidateIntoWith([]byte(`{}`), &struct{}{}, WithLanguage("fr", ""))
+	require.Error(t, err)
+	require.Equal(t, frMsg, err.(*ValidationError).Violations[0].Message)
+
+	ok, violations = v.ValidateArrayOfWith([]interface{}{map[string]interface{}{}}, WithLanguage("fr", ""))
+	require.False(t, ok)
+	require.Equal(t, frMsg, violations[0].Message)
+}
+
+func TestValidate_WithLanguageAndConditions(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"foo": {Mandatory: true, WhenConditions: []string{"c1"}},
+			"bar": {Mandatory: true, WhenConditions: []string{"c2"}},
+		},
+	}
+	ok, _ := v.Validate(map[string]interface{}{})
+	require.True(t, ok)
+	ok, violations := v.ValidateWith(map[string]interface{}{}, WithConditions("c1"), WithLanguage("de", ""))
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "foo", violations[0].Property)
+	require.Equal(t, newDefaultI18nContext("de", "").TranslateMessage(msgMissingProperty), violations[0].Message)
+	conditions := []string{"c1", "c2"}
+	ok, violations = v.Validate(map[string]interface{}{}, conditions...)
+	require.False(t, ok)
+	require.Equal(t, 2, len(violations))
+	ok, violations = v.ValidateWith(map[string]interface{}{}, WithConditions(conditions...))
+	require.False(t, ok)
+	require.Equal(t, 2, len(violations))
+}
+
+func TestRequestValidate_WithOptions(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"foo": {Mandatory: true},
+		},
+	}
+	newRequest := func() *http.Request {
+		req, _ := http.NewRequest("POST", "/", strings.NewReader(`{}`))
+		req.Header.Set("Accept-Language", "de")
+		return req
+	}
+	ok, violations, _ := v.RequestValidate(newRequest())
+	require.False(t, ok)
+	require.Equal(t, newDefaultI18nContext("de", "").TranslateMessage(msgMissingProperty), violations[0].Message)
+
+	ok, violations, _ = v.RequestValidateWith(newRequest(), WithLanguage("fr", ""))
+	require.False(t, ok)
+	require.Equal(t, newDefaultI18nContext("fr", "").TranslateMessage(msgMissingProperty), violations[0].Message)
+
+	ok, violations, _ = v.RequestValidateIntoWith(newRequest(), &struct{}{}, WithTranslator(&testPrefixTranslator{}))
+	require.False(t, ok)
+	require.Equal(t, "de:"+msgMissingProperty, violations[0].Message)
+
+	ok, violations, _ = v.RequestValidateIntoWith(newRequest(), &struct{}{}, WithI18nContext(newDefaultI18nContext("it", "")))
+	require.False(t, ok)
+	require.Equal(t, newDefaultI18nContext("it", "").TranslateMessage(msgMissingProperty), violations[0].Message)
+
+	req, _ := http.NewRequest("GET", "/", nil)
+	ok, violations, _ = v.RequestQueryValidateWith(req, WithLanguage("fr", ""))
+	require.False(t, ok)
+	require.Equal(t, newDefaultI18nContext("fr", "").TranslateMessage(msgMissingProperty), violations[0].Message)
+}
+
+func TestValidator_I18n(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"foo": {Mandatory: true},
+		},
+		I18n: &testLanguageI18nProvider{},
+	}
+	ok, violations := v.Validate(map[string]interface{}{})
+	require.False(t, ok)
+	require.Equal(t, "xx:"+msgMissingProperty, violations[0].Message)
+
+	ok, violations = v.ValidateWith(map[string]interface{}{}, WithLanguage("fr", ""))
+	require.False(t, ok)
+	require.Equal(t, "fr:"+msgMissingProperty, violations[0].Message)
+
+	req, _ := http.NewRequest("POST", "/", strings.NewReader(`{}`))
+	ok, violations, _ = v.RequestValidate(req)
+	require.False(t, ok)
+	require.Equal(t, "req:"+msgMissingProperty, violations[0].Message)
+
+	// another validator is unaffected...
+	other := v.WithI18n(nil)
+	require.Nil(t, other.I18n)
+	require.NotNil(t, v.I18n)
+	ok, violations = other.Validate(map[string]interface{}{})
+	require.False(t, ok)
+	require.Equal(t, msgMissingProperty, violations[0].Message)
+
+	require.Equal(t, v.I18n, v.Clone().I18n)
+}
+
+func TestAPI_ValidatorI18n(t *testing.T) {
+	provider := &testLanguageI18nProvider{}
+	api := &API{}
+	err := api.AddOperation(&Operation{
+		Method: "GET",
+		Path:   "/foos/{fooId}",
+		PathParams: &Validator{
+			Properties: Properties{
+				"fooId": {Type: JsonInteger},
+			},
+			I18n: provider,
+		},
+		Responses: map[int]*Validator{
+			http.StatusOK: {
+				Properties: Properties{
+					"id": {Type: JsonInteger, Mandatory: true},
+				},
+				I18n: provider,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	req, _ := http.NewRequest("GET", "http://example.com/foos/abc", nil)
+	ok, violations, _ := api.RequestValidate(req)
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.True(t, strings.HasPrefix(violations[0].Message, "req:"))
+	_, violations, _ = api.RequestValidateWith(req, WithLanguage("fr", ""))
+	require.True(t, strings.HasPrefix(violations[0].Message, "fr:"))
+
+	h := api.Handler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	obj := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &obj))
+	require.Equal(t, "req:"+msgProblemTitleUnprocessableEntity, obj["title"])
+
+	api.ValidateResponses = true
+	api.ResponseMode = ResponseValidationFail
+	req, _ = http.NewRequest("GET", "http://example.com/foos/1", nil)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	obj = map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &obj))
+	require.Equal(t, "req:"+msgResponseFailedValidation, obj["title"])
+	require.Equal(t, "req:"+msgMissingProperty, obj["errors"].([]interface{})[0].(map[string]interface{})["message"])
+
+	p := (&ProblemRenderer{I18n: provider}).RenderRequest(req, violations)
+	require.Equal(t, "req:"+msgProblemTitleUnprocessableEntity, p.Title)
+}
+
+func TestPropertyValidator_ValidateWithOptions(t *testing.T) {
+	pv := &PropertyValidator{NotNull: true}
+	ok, violations := pv.ValidateWith(nil, WithLanguage("fr", ""))
+	require.False(t, ok)
+	require.Equal(t, newDefaultI18nContext("fr", "").TranslateMessage(msgValueCannotBeNull), violations[0].Message)
+	err := pv.ValidateErrWith(nil, WithTranslator(&testPrefixTranslator{}))
+	require.Error(t, err)
+	require.Equal(t, DefaultLanguage+":"+msgValueCannotBeNull, err.(*ValidationError).Violations[0].Message)
+}
+
+type testPrefixTranslator struct {
+	Translator
+}
+
+func (t *testPrefixTranslator) TranslateToken(lang string, region string, token string) string {
+	return lang + ":" + token
+}
+
+func (t *testPrefixTranslator) TranslateMessage(lang string, region string, message string) string {
+	return lang + ":" + message
+}
+
+func (t *testPrefixTranslator) TranslateFormat(lang string, region string, format string, a ...interface{}) string {
+	return lang + ":" + FormatMessage(nil, lang, region, format, a...)
+}
+
+type testLanguageI18nProvider struct{}
+
+func (t *testLanguageI18nProvider) ContextFromRequest(r *http.Request) I18nContext {
+	return newTranslatorI18nContext("req", "", &testPrefixTranslator{})
+}
+
+func (t *testLanguageI18nProvider) DefaultContext() I18nContext {
+	return newTranslatorI18nContext("xx", "", &testPrefixTranslator{})
+}
+
+func (t *testLanguageI18nProvider) LanguageContext(lang string, region string) I18nContext {
+	return newTranslatorI18nContext(lang, region, &testPrefixTranslator{})
+}
+
+type testFixedI18nProvider struct{}
+
+func (t *testFixedI18nProvider) ContextFromRequest(r *http.Request) I18nContext {
+	return t.DefaultContext()
+}
+
+func (t *testFixedI18nProvider) DefaultContext() I18nContext {
+	return newTranslatorI18nContext("xx", "", &testPrefixTranslator{})
+}
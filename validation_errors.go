@@ -0,0 +1,176 @@
+package valix
+
+// CodeError is a sentinel error for a violation code - enabling checks such as
+//
+//	errors.Is(err, valix.ErrMissingProperty)
+//
+// on errors returned by validation (see ValidationError)
+//
+// A Violation matches a CodeError (using errors.Is) when the violation has the same code
+type CodeError struct {
+	// Code is the violation code (e.g. CodeMissingProperty)
+	Code int
+	// Message is the (default, non-translated) message for the code
+	Message string
+}
+
+// Error implements error.Error
+func (e *CodeError) Error() string {
+	return e.Message
+}
+
+var (
+	// ErrUnableToDecode is the sentinel error for violations with CodeUnableToDecode
+	ErrUnableToDecode = &CodeError{Code: CodeUnableToDecode, Message: msgUnableToDecode}
+	// ErrNotJsonNull is the sentinel error for violations with CodeNotJsonNull
+	ErrNotJsonNull = &CodeError{Code: CodeNotJsonNull, Message: msgNotJsonNull}
+	// ErrNotJsonArray is the sentinel error for violations with CodeNotJsonArray
+	ErrNotJsonArray = &CodeError{Code: CodeNotJsonArray, Message: msgNotJsonArray}
+	// ErrNotJsonObject is the sentinel error for violations with CodeNotJsonObject
+	ErrNotJsonObject = &CodeError{Code: CodeNotJsonObject, Message: msgNotJsonObject}
+	// ErrExpectedJsonArray is the sentinel error for violations with CodeExpectedJsonArray
+	ErrExpectedJsonArray = &CodeError{Code: CodeExpectedJsonArray, Message: msgExpectedJsonArray}
+	// ErrExpectedJsonObject is the sentinel error for violations with CodeExpectedJsonObject
+	ErrExpectedJsonObject = &CodeError{Code: CodeExpectedJsonObject, Message: msgExpectedJsonObject}
+	// ErrErrorReading is the sentinel error for violations with CodeErrorReading
+	ErrErrorReading = &CodeError{Code: CodeErrorReading, Message: msgErrorReading}
+	// ErrErrorUnmarshall is the sentinel error for violations with CodeErrorUnmarshall
+	ErrErrorUnmarshall = &CodeError{Code: CodeErrorUnmarshall, Message: msgErrorUnmarshall}
+	// ErrRequestBodyEmpty is the sentinel error for violations with CodeRequestBodyEmpty
+	ErrRequestBodyEmpty = &CodeError{Code: CodeRequestBodyEmpty, Message: msgRequestBodyEmpty}
+	// ErrUnableToDecodeRequest is the sentinel error for violations with CodeUnableToDecodeRequest
+	ErrUnableToDecodeRequest = &CodeError{Code: CodeUnableToDecodeRequest, Message: msgUnableToDecodeRequest}
+	// ErrRequestBodyNotJsonNull is the sentinel error for violations with CodeRequestBodyNotJsonNull
+	ErrRequestBodyNotJsonNull = &CodeError{Code: CodeRequestBodyNotJsonNull, Message: msgRequestBodyNotJsonNull}
+	// ErrRequestBodyNotJsonArray is the sentinel error for violations with CodeRequestBodyNotJsonArray
+	ErrRequestBodyNotJsonArray = &CodeError{Code: CodeRequestBodyNotJsonArray, Message: msgRequestBodyNotJsonArray}
+	// ErrRequestBodyNotJsonObject is the sentinel error for violations with CodeRequestBodyNotJsonObject
+	ErrRequestBodyNotJsonObject = &CodeError{Code: CodeRequestBodyNotJsonObject, Message: msgRequestBodyNotJsonObject}
+	// ErrRequestBodyExpectedJsonArray is the sentinel error for violations with CodeRequestBodyExpectedJsonArray
+	ErrRequestBodyExpectedJsonArray = &CodeError{Code: CodeRequestBodyExpectedJsonArray, Message: msgRequestBodyExpectedJsonArray}
+	// ErrRequestBodyExpectedJsonObject is the sentinel error for violations with CodeRequestBodyExpectedJsonObject
+	ErrRequestBodyExpectedJsonObject = &CodeError{Code: CodeRequestBodyExpectedJsonObject, Message: msgRequestBodyExpectedJsonObject}
+	// ErrRequestQueryParamMultiNotAllowed is the sentinel error for violations with CodeRequestQueryParamMultiNotAllowed
+	ErrRequestQueryParamMultiNotAllowed = &CodeError{Code: CodeRequestQueryParamMultiNotAllowed, Message: msgQueryParamMultiNotAllowed}
+	// ErrRequestQueryParamInvalidType is the sentinel error for violations with CodeRequestQueryParamInvalidType
+	ErrRequestQueryParamInvalidType = &CodeError{Code: CodeRequestQueryParamInvalidType, Message: "Query param invalid type"}
+	// ErrRequestPathParamInvalidType is the sentinel error for violations with CodeRequestPathParamInvalidType
+	ErrRequestPathParamInvalidType = &CodeError{Code: CodeRequestPathParamInvalidType, Message: "Path param invalid type"}
+	// ErrRequestHeaderMultiNotAllowed is the sentinel error for violations with CodeRequestHeaderMultiNotAllowed
+	ErrRequestHeaderMultiNotAllowed = &CodeError{Code: CodeRequestHeaderMultiNotAllowed, Message: msgHeaderMultiNotAllowed}
+	// ErrRequestHeaderInvalidType is the sentinel error for violations with CodeRequestHeaderInvalidType
+	ErrRequestHeaderInvalidType = &CodeError{Code: CodeRequestHeaderInvalidType, Message: "Header invalid type"}
+	// ErrArrayElementMustBeObject is the sentinel error for violations with CodeArrayElementMustBeObject
+	ErrArrayElementMustBeObject = &CodeError{Code: CodeArrayElementMustBeObject, Message: msgArrayElementMustBeObject}
+	// ErrMissingProperty is the sentinel error for violations with CodeMissingProperty
+	ErrMissingProperty = &CodeError{Code: CodeMissingProperty, Message: msgMissingProperty}
+	// ErrUnwantedProperty is the sentinel error for violations with CodeUnwantedProperty
+	ErrUnwantedProperty = &CodeError{Code: CodeUnwantedProperty, Message: msgUnwantedProperty}
+	// ErrUnknownProperty is the sentinel error for violations with CodeUnknownProperty
+	ErrUnknownProperty = &CodeError{Code: CodeUnknownProperty, Message: msgUnknownProperty}
+	// ErrInvalidProperty is the sentinel error for violations with CodeInvalidProperty
+	ErrInvalidProperty = &CodeError{Code: CodeInvalidProperty, Message: msgInvalidProperty}
+	// ErrValueCannotBeNull is the sentinel error for violations with CodeValueCannotBeNull
+	ErrValueCannotBeNull = &CodeError{Code: CodeValueCannotBeNull, Message: msgValueCannotBeNull}
+	// ErrValueExpectedType is the sentinel error for violations with CodeValueExpectedType
+	ErrValueExpectedType = &CodeError{Code: CodeValueExpectedType, Message: "Value of incorrect type"}
+	// ErrValueMustBeObject is the sentinel error for violations with CodeValueMustBeObject
+	ErrValueMustBeObject = &CodeError{Code: CodeValueMustBeObject, Message: msgValueMustBeObject}
+	// ErrValueMustBeArray is the sentinel error for violations with CodeValueMustBeArray
+	ErrValueMustBeArray = &CodeError{Code: CodeValueMustBeArray, Message: msgValueMustBeArray}
+	// ErrValueMustBeObjectOrArray is the sentinel error for violations with CodeValueMustBeObjectOrArray
+	ErrValueMustBeObjectOrArray = &CodeError{Code: CodeValueMustBeObjectOrArray, Message: msgValueMustBeObjectOrArray}
+	// ErrPropertyObjectValidatorError is the sentinel error for violations with CodePropertyObjectValidatorError
+	ErrPropertyObjectValidatorError = &CodeError{Code: CodePropertyObjectValidatorError, Message: msgPropertyObjectValidatorError}
+	// ErrInvalidPropertyName is the sentinel error for violations with CodeInvalidPropertyName
+	ErrInvalidPropertyName = &CodeError{Code: CodeInvalidPropertyName, Message: msgInvalidPropertyName}
+	// ErrPropertyValueMustBeObject is the sentinel error for violations with CodePropertyValueMustBeObject
+	ErrPropertyValueMustBeObject = &CodeError{Code: CodePropertyValueMustBeObject, Message: msgPropertyValueMustBeObject}
+	// ErrPropertyRequiredWhen is the sentinel error for violations with CodePropertyRequiredWhen
+	ErrPropertyRequiredWhen = &CodeError{Code: CodePropertyRequiredWhen, Message: msgPropertyRequiredWhen}
+	// ErrPropertyUnwantedWhen is the sentinel error for violations with CodePropertyUnwantedWhen
+	ErrPropertyUnwantedWhen = &CodeError{Code: CodePropertyUnwantedWhen, Message: msgPropertyUnwantedWhen}
+	// ErrArrayElementMustNotBeNull is the sentinel error for violations with CodeArrayElementMustNotBeNull
+	ErrArrayElementMustNotBeNull = &CodeError{Code: CodeArrayElementMustNotBeNull, Message: msgArrayElementMustNotBeNull}
+	// ErrOnlyProperty is the sentinel error for violations with CodeOnlyProperty
+	ErrOnlyProperty = &CodeError{Code: CodeOnlyProperty, Message: msgOnlyProperty}
+	// ErrDeprecatedProperty is the sentinel error for violations with CodeDeprecatedProperty
+	ErrDeprecatedProperty = &CodeError{Code: CodeDeprecatedProperty, Message: msgDeprecatedProperty}
+	// ErrValidatorConstraintFail is the sentinel error for violations with CodeValidatorConstraintFail
+	ErrValidatorConstraintFail = &CodeError{Code: CodeValidatorConstraintFail, Message: "Validator constraint failed"}
+	// ErrPropertyConstraintFail is the sentinel error for violations with CodePropertyConstraintFail
+	ErrPropertyConstraintFail = &CodeError{Code: CodePropertyConstraintFail, Message: "Property constraint failed"}
+)
+
+// Error implements error.Error - so that a Violation can be used as an error
+//
+// The error message is the violation message - prefixed with the full path of the violating property (where there is one)
+func (v *Violation) Error() string {
+	if path := v.FullPath(); path != "" {
+		return path + ": " + v.Message
+	}
+	return v.Message
+}
+
+// Is enables errors.Is to match the violation against a sentinel CodeError (e.g. ErrMissingProperty)
+func (v *Violation) Is(target error) bool {
+	if ce, ok := target.(*CodeError); ok {
+		code, _ := violationCode(v)
+		return code == ce.Code
+	}
+	return false
+}
+
+// Unwrap returns any errors carried by the violation (i.e. any errors in the Violation.Codes) - enabling errors.Is and
+// errors.As to find underlying errors (e.g. JSON decoding errors or errors added by custom constraints
+// using ValidatorContext.AddViolationErrorForCurrent)
+func (v *Violation) Unwrap() []error {
+	result := make([]error, 0)
+	for _, c := range v.Codes {
+		if err, ok := c.(error); ok && err != nil {
+			result = append(result, err)
+		}
+	}
+	return result
+}
+
+// Unwrap returns the violations (as errors) - enabling errors.Is and errors.As to match against violations, e.g.
+//
+//	errors.Is(err, valix.ErrMissingProperty)
+//
+//	var violation *valix.Violation
+//	errors.As(err, &violation)
+func (ve *ValidationError) Unwrap() []error {
+	violations := ve.violations
+	if violations == nil {
+		violations = ve.Violations
+	}
+	result := make([]error, 0, len(violations))
+	for _, v := range violations {
+		result = append(result, v)
+	}
+	return result
+}
+
+// newValidationError returns a ValidationError for the validation result - or nil if the validation was ok
+func newValidationError(ok bool, violations []*Violation) error {
+	if ok {
+		return nil
+	}
+	if len(violations) > 1 {
+		SortViolationsByPathAndProperty(violations)
+	}
+	result := &ValidationError{
+		Message:    msgErrorUnmarshall,
+		Violations: violations,
+		violations: violations,
+	}
+	for _, v := range violations {
+		if v.IsError() {
+			result.Message = v.Message
+			result.IsBadRequest = v.BadRequest
+			break
+		}
+	}
+	return result
+}
@@ -0,0 +1,177 @@
+package valix
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testErrorsValidator = &Validator{
+	Properties: Properties{
+		"name": {Type: JsonString, Mandatory: true},
+		"age":  {Type: JsonInteger, Constraints: Constraints{&Minimum{Value: 18}}},
+	},
+}
+
+func TestValidationError_Is(t *testing.T) {
+	err := testErrorsValidator.ValidateErr(map[string]interface{}{"age": float64(1), "foo": true})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrMissingProperty))
+	require.True(t, errors.Is(err, ErrUnknownProperty))
+	require.True(t, errors.Is(err, ErrPropertyConstraintFail))
+	require.False(t, errors.Is(err, ErrValueCannotBeNull))
+	var ve *ValidationError
+	require.True(t, errors.As(err, &ve))
+	require.Equal(t, 3, len(ve.Violations))
+
+	err = testErrorsValidator.ValidateErr(map[string]interface{}{"name": "x", "age": float64(18)})
+	require.NoError(t, err)
+}
+
+func TestValidationError_AsViolation(t *testing.T) {
+	err := testErrorsValidator.ValidateErr(map[string]interface{}{"name": "x", "age": float64(1)})
+	require.Error(t, err)
+	var violation *Violation
+	require.True(t, errors.As(err, &violation))
+	require.Equal(t, "age", violation.Property)
+	require.Equal(t, CodePropertyConstraintFail, violation.Code)
+	require.Equal(t, "age: "+violation.Message, violation.Error())
+	require.Equal(t, violation.Message, err.Error())
+}
+
+func TestViolation_Error(t *testing.T) {
+	require.Equal(t, "foo.bar: msg", NewViolation("bar", "foo", "msg").Error())
+	require.Equal(t, "msg", NewEmptyViolation("msg").Error())
+	require.True(t, errors.Is(NewEmptyViolation("msg", CodeMissingProperty), ErrMissingProperty))
+	require.False(t, errors.Is(NewEmptyViolation("msg"), ErrMissingProperty))
+	require.Equal(t, msgMissingProperty, ErrMissingProperty.Error())
+}
+
+type testCustomError struct {
+	reason string
+}
+
+func (e *testCustomError) Error() string {
+	return e.reason
+}
+
+func TestValidationError_CustomConstraintErrors(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"foo": {
+				Type: JsonString,
+				Constraints: Constraints{
+					NewCustomConstraint(func(value interface{}, vcx *ValidatorContext, this *CustomConstraint) (bool, string) {
+						vcx.AddViolationErrorForCurrent(&testCustomError{reason: "not a good foo"}, CodePropertyConstraintFail)
+						return true, ""
+					}, ""),
+				},
+			},
+		},
+	}
+	err := v.ValidateErr(map[string]interface{}{"foo": "x"})
+	require.Error(t, err)
+	require.Equal(t, "not a good foo", err.Error())
+	var ce *testCustomError
+	require.True(t, errors.As(err, &ce))
+	require.Equal(t, "not a good foo", ce.reason)
+	require.True(t, errors.Is(err, ErrPropertyConstraintFail))
+}
+
+func TestValidationError_DecodeErrors(t *testing.T) {
+	_, err := testErrorsValidator.ValidateStringErr(`{`)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrUnableToDecode))
+	var ve *ValidationError
+	require.True(t, errors.As(err, &ve))
+	require.True(t, ve.IsBadRequest)
+	var se *json.SyntaxError
+	require.False(t, errors.As(err, &se)) // unexpected EOF is not a syntax error
+
+	_, err = testErrorsValidator.ValidateStringErr(`{"name": x}`)
+	require.True(t, errors.As(err, &se))
+
+	err = testErrorsValidator.ValidateInto([]byte(`null`), &struct{}{})
+	require.Error(t, err)
+	require.True(t, errors.As(err, &ve))
+	require.Equal(t, 0, len(ve.Violations))
+	require.True(t, errors.Is(err, ErrNotJsonNull))
+}
+
+func TestValidationError_WarningsOnly(t *testing.T) {
+	v := &Validator{
+		Properties: Properties{
+			"foo": {Type: JsonString, OasInfo: &OasInfo{Deprecated: true}},
+		},
+	}
+	require.NoError(t, v.ValidateErr(map[string]interface{}{"foo": "x"}))
+
+	err := v.ValidateErr(map[string]interface{}{"foo": "x", "bar": "x"})
+	require.Error(t, err)
+	require.Equal(t, msgUnknownProperty, err.Error())
+	// warnings are not part of the error...
+	require.False(t, errors.Is(err, ErrDeprecatedProperty))
+}
+
+func TestValidatorErrVariants(t *testing.T) {
+	type target struct {
+		Name string `json:"name"`
+	}
+	obj, err := testErrorsValidator.ValidateStringErr(`{"name": "x"}`)
+	require.NoError(t, err)
+	require.NotNil(t, obj)
+	_, err = testErrorsValidator.ValidateReaderErr(strings.NewReader(`{}`))
+	require.True(t, errors.Is(err, ErrMissingProperty))
+
+	tv := &target{}
+	_, err = testErrorsValidator.ValidateStringIntoErr(`{"name": "x"}`, tv)
+	require.NoError(t, err)
+	require.Equal(t, "x", tv.Name)
+	_, err = testErrorsValidator.ValidateReaderIntoErr(strings.NewReader(`{}`), tv)
+	require.True(t, errors.Is(err, ErrMissingProperty))
+
+	err = (&Validator{AllowArray: true}).ValidateArrayOfErr([]interface{}{map[string]interface{}{"foo": true}})
+	require.True(t, errors.Is(err, ErrUnknownProperty))
+
+	req, _ := http.NewRequest("POST", "http://example.com", nil)
+	_, err = testErrorsValidator.RequestValidateErr(req)
+	require.True(t, errors.Is(err, ErrRequestBodyEmpty))
+	_, err = testErrorsValidator.RequestValidateIntoErr(req, tv)
+	require.True(t, errors.Is(err, ErrRequestBodyEmpty))
+	req, _ = http.NewRequest("POST", "http://example.com", strings.NewReader(`{"name": "y"}`))
+	_, err = testErrorsValidator.RequestValidateIntoErr(req, tv)
+	require.NoError(t, err)
+	require.Equal(t, "y", tv.Name)
+
+	qv := &Validator{
+		Properties: Properties{
+			"flag": {Type: JsonBoolean, Mandatory: true},
+		},
+	}
+	req, _ = http.NewRequest("GET", "http://example.com?flag=x", nil)
+	_, err = qv.RequestQueryValidateErr(req)
+	require.True(t, errors.Is(err, ErrRequestQueryParamInvalidType))
+	_, err = qv.RequestQueryValidateIntoErr(req, &struct{}{})
+	require.True(t, errors.Is(err, ErrRequestQueryParamInvalidType))
+
+	pv := &PropertyValidator{Type: JsonString, NotNull: true}
+	require.True(t, errors.Is(pv.ValidateErr(nil), ErrValueCannotBeNull))
+	require.NoError(t, pv.ValidateErr("x"))
+}
+
+func TestAPI_RequestValidateErr(t *testing.T) {
+	api := testApi(t)
+	req, _ := http.NewRequest("GET", "http://example.com/foos/-1", nil)
+	opReq, err := api.RequestValidateErr(req)
+	require.NotNil(t, opReq)
+	require.True(t, errors.Is(err, ErrPropertyConstraintFail))
+
+	req, _ = http.NewRequest("GET", "http://example.com/unknown", nil)
+	opReq, err = api.RequestValidateErr(req)
+	require.Nil(t, opReq)
+	require.Equal(t, ErrNoOperation, err)
+}
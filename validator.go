@@ -45,6 +45,30 @@ type Validator struct {
 	// Note: If any of the properties in the validator has PropertyValidator.Order set to a non-zero value
 	// then ordered property checks are also performed
 	OrderedPropertyChecks bool
+	// IncludeRejectedValues determines whether violations include the rejected value (see Violation.Value)
+	//
+	// Note: Only the setting on the root (starting) validator is used
+	IncludeRejectedValues bool
+	// IncludeSourcePositions determines whether violations include the position (byte offset, line and column) of the
+	// offending value in the raw JSON (see Violation.Position)
+	//
+	// Only applies when validating raw JSON (e.g. Validator.ValidateReader, Validator.RequestValidate) - and also
+	// reports the position at which decoding failed for violations with CodeUnableToDecode or CodeUnableToDecodeRequest
+	//
+	// Note: Only the setting on the root (starting) validator is used
+	IncludeSourcePositions bool
+	// PathFormat is the format of paths in violations (see Violation.Path) - default is PathFormatDotted
+	//
+	// Note: Only the setting on the root (starting) validator is used (see also Validator.WithPathFormat and the
+	// WithPathFormat validate option)
+	PathFormat PathFormat
+	// I18n is the I18n provider used to obtain the I18nContext (i.e. the language and translations used for
+	// violation messages) - if nil, DefaultI18nProvider is used
+	//
+	// This allows validators (e.g. for different APIs in the same binary) to use different translations
+	//
+	// Note: Only the setting on the root (starting) validator is used (see also Validator.WithI18n)
+	I18n I18n
 	// WhenConditions is the condition tokens that dictate under which conditions this validator is to be checked
 	//
 	// Condition tokens can be set and unset during validation to allow polymorphism of validation
@@ -141,7 +165,10 @@ const (
 	CodeArrayElementMustNotBeNull = 42221
 	msgOnlyProperty               = "Property cannot be present with other properties"
 	// CodeOnlyProperty is the violation code when the validator detects a property that is specified as being an only property but has other properties present
-	CodeOnlyProperty = 42222
+	CodeOnlyProperty      = 42222
+	msgDeprecatedProperty = "Property is deprecated"
+	// CodeDeprecatedProperty is the violation code (with SeverityWarning) when the validator detects a property present that is deprecated (i.e. has OasInfo.Deprecated set)
+	CodeDeprecatedProperty = 42223
 	// CodeValidatorConstraintFail is the violation code when the validator fails one of its Validator.Constraints
 	CodeValidatorConstraintFail = 42298
 )
@@ -176,18 +203,46 @@ type ConditionalVariant struct {
 // or as represented by (if the body was a JSON array)
 //   []interface{}
 func (v *Validator) RequestValidate(req *http.Request, initialConditions ...string) (bool, []*Violation, interface{}) {
-	tmpVcx := newEmptyValidatorContext(obtainI18nProvider().ContextFromRequest(req))
-	ok, obj := v.decodeRequestBody(req.Body, tmpVcx)
+	return v.RequestValidateWith(req, WithConditions(initialConditions...))
+}
+
+// RequestValidateWith is the same as Validator.RequestValidate - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) RequestValidateWith(req *http.Request, options ...ValidateOption) (bool, []*Violation, interface{}) {
+	settings := newValidateSettings(options)
+	i18ctx := settings.i18nContext(v.i18nProvider(), req)
+	tmpVcx := newEmptyValidatorContext(i18ctx)
+	body, positions, err := v.readSourcePositions(req.Body)
+	if err != nil {
+		tmpVcx.AddViolation(newBadRequestViolation(tmpVcx, msgErrorReading, CodeErrorReading, err))
+		return false, tmpVcx.violations, nil
+	}
+	ok, obj := v.decodeRequestBody(body, tmpVcx)
 	if !ok {
+		positions.apply(tmpVcx.violations)
 		return false, tmpVcx.violations, nil
 	}
-	vcx := newValidatorContext(obj, v, v.StopOnFirst, obtainI18nProvider().ContextFromRequest(req))
+	vcx := newValidatorContext(obj, v, v.StopOnFirst, i18ctx)
 	vcx.setConditionsFromRequest(req)
-	vcx.setInitialConditions(initialConditions...)
+	vcx.applySettings(settings)
 	v.validateObjectOrArray(vcx, obj, true)
+	positions.applyContext(vcx)
 	return vcx.ok, vcx.violations, obj
 }
 
+// RequestValidateErr is the same as Validator.RequestValidate - except that it returns an error (a *ValidationError)
+// if validation fails
+func (v *Validator) RequestValidateErr(req *http.Request, initialConditions ...string) (interface{}, error) {
+	return v.RequestValidateErrWith(req, WithConditions(initialConditions...))
+}
+
+// RequestValidateErrWith is the same as Validator.RequestValidateErr - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) RequestValidateErrWith(req *http.Request, options ...ValidateOption) (interface{}, error) {
+	ok, violations, obj := v.RequestValidateWith(req, options...)
+	return obj, newValidationError(ok, violations)
+}
+
 func (v *Validator) decodeRequestBody(r io.Reader, vcx *ValidatorContext) (bool, interface{}) {
 	if r == nil {
 		vcx.AddViolation(newBadRequestViolation(vcx, msgRequestBodyEmpty, CodeRequestBodyEmpty, nil))
@@ -207,12 +262,30 @@ func (v *Validator) decodeRequestBody(r io.Reader, vcx *ValidatorContext) (bool,
 // Where the JSON object is represented as an unmarshalled
 //   map[string]interface{}
 func (v *Validator) Validate(obj map[string]interface{}, initialConditions ...string) (bool, []*Violation) {
-	vcx := newValidatorContext(obj, v, v.StopOnFirst, obtainI18nProvider().DefaultContext())
-	vcx.setInitialConditions(initialConditions...)
+	return v.ValidateWith(obj, WithConditions(initialConditions...))
+}
+
+// ValidateWith is the same as Validator.Validate - except that it accepts validate options (see ValidateOption)
+func (v *Validator) ValidateWith(obj map[string]interface{}, options ...ValidateOption) (bool, []*Violation) {
+	obj, _ = nativeToJsonMap(obj)
+	settings := newValidateSettings(options)
+	vcx := newValidatorContext(obj, v, v.StopOnFirst, settings.i18nContext(v.i18nProvider(), nil))
+	vcx.applySettings(settings)
 	v.validate(obj, vcx)
 	return vcx.ok, vcx.violations
 }
 
+// ValidateErr is the same as Validator.Validate - except that it returns an error (a *ValidationError)
+// if validation fails
+func (v *Validator) ValidateErr(obj map[string]interface{}, initialConditions ...string) error {
+	return v.ValidateErrWith(obj, WithConditions(initialConditions...))
+}
+
+// ValidateErrWith is the same as Validator.ValidateErr - except that it accepts validate options (see ValidateOption)
+func (v *Validator) ValidateErrWith(obj map[string]interface{}, options ...ValidateOption) error {
+	return newValidationError(v.ValidateWith(obj, options...))
+}
+
 // ValidateArrayOf Performs validation on each element of the supplied JSON array
 //
 // Where the JSON array is represented as an unmarshalled
@@ -220,27 +293,76 @@ func (v *Validator) Validate(obj map[string]interface{}, initialConditions ...st
 // and each item of the slice is expected to be a JSON object represented as an unmarshalled
 //   map[string]interface{}
 func (v *Validator) ValidateArrayOf(arr []interface{}, initialConditions ...string) (bool, []*Violation) {
-	vcx := newValidatorContext(arr, v, v.StopOnFirst, obtainI18nProvider().DefaultContext())
-	vcx.setInitialConditions(initialConditions...)
+	return v.ValidateArrayOfWith(arr, WithConditions(initialConditions...))
+}
+
+// ValidateArrayOfWith is the same as Validator.ValidateArrayOf - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) ValidateArrayOfWith(arr []interface{}, options ...ValidateOption) (bool, []*Violation) {
+	arr, _ = nativeToJsonSlice(arr)
+	settings := newValidateSettings(options)
+	vcx := newValidatorContext(arr, v, v.StopOnFirst, settings.i18nContext(v.i18nProvider(), nil))
+	vcx.applySettings(settings)
 	v.validateArrayOf(arr, vcx)
 	return vcx.ok, vcx.violations
 }
 
+// ValidateArrayOfErr is the same as Validator.ValidateArrayOf - except that it returns an error (a *ValidationError)
+// if validation fails
+func (v *Validator) ValidateArrayOfErr(arr []interface{}, initialConditions ...string) error {
+	return v.ValidateArrayOfErrWith(arr, WithConditions(initialConditions...))
+}
+
+// ValidateArrayOfErrWith is the same as Validator.ValidateArrayOfErr - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) ValidateArrayOfErrWith(arr []interface{}, options ...ValidateOption) error {
+	return newValidationError(v.ValidateArrayOfWith(arr, options...))
+}
+
 // ValidateReader performs validation on the supplied reader (representing JSON)
 func (v *Validator) ValidateReader(r io.Reader, initialConditions ...string) (bool, []*Violation, interface{}) {
+	return v.ValidateReaderWith(r, WithConditions(initialConditions...))
+}
+
+// ValidateReaderWith is the same as Validator.ValidateReader - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) ValidateReaderWith(r io.Reader, options ...ValidateOption) (bool, []*Violation, interface{}) {
+	settings := newValidateSettings(options)
+	i18ctx := settings.i18nContext(v.i18nProvider(), nil)
+	r, positions, err := v.readSourcePositions(r)
+	if err != nil {
+		errVcx := newEmptyValidatorContext(i18ctx)
+		errVcx.AddViolation(newBadRequestViolation(errVcx, msgErrorReading, CodeErrorReading, err))
+		return false, errVcx.violations, nil
+	}
 	decoder := getDefaultDecoderProvider().NewDecoder(r, v.UseNumber)
 	var obj interface{} = reflect.Interface
 	if err := decoder.Decode(&obj); err != nil {
-		vcx := newEmptyValidatorContext(obtainI18nProvider().DefaultContext())
+		vcx := newEmptyValidatorContext(i18ctx)
 		vcx.AddViolation(newBadRequestViolation(vcx, msgUnableToDecode, CodeUnableToDecode, err))
+		positions.applyContext(vcx)
 		return vcx.ok, vcx.violations, nil
 	}
-	vcx := newValidatorContext(obj, v, v.StopOnFirst, obtainI18nProvider().DefaultContext())
-	vcx.setInitialConditions(initialConditions...)
+	vcx := newValidatorContext(obj, v, v.StopOnFirst, i18ctx)
+	vcx.applySettings(settings)
 	v.validateObjectOrArray(vcx, obj, false)
+	positions.applyContext(vcx)
 	return vcx.ok, vcx.violations, obj
 }
 
+// ValidateReaderErr is the same as Validator.ValidateReader - except that it returns an error (a *ValidationError)
+// if validation fails
+func (v *Validator) ValidateReaderErr(r io.Reader, initialConditions ...string) (interface{}, error) {
+	return v.ValidateReaderErrWith(r, WithConditions(initialConditions...))
+}
+
+// ValidateReaderErrWith is the same as Validator.ValidateReaderErr - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) ValidateReaderErrWith(r io.Reader, options ...ValidateOption) (interface{}, error) {
+	ok, violations, obj := v.ValidateReaderWith(r, options...)
+	return obj, newValidationError(ok, violations)
+}
+
 func (v *Validator) validateObjectOrArray(vcx *ValidatorContext, obj interface{}, isRequest bool) {
 	var violation *Violation = nil
 	if obj != nil {
@@ -280,10 +402,18 @@ func (v *Validator) validateObjectOrArray(vcx *ValidatorContext, obj interface{}
 	}
 }
 
+// ValidationError is the error returned by validation methods that return an error (e.g. Validator.ValidateInto,
+// Validator.ValidateErr)
+//
+// A ValidationError unwraps to its violations (see ValidationError.Unwrap) - so errors.Is can be used to check for
+// specific violation codes (e.g. errors.Is(err, ErrMissingProperty)) and errors.As can be used to obtain
+// a *Violation (or any error carried by a violation)
 type ValidationError struct {
 	Message      string
 	Violations   []*Violation
 	IsBadRequest bool
+	// violations is all the violations (Violations may be emptied for bad requests)
+	violations []*Violation
 }
 
 func (ve *ValidationError) Error() string {
@@ -295,52 +425,52 @@ func (ve *ValidationError) Error() string {
 //
 // If validation is unsuccessful (i.e. any violations) this method returns a ValidationError
 func (v *Validator) ValidateInto(data []byte, value interface{}, initialConditions ...string) error {
+	return v.ValidateIntoWith(data, value, WithConditions(initialConditions...))
+}
+
+// ValidateIntoWith is the same as Validator.ValidateInto - except that it accepts validate options (see ValidateOption)
+func (v *Validator) ValidateIntoWith(data []byte, value interface{}, options ...ValidateOption) error {
 	r := bytes.NewReader(data)
-	ok, violations, _ := v.ValidateReaderInto(r, value, initialConditions...)
-	if ok {
-		return nil
-	}
-	isBad := false
-	msg := msgErrorUnmarshall
-	if l := len(violations); l == 1 {
-		msg = violations[0].Message
-		isBad = violations[0].BadRequest
-		if isBad {
-			violations = []*Violation{}
-		}
-	} else if l > 0 {
-		isBad = violations[0].BadRequest
-		SortViolationsByPathAndProperty(violations)
-		msg = violations[0].Message
-	}
-	return &ValidationError{
-		Message:      msg,
-		Violations:   violations,
-		IsBadRequest: isBad,
+	ok, violations, _ := v.ValidateReaderIntoWith(r, value, options...)
+	err := newValidationError(ok, violations)
+	if ve, isVe := err.(*ValidationError); isVe && ve.IsBadRequest && len(violations) == 1 {
+		ve.Violations = []*Violation{}
 	}
+	return err
 }
 
 // ValidateReaderInto performs validation on the supplied reader (representing JSON)
 // and, if validation successful, attempts to unmarshall the JSON into the supplied value
 func (v *Validator) ValidateReaderInto(r io.Reader, value interface{}, initialConditions ...string) (bool, []*Violation, interface{}) {
+	return v.ValidateReaderIntoWith(r, value, WithConditions(initialConditions...))
+}
+
+// ValidateReaderIntoWith is the same as Validator.ValidateReaderInto - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) ValidateReaderIntoWith(r io.Reader, value interface{}, options ...ValidateOption) (bool, []*Violation, interface{}) {
+	settings := newValidateSettings(options)
+	i18ctx := settings.i18nContext(v.i18nProvider(), nil)
 	// we'll need to read the reader twice - first into our representation (for validation) and then into the value
 	buffer, err := ioutil.ReadAll(r)
 	if err != nil {
-		errVcx := newEmptyValidatorContext(obtainI18nProvider().DefaultContext())
+		errVcx := newEmptyValidatorContext(i18ctx)
 		errVcx.AddViolation(newBadRequestViolation(errVcx, msgErrorReading, CodeErrorReading, err))
 		return false, errVcx.violations, nil
 	}
+	positions := v.newSourcePositions(buffer)
 	initialReader := bytes.NewReader(buffer)
 	decoder := getDefaultDecoderProvider().NewDecoder(initialReader, v.UseNumber)
 	var obj interface{} = reflect.Interface
 	if dErr := decoder.Decode(&obj); dErr != nil {
-		errVcx := newEmptyValidatorContext(nil)
+		errVcx := newEmptyValidatorContext(i18ctx)
 		errVcx.AddViolation(newBadRequestViolation(errVcx, msgUnableToDecode, CodeUnableToDecode, dErr))
+		positions.apply(errVcx.violations)
 		return false, errVcx.violations, nil
 	}
-	vcx := newValidatorContext(obj, v, v.StopOnFirst, obtainI18nProvider().DefaultContext())
-	vcx.setInitialConditions(initialConditions...)
+	vcx := newValidatorContext(obj, v, v.StopOnFirst, i18ctx)
+	vcx.applySettings(settings)
 	v.validateObjectOrArray(vcx, obj, false)
+	positions.applyContext(vcx)
 	if !vcx.ok {
 		return false, vcx.violations, obj
 	}
@@ -354,21 +484,77 @@ func (v *Validator) ValidateReaderInto(r io.Reader, value interface{}, initialCo
 	return vcx.ok, vcx.violations, obj
 }
 
+// ValidateReaderIntoErr is the same as Validator.ValidateReaderInto - except that it returns an error
+// (a *ValidationError) if validation fails
+func (v *Validator) ValidateReaderIntoErr(r io.Reader, value interface{}, initialConditions ...string) (interface{}, error) {
+	return v.ValidateReaderIntoErrWith(r, value, WithConditions(initialConditions...))
+}
+
+// ValidateReaderIntoErrWith is the same as Validator.ValidateReaderIntoErr - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) ValidateReaderIntoErrWith(r io.Reader, value interface{}, options ...ValidateOption) (interface{}, error) {
+	ok, violations, obj := v.ValidateReaderIntoWith(r, value, options...)
+	return obj, newValidationError(ok, violations)
+}
+
 // ValidateString performs validation on the supplied string (representing JSON)
 func (v *Validator) ValidateString(s string, initialConditions ...string) (bool, []*Violation, interface{}) {
-	return v.ValidateReader(strings.NewReader(s), initialConditions...)
+	return v.ValidateStringWith(s, WithConditions(initialConditions...))
+}
+
+// ValidateStringWith is the same as Validator.ValidateString - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) ValidateStringWith(s string, options ...ValidateOption) (bool, []*Violation, interface{}) {
+	return v.ValidateReaderWith(strings.NewReader(s), options...)
+}
+
+// ValidateStringErr is the same as Validator.ValidateString - except that it returns an error (a *ValidationError)
+// if validation fails
+func (v *Validator) ValidateStringErr(s string, initialConditions ...string) (interface{}, error) {
+	return v.ValidateStringErrWith(s, WithConditions(initialConditions...))
+}
+
+// ValidateStringErrWith is the same as Validator.ValidateStringErr - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) ValidateStringErrWith(s string, options ...ValidateOption) (interface{}, error) {
+	return v.ValidateReaderErrWith(strings.NewReader(s), options...)
 }
 
 // ValidateStringInto performs validation on the supplied string (representing JSON)
 // and, if validation successful, attempts to unmarshall the JSON into the supplied value
 func (v *Validator) ValidateStringInto(s string, value interface{}, initialConditions ...string) (bool, []*Violation, interface{}) {
-	return v.ValidateReaderInto(strings.NewReader(s), value, initialConditions...)
+	return v.ValidateStringIntoWith(s, value, WithConditions(initialConditions...))
+}
+
+// ValidateStringIntoWith is the same as Validator.ValidateStringInto - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) ValidateStringIntoWith(s string, value interface{}, options ...ValidateOption) (bool, []*Violation, interface{}) {
+	return v.ValidateReaderIntoWith(strings.NewReader(s), value, options...)
+}
+
+// ValidateStringIntoErr is the same as Validator.ValidateStringInto - except that it returns an error
+// (a *ValidationError) if validation fails
+func (v *Validator) ValidateStringIntoErr(s string, value interface{}, initialConditions ...string) (interface{}, error) {
+	return v.ValidateStringIntoErrWith(s, value, WithConditions(initialConditions...))
+}
+
+// ValidateStringIntoErrWith is the same as Validator.ValidateStringIntoErr - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) ValidateStringIntoErrWith(s string, value interface{}, options ...ValidateOption) (interface{}, error) {
+	return v.ValidateReaderIntoErrWith(strings.NewReader(s), value, options...)
 }
 
 // RequestValidateInto performs validation on the request body (representing JSON)
 // and, if validation successful, attempts to unmarshall the JSON into the supplied value
 func (v *Validator) RequestValidateInto(req *http.Request, value interface{}, initialConditions ...string) (bool, []*Violation, interface{}) {
-	i18ctx := obtainI18nProvider().ContextFromRequest(req)
+	return v.RequestValidateIntoWith(req, value, WithConditions(initialConditions...))
+}
+
+// RequestValidateIntoWith is the same as Validator.RequestValidateInto - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) RequestValidateIntoWith(req *http.Request, value interface{}, options ...ValidateOption) (bool, []*Violation, interface{}) {
+	settings := newValidateSettings(options)
+	i18ctx := settings.i18nContext(v.i18nProvider(), req)
 	if req.Body == nil {
 		errVcx := newEmptyValidatorContext(i18ctx)
 		errVcx.AddViolation(newBadRequestViolation(i18ctx, msgRequestBodyEmpty, CodeRequestBodyEmpty, nil))
@@ -381,16 +567,19 @@ func (v *Validator) RequestValidateInto(req *http.Request, value interface{}, in
 		errVcx.AddViolation(newBadRequestViolation(i18ctx, msgErrorReading, CodeErrorReading, err))
 		return false, errVcx.violations, nil
 	}
+	positions := v.newSourcePositions(buffer)
 	initialReader := bytes.NewReader(buffer)
 	tmpVcx := newEmptyValidatorContext(i18ctx)
 	ok, obj := v.decodeRequestBody(initialReader, tmpVcx)
 	if !ok {
+		positions.apply(tmpVcx.violations)
 		return false, tmpVcx.violations, nil
 	}
 	vcx := newValidatorContext(obj, v, v.StopOnFirst, i18ctx)
 	vcx.setConditionsFromRequest(req)
-	vcx.setInitialConditions(initialConditions...)
+	vcx.applySettings(settings)
 	v.validateObjectOrArray(vcx, obj, true)
+	positions.applyContext(vcx)
 	if !vcx.ok {
 		return false, vcx.violations, obj
 	}
@@ -404,6 +593,19 @@ func (v *Validator) RequestValidateInto(req *http.Request, value interface{}, in
 	return vcx.ok, vcx.violations, obj
 }
 
+// RequestValidateIntoErr is the same as Validator.RequestValidateInto - except that it returns an error
+// (a *ValidationError) if validation fails
+func (v *Validator) RequestValidateIntoErr(req *http.Request, value interface{}, initialConditions ...string) (interface{}, error) {
+	return v.RequestValidateIntoErrWith(req, value, WithConditions(initialConditions...))
+}
+
+// RequestValidateIntoErrWith is the same as Validator.RequestValidateIntoErr - except that it accepts validate options
+// (see ValidateOption)
+func (v *Validator) RequestValidateIntoErrWith(req *http.Request, value interface{}, options ...ValidateOption) (interface{}, error) {
+	ok, violations, obj := v.RequestValidateIntoWith(req, value, options...)
+	return obj, newValidationError(ok, violations)
+}
+
 func (v *Validator) validate(obj map[string]interface{}, vcx *ValidatorContext) {
 	if checkConstraints(obj, vcx, v.Constraints) {
 		return
@@ -481,14 +683,17 @@ func (v *Validator) checkProperties(obj map[string]interface{}, vcx *ValidatorCo
 	for i, propertyName := range names {
 		pv := pvs[i]
 		actualValue, present := obj[propertyName]
-		if present && !vcx.meetsUnwantedConditions(pv.UnwantedConditions) {
+		if present && (!vcx.meetsUnwantedConditions(pv.UnwantedConditions) || vcx.isDirectionalUnwanted(pv)) {
 			vcx.addViolationPropertyForCurrent(propertyName, msgUnwantedProperty, CodeUnwantedProperty, propertyName)
 		} else if vcx.meetsWhenConditions(pv.WhenConditions) {
 			if !present {
-				if pv.Mandatory && (len(pv.MandatoryWhen) == 0 || vcx.meetsWhenConditions(pv.MandatoryWhen)) {
+				if pv.Mandatory && (len(pv.MandatoryWhen) == 0 || vcx.meetsWhenConditions(pv.MandatoryWhen)) && vcx.isDirectionalMandatory(pv) {
 					vcx.addViolationPropertyForCurrent(propertyName, msgMissingProperty, CodeMissingProperty, propertyName)
 				}
 			} else {
+				if pv.OasInfo != nil && pv.OasInfo.Deprecated {
+					vcx.addSeverityViolationPropertyForCurrent(SeverityWarning, propertyName, msgDeprecatedProperty, CodeDeprecatedProperty, propertyName)
+				}
 				vcx.pushPathProperty(propertyName, actualValue, pv)
 				pv.validate(actualValue, vcx)
 				vcx.popPath()
@@ -688,7 +893,7 @@ func checkConstraints(obj map[string]interface{}, vcx *ValidatorContext, constra
 	for i, constraint := range constraints {
 		if ok, msg := constraint.Check(obj, vcx); !ok {
 			// the message is already translated by the constraint
-			vcx.addTranslatedViolationForCurrent(msg, CodeValidatorConstraintFail, i)
+			vcx.addConstraintViolationForCurrent(msg, constraint, CodeValidatorConstraintFail, i)
 		}
 		if !vcx.continueAll {
 			return true
@@ -697,6 +902,26 @@ func checkConstraints(obj map[string]interface{}, vcx *ValidatorContext, constra
 	return false
 }
 
+// WithPathFormat returns a (shallow) copy of the validator that uses the specified PathFormat - enabling the
+// path format to be selected per validation, e.g.
+//
+//	ok, violations := myValidator.WithPathFormat(valix.PathFormatJsonPointer).Validate(obj)
+func (v *Validator) WithPathFormat(format PathFormat) *Validator {
+	result := *v
+	result.PathFormat = format
+	return &result
+}
+
+// WithI18n returns a (shallow) copy of the validator that uses the specified I18n provider - enabling the
+// translations to be selected per validation, e.g.
+//
+//	ok, violations := myValidator.WithI18n(myI18nProvider).Validate(obj)
+func (v *Validator) WithI18n(i18n I18n) *Validator {
+	result := *v
+	result.I18n = i18n
+	return &result
+}
+
 func (v *Validator) IsOrderedPropertyChecks() bool {
 	if !v.OrderedPropertyChecks {
 		for _, pv := range v.Properties {
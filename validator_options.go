@@ -189,3 +189,27 @@ func (o *optionOrderedPropertyChecks) Apply(on *Validator) error {
 	on.OrderedPropertyChecks = o.setting
 	return nil
 }
+
+// OptionIncludeRejectedValues option for ValidatorFor - sets Validator to include rejected values in violations
+var OptionIncludeRejectedValues Option = &optionIncludeRejectedValues{true}
+
+type optionIncludeRejectedValues struct {
+	setting bool
+}
+
+func (o *optionIncludeRejectedValues) Apply(on *Validator) error {
+	on.IncludeRejectedValues = o.setting
+	return nil
+}
+
+// OptionIncludeSourcePositions option for ValidatorFor - sets Validator to include source positions in violations
+var OptionIncludeSourcePositions Option = &optionIncludeSourcePositions{true}
+
+type optionIncludeSourcePositions struct {
+	setting bool
+}
+
+func (o *optionIncludeSourcePositions) Apply(on *Validator) error {
+	on.IncludeSourcePositions = o.setting
+	return nil
+}
@@ -123,3 +123,23 @@ func TestOptionOrderedPropertyChecks(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, v.OrderedPropertyChecks)
 }
+
+func TestOptionIncludeRejectedValues(t *testing.T) {
+	v, err := ValidatorFor(test{})
+	require.NoError(t, err)
+	require.False(t, v.IncludeRejectedValues)
+
+	v, err = ValidatorFor(test{}, OptionIncludeRejectedValues)
+	require.NoError(t, err)
+	require.True(t, v.IncludeRejectedValues)
+}
+
+func TestOptionIncludeSourcePositions(t *testing.T) {
+	v, err := ValidatorFor(test{})
+	require.NoError(t, err)
+	require.False(t, v.IncludeSourcePositions)
+
+	v, err = ValidatorFor(test{}, OptionIncludeSourcePositions)
+	require.NoError(t, err)
+	require.True(t, v.IncludeSourcePositions)
+}
@@ -199,7 +199,7 @@ func TestValidatorWithObjectConstraint(t *testing.T) {
 	ok, violations := v.Validate(o)
 	require.False(t, ok)
 	require.Equal(t, 1, len(violations))
-	require.Equal(t, fmt.Sprintf(fmtMsgMinMax, 2, tokenInclusive, 3, tokenInclusive), violations[0].Message)
+	require.Equal(t, "Value length must be between 2 (inclusive) and 3 items (inclusive)", violations[0].Message)
 	require.Equal(t, "", violations[0].Path)
 	require.Equal(t, "", violations[0].Property)
 	require.Equal(t, CodeValidatorConstraintFail, violations[0].Codes[0])
@@ -588,7 +588,7 @@ func TestSubPropertyAsArrayValidation(t *testing.T) {
 	require.Equal(t, msgPositiveOrZero, violations[0].Message)
 	require.Equal(t, "person", violations[1].Path)
 	require.Equal(t, "name", violations[1].Property)
-	require.Equal(t, fmt.Sprintf(fmtMsgStringMinMaxLen, 1, tokenInclusive, 255, tokenInclusive), violations[1].Message)
+	require.Equal(t, "String value length must be between 1 (inclusive) and 255 characters (inclusive)", violations[1].Message)
 
 	o["person"] = []interface{}{
 		jsonObject(`{
@@ -0,0 +1,96 @@
+package valixtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+)
+
+// RequestBuilder builds *http.Request for tests (using httptest.NewRequest)
+type RequestBuilder struct {
+	method  string
+	target  string
+	body    []byte
+	query   url.Values
+	headers http.Header
+}
+
+// NewRequest creates a new RequestBuilder for the method and target (e.g. "/foos?limit=10")
+func NewRequest(method string, target string) *RequestBuilder {
+	return &RequestBuilder{
+		method:  method,
+		target:  target,
+		query:   url.Values{},
+		headers: http.Header{},
+	}
+}
+
+// JSON sets the request body (and 'Content-Type' header to "application/json")
+//
+// If the body is a string or []byte it is used as is - otherwise it is marshalled to JSON
+func (rb *RequestBuilder) JSON(body interface{}) *RequestBuilder {
+	switch bt := body.(type) {
+	case string:
+		rb.body = []byte(bt)
+	case []byte:
+		rb.body = bt
+	default:
+		data, err := json.Marshal(body)
+		if err != nil {
+			panic(err)
+		}
+		rb.body = data
+	}
+	rb.headers.Set("Content-Type", "application/json")
+	return rb
+}
+
+// Query adds query param values
+func (rb *RequestBuilder) Query(name string, values ...string) *RequestBuilder {
+	if len(values) == 0 {
+		rb.query.Add(name, "")
+	}
+	for _, v := range values {
+		rb.query.Add(name, v)
+	}
+	return rb
+}
+
+// Header adds a header value
+func (rb *RequestBuilder) Header(name string, value string) *RequestBuilder {
+	rb.headers.Add(name, value)
+	return rb
+}
+
+// AcceptLanguage sets the 'Accept-Language' header (used by valix to determine the language of violation messages)
+func (rb *RequestBuilder) AcceptLanguage(lang string) *RequestBuilder {
+	rb.headers.Set("Accept-Language", lang)
+	return rb
+}
+
+// Build builds the request
+func (rb *RequestBuilder) Build() *http.Request {
+	var body io.Reader
+	if rb.body != nil {
+		body = bytes.NewReader(rb.body)
+	}
+	req := httptest.NewRequest(rb.method, rb.target, body)
+	if len(rb.query) > 0 {
+		q := req.URL.Query()
+		for k, vs := range rb.query {
+			for _, v := range vs {
+				q.Add(k, v)
+			}
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+	for k, vs := range rb.headers {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+	return req
+}
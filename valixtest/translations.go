@@ -0,0 +1,57 @@
+package valixtest
+
+import (
+	"strings"
+
+	"github.com/marrow16/valix"
+)
+
+type tLogger interface {
+	Logf(format string, args ...interface{})
+}
+
+// AssertTranslationCoverage asserts that the translator (if nil, valix.DefaultTranslator is used) has translations
+// for all the translatables in each of the languages (e.g. "fr" or "fr-CA") - if no languages are specified, all
+// supported languages are checked (see valix.SupportedLanguages)
+//
+// If translatables is nil, the translatables used by valix are checked (see valix.CollectTranslatables)
+func AssertTranslationCoverage(t TestingT, translatables *valix.Translatables, translator valix.Translator, languages ...string) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if missing := checkCoverage(translatables, translator, languages); len(missing) > 0 {
+		t.Errorf("missing translations:\n%s", formatMissingTranslations(missing))
+		return false
+	}
+	return true
+}
+
+// LogTranslationCoverage is the same as AssertTranslationCoverage - except that missing translations are only
+// logged (if the TestingT supports logging, e.g. *testing.T) and do not fail the test
+//
+// The missing translations are returned
+func LogTranslationCoverage(t TestingT, translatables *valix.Translatables, translator valix.Translator, languages ...string) []*valix.MissingTranslations {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	missing := checkCoverage(translatables, translator, languages)
+	if l, ok := t.(tLogger); ok && len(missing) > 0 {
+		l.Logf("missing translations:\n%s", formatMissingTranslations(missing))
+	}
+	return missing
+}
+
+func checkCoverage(translatables *valix.Translatables, translator valix.Translator, languages []string) []*valix.MissingTranslations {
+	if translatables == nil {
+		translatables = valix.CollectTranslatables()
+	}
+	return translatables.CheckCoverage(translator, languages...)
+}
+
+func formatMissingTranslations(missing []*valix.MissingTranslations) string {
+	var sb strings.Builder
+	for _, m := range missing {
+		sb.WriteString(m.String() + "\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,164 @@
+// Package valixtest provides assertion helpers and request builders for tests that check valix violations
+//
+// Violations are matched by path, property, code and (optionally) message - and are matched regardless of order
+// (so tests are not fragile about the order in which violations are found)
+package valixtest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/marrow16/valix"
+)
+
+// TestingT is the interface used by the assertion helpers (it is satisfied by *testing.T)
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+}
+
+type tHelper interface {
+	Helper()
+}
+
+// Expect is an expected violation
+//
+// Path is always matched (the root path is "") - Property, Code and Message are only matched when non-empty/non-zero
+type Expect struct {
+	// Path is the expected path of the violation (see valix.Violation.Path) - e.g. "a.b[0]"
+	Path string
+	// Property is the expected property name of the violation (see valix.Violation.Property)
+	Property string
+	// Code is the expected violation code (e.g. valix.CodeMissingProperty)
+	Code int
+	// Message is the expected violation message
+	Message string
+}
+
+func (e Expect) matches(v *valix.Violation) bool {
+	return v != nil && e.Path == v.Path &&
+		(e.Property == "" || e.Property == v.Property) &&
+		(e.Code == 0 || e.Code == violationCode(v)) &&
+		(e.Message == "" || e.Message == v.Message)
+}
+
+func (e Expect) String() string {
+	parts := []string{fmt.Sprintf("path: %q", e.Path)}
+	if e.Property != "" {
+		parts = append(parts, fmt.Sprintf("property: %q", e.Property))
+	}
+	if e.Code != 0 {
+		parts = append(parts, fmt.Sprintf("code: %d", e.Code))
+	}
+	if e.Message != "" {
+		parts = append(parts, fmt.Sprintf("message: %q", e.Message))
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// AssertValid asserts that there are no violations
+func AssertValid(t TestingT, violations []*valix.Violation) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if len(violations) > 0 {
+		t.Errorf("expected no violations but got %d:\n%s", len(violations), formatViolations(violations))
+		return false
+	}
+	return true
+}
+
+// AssertViolations asserts that the violations match exactly the expected violations (in any order)
+func AssertViolations(t TestingT, violations []*valix.Violation, expects ...Expect) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	unmatchedExpects, unmatchedViolations := match(violations, expects)
+	if len(unmatchedExpects) > 0 || len(unmatchedViolations) > 0 {
+		t.Errorf("violations do not match expected:\n%s", diff(unmatchedExpects, unmatchedViolations))
+		return false
+	}
+	return true
+}
+
+// AssertContainsViolations asserts that the violations contain the expected violations (in any order) - other
+// violations are ignored
+func AssertContainsViolations(t TestingT, violations []*valix.Violation, expects ...Expect) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	unmatchedExpects, _ := match(violations, expects)
+	if len(unmatchedExpects) > 0 {
+		t.Errorf("violations do not contain expected:\n%s", diff(unmatchedExpects, nil))
+		return false
+	}
+	return true
+}
+
+// match matches each expectation to a distinct violation (using augmenting paths - so that less specific
+// expectations do not 'steal' violations needed by more specific ones) and returns those left unmatched
+func match(violations []*valix.Violation, expects []Expect) ([]Expect, []*valix.Violation) {
+	matchedBy := make([]int, len(violations))
+	for i := range matchedBy {
+		matchedBy[i] = -1
+	}
+	var try func(ei int, seen []bool) bool
+	try = func(ei int, seen []bool) bool {
+		for vi, v := range violations {
+			if !seen[vi] && expects[ei].matches(v) {
+				seen[vi] = true
+				if matchedBy[vi] == -1 || try(matchedBy[vi], seen) {
+					matchedBy[vi] = ei
+					return true
+				}
+			}
+		}
+		return false
+	}
+	unmatchedExpects := make([]Expect, 0)
+	for ei := range expects {
+		if !try(ei, make([]bool, len(violations))) {
+			unmatchedExpects = append(unmatchedExpects, expects[ei])
+		}
+	}
+	unmatchedViolations := make([]*valix.Violation, 0)
+	for vi, v := range violations {
+		if matchedBy[vi] == -1 {
+			unmatchedViolations = append(unmatchedViolations, v)
+		}
+	}
+	return unmatchedExpects, unmatchedViolations
+}
+
+func diff(unmatchedExpects []Expect, unmatchedViolations []*valix.Violation) string {
+	var sb strings.Builder
+	for _, e := range unmatchedExpects {
+		sb.WriteString("  - missing:    " + e.String() + "\n")
+	}
+	for _, v := range unmatchedViolations {
+		sb.WriteString("  + unexpected: " + formatViolation(v) + "\n")
+	}
+	return sb.String()
+}
+
+func formatViolations(violations []*valix.Violation) string {
+	var sb strings.Builder
+	for _, v := range violations {
+		sb.WriteString("  " + formatViolation(v) + "\n")
+	}
+	return sb.String()
+}
+
+func formatViolation(v *valix.Violation) string {
+	return fmt.Sprintf("{path: %q, property: %q, code: %d, message: %q}", v.Path, v.Property, violationCode(v), v.Message)
+}
+
+func violationCode(v *valix.Violation) int {
+	if v.Code != 0 {
+		return v.Code
+	} else if len(v.Codes) > 0 {
+		if code, ok := v.Codes[0].(int); ok {
+			return code
+		}
+	}
+	return 0
+}
@@ -0,0 +1,190 @@
+package valixtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/marrow16/valix"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingT struct {
+	errors []string
+}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+var testValidator = &valix.Validator{
+	Properties: valix.Properties{
+		"a": {
+			Type: valix.JsonObject,
+			ObjectValidator: &valix.Validator{
+				Properties: valix.Properties{
+					"b": {
+						Type:      valix.JsonArray,
+						Mandatory: true,
+						ObjectValidator: &valix.Validator{
+							AllowArray: true,
+							Properties: valix.Properties{
+								"c": {Type: valix.JsonString, Mandatory: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func testViolations(t *testing.T) []*valix.Violation {
+	ok, violations, _ := testValidator.ValidateString(`{"a":{"b":[{"c":1},{}]}}`)
+	require.False(t, ok)
+	require.Equal(t, 2, len(violations))
+	return violations
+}
+
+func TestAssertValid(t *testing.T) {
+	rt := &recordingT{}
+	require.True(t, AssertValid(rt, nil))
+	require.Equal(t, 0, len(rt.errors))
+
+	require.False(t, AssertValid(rt, testViolations(t)))
+	require.Equal(t, 1, len(rt.errors))
+	require.Contains(t, rt.errors[0], "expected no violations but got 2:")
+	require.Contains(t, rt.errors[0], `{path: "a.b[1]", property: "c", code: 42207, message: "Missing property"}`)
+}
+
+func TestAssertViolations(t *testing.T) {
+	violations := testViolations(t)
+	rt := &recordingT{}
+	// order insensitive...
+	require.True(t, AssertViolations(rt, violations,
+		Expect{Path: "a.b[1]", Code: valix.CodeMissingProperty},
+		Expect{Path: "a.b[0]", Property: "c"}))
+	require.True(t, AssertViolations(rt, violations,
+		Expect{Path: "a.b[0]"},
+		Expect{Path: "a.b[1]", Property: "c", Message: "Missing property"}))
+	require.Equal(t, 0, len(rt.errors))
+
+	require.False(t, AssertViolations(rt, violations,
+		Expect{Path: "a.b[1]", Code: valix.CodeMissingProperty}))
+	require.Equal(t, 1, len(rt.errors))
+	require.Contains(t, rt.errors[0], `  + unexpected: {path: "a.b[0]", property: "c"`)
+
+	require.False(t, AssertViolations(rt, violations,
+		Expect{Path: "a.b[1]", Code: valix.CodeMissingProperty},
+		Expect{Path: "a.b[0]"},
+		Expect{Path: "a", Property: "x"}))
+	require.Equal(t, 2, len(rt.errors))
+	require.Equal(t, "violations do not match expected:\n  - missing:    {path: \"a\", property: \"x\"}\n", rt.errors[1])
+}
+
+func TestAssertViolationsMatchesLessSpecificLast(t *testing.T) {
+	violations := []*valix.Violation{
+		valix.NewViolation("x", "", "first", valix.CodeMissingProperty),
+		valix.NewViolation("y", "", "second", valix.CodeMissingProperty),
+	}
+	rt := &recordingT{}
+	// the less specific expectation could match either violation - it must not take the one needed by the other...
+	require.True(t, AssertViolations(rt, violations,
+		Expect{Path: "", Code: valix.CodeMissingProperty},
+		Expect{Path: "", Property: "x"}))
+	require.Equal(t, 0, len(rt.errors))
+}
+
+func TestAssertViolationsUsesViolationCode(t *testing.T) {
+	violation := valix.NewViolation("x", "", "message", "not an int code")
+	violation.Code = valix.CodeMissingProperty
+	rt := &recordingT{}
+	require.True(t, AssertViolations(rt, []*valix.Violation{violation}, Expect{Code: valix.CodeMissingProperty}))
+	require.Equal(t, 0, len(rt.errors))
+
+	violation = valix.NewViolation("x", "", "message", valix.CodeMissingProperty)
+	require.True(t, AssertViolations(rt, []*valix.Violation{violation}, Expect{Code: valix.CodeMissingProperty}))
+	require.Equal(t, 0, len(rt.errors))
+}
+
+func TestAssertContainsViolations(t *testing.T) {
+	violations := testViolations(t)
+	rt := &recordingT{}
+	require.True(t, AssertContainsViolations(rt, violations, Expect{Path: "a.b[1]", Property: "c"}))
+	require.Equal(t, 0, len(rt.errors))
+	require.False(t, AssertContainsViolations(rt, violations, Expect{Path: "a.b[2]"}))
+	require.Equal(t, 1, len(rt.errors))
+	require.Equal(t, "violations do not contain expected:\n  - missing:    {path: \"a.b[2]\"}\n", rt.errors[0])
+}
+
+func TestNewRequest(t *testing.T) {
+	req := NewRequest("POST", "/foos?a=1").
+		JSON(map[string]interface{}{"foo": "bar"}).
+		Query("b", "2", "3").
+		Query("flag").
+		Header("X-Foo", "foo").
+		AcceptLanguage("fr").
+		Build()
+	require.Equal(t, "POST", req.Method)
+	require.Equal(t, "/foos", req.URL.Path)
+	require.Equal(t, "1", req.URL.Query().Get("a"))
+	require.Equal(t, []string{"2", "3"}, req.URL.Query()["b"])
+	require.Equal(t, []string{""}, req.URL.Query()["flag"])
+	require.Equal(t, "foo", req.Header.Get("X-Foo"))
+	require.Equal(t, "application/json", req.Header.Get("Content-Type"))
+
+	v := &valix.Validator{
+		Properties: valix.Properties{
+			"foo": {Type: valix.JsonString},
+			"bar": {Type: valix.JsonString, Mandatory: true},
+		},
+	}
+	ok, violations, _ := v.RequestValidate(req)
+	require.False(t, ok)
+	AssertViolations(t, violations, Expect{Path: "", Property: "bar", Message: "Propriété manquante"})
+
+	req = NewRequest("POST", "/foos").JSON(`{"bar": "x"}`).Build()
+	_, violations, _ = v.RequestValidate(req)
+	AssertValid(t, violations)
+
+	req = NewRequest("GET", "/foos").Build()
+	_, violations, _ = v.RequestValidate(req)
+	AssertViolations(t, violations, Expect{Code: valix.CodeUnableToDecodeRequest})
+}
+
+type loggingT struct {
+	recordingT
+	logs []string
+}
+
+func (l *loggingT) Logf(format string, args ...interface{}) {
+	l.logs = append(l.logs, fmt.Sprintf(format, args...))
+}
+
+func TestAssertTranslationCoverage(t *testing.T) {
+	rt := &recordingT{}
+	require.True(t, AssertTranslationCoverage(rt, nil, nil))
+	require.Equal(t, 0, len(rt.errors))
+
+	translatables := valix.CollectTranslatables()
+	translatables.Messages = append(translatables.Messages, "Foo must be bar")
+	require.False(t, AssertTranslationCoverage(rt, translatables, nil, "fr", "de-CH", "en"))
+	require.Equal(t, 1, len(rt.errors))
+	require.Equal(t, "missing translations:\n"+
+		"fr: 1 missing translations\n  message: \"Foo must be bar\"\n"+
+		"de-CH: 1 missing translations\n  message: \"Foo must be bar\"\n", rt.errors[0])
+}
+
+func TestLogTranslationCoverage(t *testing.T) {
+	lt := &loggingT{}
+	translatables := &valix.Translatables{Tokens: []string{"year", "fortnight"}}
+	missing := LogTranslationCoverage(lt, translatables, nil, "pl")
+	require.Equal(t, 1, len(missing))
+	require.Equal(t, []string{"fortnight"}, missing[0].Tokens)
+	require.Equal(t, 0, len(lt.errors))
+	require.Equal(t, 1, len(lt.logs))
+	require.Equal(t, "missing translations:\npl: 1 missing translations\n  token: \"fortnight\"\n", lt.logs[0])
+
+	missing = LogTranslationCoverage(lt, translatables, nil, "en")
+	require.Equal(t, 0, len(missing))
+	require.Equal(t, 1, len(lt.logs))
+}
@@ -1,15 +1,24 @@
 package valix
 
-import "sort"
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
 
 // Violation contains information about an encountered validation violation
 type Violation struct {
 	// Property is the name of the property that failed validation
 	Property string `json:"property"`
-	// Path is the path to the property that failed validation (in JSON format, e.g. "foo.bar[0].baz")
+	// Path is the path to the property that failed validation (in the PathFormat of the validator, e.g. "foo.bar[0].baz"
+	// for PathFormatDotted or "/foo/bar/0/baz" for PathFormatJsonPointer)
 	Path string `json:"path"`
 	// Message is the violation message
 	Message string `json:"message"`
+	// Severity is the severity of the violation - only violations with SeverityError cause validation to fail
+	Severity Severity `json:"severity"`
 	// BadRequest is a flag indicating that the request could not be validated because
 	// the payload was not JSON.  This effectively allows the caller of validation to determine
 	// whether to respond with `400 Bad Request` or `422 Unprocessable Entity`
@@ -26,6 +35,33 @@ type Violation struct {
 	// Codes is a slice of anything needed to codify the violation (and can also be used to provide
 	// additional information about the violation)
 	Codes []interface{} `json:"-"`
+	// Code is the numeric code of the violation (e.g. CodePropertyConstraintFail) - set from the first
+	// of the Codes (if that is an int)
+	Code int `json:"code,omitempty"`
+	// Constraint is the registered name of the constraint that failed (e.g. "StringMaxLength") - for constraints
+	// registered under a specific name (e.g. using RegisterNamedConstraint) this is the name it was registered with
+	//
+	// Only set where the violation is the result of a constraint failing
+	Constraint string `json:"constraint,omitempty"`
+	// Parameters is the parameters of the constraint that failed (e.g. {"maximum": 20} for a failing
+	// StringMaxLength) - keyed by the constraint's field names (with first letter lower-cased), except that
+	// the Value field of built-in constraints is keyed by its descriptive name (e.g. "maximum", "minimum" or "length")
+	//
+	// Only set where the violation is the result of a constraint failing
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	// Value is the rejected value
+	//
+	// Only set when the validator has Validator.IncludeRejectedValues set to true
+	Value interface{} `json:"value,omitempty"`
+	// Position is the position of the offending value in the raw JSON (or, for decode failures, the position at
+	// which decoding failed)
+	//
+	// Only set when the validator has Validator.IncludeSourcePositions set to true (and raw JSON was validated)
+	Position *SourcePosition `json:"position,omitempty"`
+	// location is the location of the violating property (property names and array indices from the root)
+	location []interface{}
+	// pathFormat is the format of Path
+	pathFormat PathFormat
 }
 
 // NewEmptyViolation creates a new violation with the specified message (path and property are blank)
@@ -35,6 +71,7 @@ func NewEmptyViolation(msg string, codes ...interface{}) *Violation {
 		Path:     "",
 		Message:  msg,
 		Codes:    codes,
+		Code:     firstCode(codes),
 	}
 }
 
@@ -49,6 +86,7 @@ func NewViolation(property string, path string, msg string, codes ...interface{}
 		Path:     path,
 		Message:  msg,
 		Codes:    codes,
+		Code:     firstCode(codes),
 	}
 }
 
@@ -60,6 +98,7 @@ func NewBadRequestViolation(msg string, codes ...interface{}) *Violation {
 		Message:    msg,
 		BadRequest: true,
 		Codes:      codes,
+		Code:       firstCode(codes),
 	}
 }
 
@@ -76,3 +115,125 @@ func SortViolationsByPathAndProperty(violations []*Violation) {
 		return violations[i].Path < violations[j].Path
 	})
 }
+
+func firstCode(codes []interface{}) int {
+	if len(codes) > 0 {
+		if code, ok := codes[0].(int); ok {
+			return code
+		}
+	}
+	return 0
+}
+
+// setConstraint sets the Violation.Constraint and Violation.Parameters from the failing constraint
+//
+// wrapping constraints (i.e. ConditionalConstraint and SetConditionIf) are unwrapped so that the
+// name and parameters are those of the wrapped constraint
+func (v *Violation) setConstraint(constraint Constraint) {
+	useConstraint := unwrapConstraint(constraint)
+	rv := reflect.ValueOf(useConstraint)
+	if !rv.IsValid() || rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return
+	}
+	rv = rv.Elem()
+	v.Constraint = constraintsRegistry.nameOf(useConstraint)
+	v.Parameters = constraintParameters(rv)
+	if alias, ok := constraintValuePlaceholders[rv.Type().Name()]; ok {
+		if pv, ok := v.Parameters[placeholderValue]; ok {
+			delete(v.Parameters, placeholderValue)
+			v.Parameters[alias] = pv
+		}
+	}
+}
+
+// unwrapConstraint returns the constraint wrapped by wrapping constraints (i.e. ConditionalConstraint,
+// SetConditionIf and WarningOnly)
+func unwrapConstraint(constraint Constraint) Constraint {
+	useConstraint := constraint
+	for unwrapped := true; unwrapped; {
+		switch ct := useConstraint.(type) {
+		case *ConditionalConstraint:
+			useConstraint = ct.Constraint
+		case *SetConditionIf:
+			useConstraint = ct.Constraint
+		default:
+			unwrapped = false
+		}
+		if useConstraint == nil {
+			useConstraint = constraint
+			break
+		}
+	}
+	if warn, ok := useConstraint.(*WarningOnly); ok && warn.Constraint != nil {
+		useConstraint = warn.Constraint
+	}
+	return useConstraint
+}
+
+var (
+	constraintType         = reflect.TypeOf((*Constraint)(nil)).Elem()
+	constraintsType        = reflect.TypeOf(Constraints{})
+	regexpType             = reflect.TypeOf(regexp.Regexp{})
+	othersExprType         = reflect.TypeOf(OthersExpr{})
+	nonParameterFieldNames = map[string]bool{
+		"Message": true,
+		"Stop":    true,
+	}
+)
+
+// constraintParameters returns the parameters of a constraint - i.e. the constraint's exported fields
+// (other than Message, Stop, wrapped constraints and functions) that are not empty strings, false,
+// nil or empty slices/maps
+func constraintParameters(rv reflect.Value) map[string]interface{} {
+	result := map[string]interface{}{}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		fld := rt.Field(i)
+		if !fld.IsExported() || nonParameterFieldNames[fld.Name] ||
+			fld.Type.Kind() == reflect.Func || fld.Type.Kind() == reflect.Chan ||
+			fld.Type == constraintType || fld.Type == constraintsType {
+			continue
+		}
+		fv := rv.Field(i)
+		var value interface{}
+		switch {
+		case fld.Type == regexpType:
+			value = fv.Addr().Interface().(*regexp.Regexp).String()
+		case fld.Type == othersExprType:
+			value = fv.Addr().Interface().(*OthersExpr).String()
+		default:
+			switch fv.Kind() {
+			case reflect.Bool:
+				if !fv.Bool() {
+					continue
+				}
+			case reflect.String:
+				if fv.String() == "" {
+					continue
+				}
+			case reflect.Slice, reflect.Map:
+				if fv.Len() == 0 {
+					continue
+				}
+			case reflect.Ptr, reflect.Interface:
+				if fv.IsNil() {
+					continue
+				}
+			}
+			value = fv.Interface()
+		}
+		if value == "" {
+			continue
+		}
+		result[parameterName(fld.Name)] = value
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+func parameterName(fieldName string) string {
+	r, n := utf8.DecodeRuneInString(fieldName)
+	return string(unicode.ToLower(r)) + fieldName[n:]
+}
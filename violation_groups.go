@@ -0,0 +1,154 @@
+package valix
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ViolationsNode is a node in a tree of violations (see ViolationsTree) - the tree mirrors the structure of the
+// validated document, where each node represents an object property or array element
+type ViolationsNode struct {
+	// Violations is the violations for the property or array element represented by the node
+	Violations []*Violation `json:"violations,omitempty"`
+	// Properties is the child nodes for properties (of an object) - keyed by property name
+	Properties map[string]*ViolationsNode `json:"properties,omitempty"`
+	// Items is the child nodes for elements (of an array) - keyed by array index
+	Items map[int]*ViolationsNode `json:"items,omitempty"`
+}
+
+// ViolationsSummary is a summary of violations (see SummariseViolations)
+type ViolationsSummary struct {
+	// Total is the total number of violations
+	Total int `json:"total"`
+	// ByCode is the count of violations per violation code (see Violation.Code) - violations without a code are
+	// counted against code 0
+	ByCode map[int]int `json:"byCode"`
+	// ByProperty is the count of violations per top-level property - violations on the root object/array itself
+	// are counted against "" (and top-level array elements are keyed as "[n]")
+	ByProperty map[string]int `json:"byProperty"`
+}
+
+// ViolationsTree returns the violations as a tree mirroring the validated document (objects and arrays)
+//
+// Violations on the root object/array itself (e.g. failing Validator.Constraints) are in the root node's
+// ViolationsNode.Violations
+func ViolationsTree(violations []*Violation) *ViolationsNode {
+	root := &ViolationsNode{}
+	for _, v := range violations {
+		node := root
+		for _, key := range v.getLocation() {
+			node = node.child(key)
+		}
+		node.Violations = append(node.Violations, v)
+	}
+	return root
+}
+
+func (n *ViolationsNode) child(key interface{}) *ViolationsNode {
+	if idx, ok := key.(int); ok {
+		if n.Items == nil {
+			n.Items = map[int]*ViolationsNode{}
+		}
+		if _, exists := n.Items[idx]; !exists {
+			n.Items[idx] = &ViolationsNode{}
+		}
+		return n.Items[idx]
+	}
+	name, _ := key.(string)
+	if n.Properties == nil {
+		n.Properties = map[string]*ViolationsNode{}
+	}
+	if _, exists := n.Properties[name]; !exists {
+		n.Properties[name] = &ViolationsNode{}
+	}
+	return n.Properties[name]
+}
+
+// ViolationsByPath returns the violations keyed by the full path to the violating property (i.e. the Violation.Path
+// joined with the Violation.Property - in the same PathFormat as the Violation.Path)
+func ViolationsByPath(violations []*Violation) map[string][]*Violation {
+	result := map[string][]*Violation{}
+	for _, v := range violations {
+		path := v.FullPath()
+		result[path] = append(result[path], v)
+	}
+	return result
+}
+
+// SummariseViolations returns a summary of the violations - counts per code and per top-level property
+func SummariseViolations(violations []*Violation) *ViolationsSummary {
+	result := &ViolationsSummary{
+		Total:      len(violations),
+		ByCode:     map[int]int{},
+		ByProperty: map[string]int{},
+	}
+	for _, v := range violations {
+		code, _ := violationCode(v)
+		result.ByCode[code]++
+		pty := ""
+		if loc := v.getLocation(); len(loc) > 0 {
+			if idx, ok := loc[0].(int); ok {
+				pty = "[" + strconv.Itoa(idx) + "]"
+			} else {
+				pty, _ = loc[0].(string)
+			}
+		}
+		result.ByProperty[pty]++
+	}
+	return result
+}
+
+// FullPath returns the full path to the violating property (i.e. the Path joined with the Property)
+func (v *Violation) FullPath() string {
+	if v.location != nil {
+		result := v.pathFormat.rootPath()
+		for _, key := range v.location {
+			result = v.pathFormat.join(result, key)
+		}
+		return result
+	}
+	if v.Property == "" {
+		return v.Path
+	} else if v.Path == "" || strings.HasPrefix(v.Property, "[") {
+		return v.Path + v.Property
+	}
+	return v.Path + "." + v.Property
+}
+
+// getLocation returns the location of the violating property - for violations not created during validation, the
+// location is parsed from the (dotted) Path and Property
+func (v *Violation) getLocation() []interface{} {
+	if v.location != nil {
+		return v.location
+	}
+	return parseDottedPath(v.FullPath())
+}
+
+func parseDottedPath(path string) []interface{} {
+	result := make([]interface{}, 0)
+	for _, part := range strings.Split(path, ".") {
+		for part != "" {
+			if open := strings.Index(part, "["); open == -1 {
+				result = append(result, part)
+				part = ""
+			} else {
+				if open > 0 {
+					result = append(result, part[:open])
+				}
+				end := strings.Index(part[open:], "]")
+				if end == -1 {
+					result = append(result, part[open:])
+					break
+				}
+				end += open
+				if idx, err := strconv.Atoi(part[open+1 : end]); err == nil {
+					result = append(result, idx)
+				} else {
+					result = append(result, part[open:end+1])
+				}
+				part = part[end+1:]
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,127 @@
+package valix
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testGroupingValidator = &Validator{
+	Constraints: Constraints{&Length{Minimum: 4}},
+	Properties: Properties{
+		"name": {Type: JsonString, Mandatory: true},
+		"age":  {Type: JsonInteger, Constraints: Constraints{&Minimum{Value: 18}}},
+		"address": {
+			Type: JsonObject,
+			ObjectValidator: &Validator{
+				Properties: Properties{
+					"postcode": {Type: JsonString, Mandatory: true},
+				},
+			},
+		},
+		"items": {
+			Type: JsonArray,
+			ObjectValidator: &Validator{
+				AllowArray:     true,
+				DisallowObject: true,
+				Properties: Properties{
+					"sku": {Type: JsonString, Mandatory: true},
+				},
+			},
+		},
+	},
+}
+
+var testGroupingObj = map[string]interface{}{
+	"age":     float64(1),
+	"address": map[string]interface{}{},
+	"items": []interface{}{
+		map[string]interface{}{"sku": "a"},
+		map[string]interface{}{},
+	},
+}
+
+func TestViolationsTree(t *testing.T) {
+	ok, violations := testGroupingValidator.Validate(testGroupingObj)
+	require.False(t, ok)
+	require.Equal(t, 5, len(violations))
+
+	tree := ViolationsTree(violations)
+	require.Equal(t, 1, len(tree.Violations))
+	require.Equal(t, CodeValidatorConstraintFail, tree.Violations[0].Code)
+	require.Equal(t, 4, len(tree.Properties))
+	require.Equal(t, CodeMissingProperty, tree.Properties["name"].Violations[0].Code)
+	require.Equal(t, CodePropertyConstraintFail, tree.Properties["age"].Violations[0].Code)
+	require.Equal(t, 0, len(tree.Properties["address"].Violations))
+	require.Equal(t, CodeMissingProperty, tree.Properties["address"].Properties["postcode"].Violations[0].Code)
+	items := tree.Properties["items"]
+	require.Equal(t, 1, len(items.Items))
+	require.Equal(t, CodeMissingProperty, items.Items[1].Properties["sku"].Violations[0].Code)
+
+	data, err := json.Marshal(items)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `{"items":{"1":{"properties":{"sku":{"violations":[{"property":"sku","path":"items[1]"`)
+}
+
+func TestViolationsByPath(t *testing.T) {
+	_, violations := testGroupingValidator.Validate(testGroupingObj)
+	byPath := ViolationsByPath(violations)
+	require.Equal(t, 5, len(byPath))
+	for _, path := range []string{"", "name", "age", "address.postcode", "items[1].sku"} {
+		require.Equal(t, 1, len(byPath[path]), path)
+	}
+
+	_, violations = testGroupingValidator.WithPathFormat(PathFormatJsonPointer).Validate(testGroupingObj)
+	byPath = ViolationsByPath(violations)
+	for _, path := range []string{"", "/name", "/age", "/address/postcode", "/items/1/sku"} {
+		require.Equal(t, 1, len(byPath[path]), path)
+	}
+}
+
+func TestSummariseViolations(t *testing.T) {
+	_, violations := testGroupingValidator.Validate(testGroupingObj)
+	summary := SummariseViolations(violations)
+	require.Equal(t, 5, summary.Total)
+	require.Equal(t, map[int]int{
+		CodeValidatorConstraintFail: 1,
+		CodeMissingProperty:         3,
+		CodePropertyConstraintFail:  1,
+	}, summary.ByCode)
+	require.Equal(t, map[string]int{"": 1, "name": 1, "age": 1, "address": 1, "items": 1}, summary.ByProperty)
+
+	data, err := json.Marshal(summary)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"total":5`)
+	require.Contains(t, string(data), `"byCode":{`)
+	require.Contains(t, string(data), `"byProperty":{`)
+}
+
+func TestViolationGroupingForConstructedViolations(t *testing.T) {
+	violations := []*Violation{
+		NewViolation("baz", "foo.bar[2]", "msg", 1),
+		NewViolation("[0]", "foo.list", "msg"),
+		NewEmptyViolation("msg"),
+	}
+	require.Equal(t, "foo.bar[2].baz", violations[0].FullPath())
+	require.Equal(t, "foo.list[0]", violations[1].FullPath())
+	require.Equal(t, "", violations[2].FullPath())
+
+	tree := ViolationsTree(violations)
+	require.Equal(t, 1, len(tree.Violations))
+	require.Equal(t, 1, len(tree.Properties["foo"].Properties["bar"].Items[2].Properties["baz"].Violations))
+	require.Equal(t, 1, len(tree.Properties["foo"].Properties["list"].Items[0].Violations))
+
+	summary := SummariseViolations(violations)
+	require.Equal(t, map[string]int{"": 1, "foo": 2}, summary.ByProperty)
+	require.Equal(t, map[int]int{0: 2, 1: 1}, summary.ByCode)
+}
+
+func TestParseDottedPath(t *testing.T) {
+	require.Equal(t, []interface{}{}, parseDottedPath(""))
+	require.Equal(t, []interface{}{"foo"}, parseDottedPath("foo"))
+	require.Equal(t, []interface{}{"foo", 1, "bar", 2, 3}, parseDottedPath("foo[1].bar[2][3]"))
+	require.Equal(t, []interface{}{1, "foo"}, parseDottedPath("[1].foo"))
+	require.Equal(t, []interface{}{"foo", "[x]"}, parseDottedPath("foo[x]"))
+	require.Equal(t, []interface{}{"foo", "[1"}, parseDottedPath("foo[1"))
+}
@@ -1,6 +1,10 @@
 package valix
 
 import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -92,3 +96,158 @@ func TestNewBadRequestViolationWithCodes(t *testing.T) {
 	require.Equal(t, "123", v.Codes[0])
 	require.Equal(t, 345, v.Codes[1])
 }
+
+func TestNewViolationSetsCode(t *testing.T) {
+	v := NewViolation("", "", "", CodeMissingProperty, "foo")
+	require.Equal(t, CodeMissingProperty, v.Code)
+	v = NewEmptyViolation("", "123")
+	require.Equal(t, 0, v.Code)
+	v = NewBadRequestViolation("", CodeRequestBodyEmpty)
+	require.Equal(t, CodeRequestBodyEmpty, v.Code)
+}
+
+func TestViolationConstraintMetadata(t *testing.T) {
+	v := &Validator{
+		Constraints: Constraints{&Length{Minimum: 3}},
+		Properties: Properties{
+			"name": {
+				Type:        JsonString,
+				Constraints: Constraints{&StringMaxLength{Value: 5}},
+			},
+			"age": {
+				Type: JsonInteger,
+				Constraints: Constraints{
+					&ConditionalConstraint{When: Conditions{"adult"}, Constraint: &Minimum{Value: 18, ExclusiveMin: true}},
+				},
+			},
+			"tags": {
+				Type:        JsonArray,
+				Constraints: Constraints{&ArrayOf{Type: "string", Constraints: Constraints{&StringPattern{Regexp: *regexp.MustCompile("^[a-z]+$")}}}},
+			},
+		},
+	}
+	ok, violations, _ := v.ValidateReader(bytes.NewReader([]byte(`{"name": "too long", "tags": ["A"]}`)), "adult")
+	require.False(t, ok)
+	require.Equal(t, 3, len(violations))
+	SortViolationsByPathAndProperty(violations)
+
+	require.Equal(t, "", violations[0].Property)
+	require.Equal(t, CodeValidatorConstraintFail, violations[0].Code)
+	require.Equal(t, "Length", violations[0].Constraint)
+	require.Equal(t, map[string]interface{}{"minimum": 3, "maximum": 0}, violations[0].Parameters)
+
+	require.Equal(t, "name", violations[1].Property)
+	require.Equal(t, CodePropertyConstraintFail, violations[1].Code)
+	require.Equal(t, "StringMaxLength", violations[1].Constraint)
+	require.Equal(t, map[string]interface{}{"maximum": 5}, violations[1].Parameters)
+	require.Nil(t, violations[1].Value)
+
+	require.Equal(t, "tags", violations[2].Path)
+	require.Equal(t, "[0]", violations[2].Property)
+	require.Equal(t, 0, violations[2].Code)
+	require.Equal(t, "StringPattern", violations[2].Constraint)
+	require.Equal(t, map[string]interface{}{"regexp": "^[a-z]+$"}, violations[2].Parameters)
+
+	// conditional constraints are unwrapped...
+	ok, violations, _ = v.ValidateReader(bytes.NewReader([]byte(`{"age": 18, "name": "ok", "tags": []}`)), "adult")
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "Minimum", violations[0].Constraint)
+	require.Equal(t, map[string]interface{}{"minimum": float64(18), "exclusiveMin": true}, violations[0].Parameters)
+
+	data, err := json.Marshal(violations[0])
+	require.NoError(t, err)
+	require.Equal(t, `{"property":"age","path":"","message":"`+violations[0].Message+`","severity":"error","code":42299,"constraint":"Minimum","parameters":{"exclusiveMin":true,"minimum":18}}`, string(data))
+}
+
+func TestViolationIncludesRejectedValues(t *testing.T) {
+	v := &Validator{
+		IncludeRejectedValues: true,
+		Properties: Properties{
+			"name": {
+				Type:        JsonString,
+				Constraints: Constraints{&StringMaxLength{Value: 5}},
+			},
+			"count": {
+				Type: JsonInteger,
+			},
+		},
+	}
+	ok, violations := v.Validate(map[string]interface{}{"name": "too long", "count": "1"})
+	require.False(t, ok)
+	require.Equal(t, 2, len(violations))
+	SortViolationsByPathAndProperty(violations)
+	require.Equal(t, "1", violations[0].Value)
+	require.Equal(t, CodeValueExpectedType, violations[0].Code)
+	require.Equal(t, "", violations[0].Constraint)
+	require.Equal(t, "too long", violations[1].Value)
+
+	data, err := json.Marshal(violations[1])
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"value":"too long"`)
+}
+
+func TestViolationConstraintMetadataForAllConstraints(t *testing.T) {
+	for name, c := range defaultConstraints() {
+		violation := &Violation{}
+		violation.setConstraint(c)
+		require.Equal(t, reflect.TypeOf(c).Elem().Name(), violation.Constraint, name)
+		for k := range violation.Parameters {
+			require.NotEqual(t, "message", k, name)
+			require.NotEqual(t, "stop", k, name)
+		}
+	}
+}
+
+func TestViolationConstraintMetadataUsesRegisteredName(t *testing.T) {
+	defer func() {
+		ConstraintsRegistryReset()
+	}()
+	custom := NewCustomConstraint(func(value interface{}, vcx *ValidatorContext, this *CustomConstraint) (bool, string) {
+		return false, this.GetMessage(vcx)
+	}, "Fails")
+	RegisterNamedConstraint("alwaysFails", custom)
+	named := &StringMaxLength{Value: 2}
+	RegisterNamedConstraint("maxTwo", named)
+
+	violation := &Violation{}
+	violation.setConstraint(custom)
+	require.Equal(t, "alwaysFails", violation.Constraint)
+	require.Nil(t, violation.Parameters)
+
+	violation = &Violation{}
+	violation.setConstraint(&ConditionalConstraint{When: Conditions{"foo"}, Constraint: named})
+	require.Equal(t, "maxTwo", violation.Constraint)
+	require.Equal(t, map[string]interface{}{"maximum": 2}, violation.Parameters)
+
+	// unregistered instances use the type name...
+	violation = &Violation{}
+	violation.setConstraint(&StringMaxLength{Value: 3})
+	require.Equal(t, "StringMaxLength", violation.Constraint)
+	require.Equal(t, map[string]interface{}{"maximum": 3}, violation.Parameters)
+
+	type testStruct struct {
+		Foo string `json:"foo" v8n:"constraints:[alwaysFails]"`
+	}
+	v, err := ValidatorFor(testStruct{}, nil)
+	require.NoError(t, err)
+	ok, violations := v.Validate(map[string]interface{}{"foo": "bar"})
+	require.False(t, ok)
+	require.Equal(t, 1, len(violations))
+	require.Equal(t, "alwaysFails", violations[0].Constraint)
+}
+
+func TestViolationConstraintMetadataUsesValueAliases(t *testing.T) {
+	for name, alias := range constraintValuePlaceholders {
+		c, ok := constraintsRegistry.get(name)
+		require.True(t, ok, name)
+		raw := constraintParameters(reflect.ValueOf(c).Elem())
+		violation := &Violation{}
+		violation.setConstraint(c)
+		_, hasValue := violation.Parameters["value"]
+		require.False(t, hasValue, name)
+		_, rawHasValue := raw["value"]
+		_, hasAlias := violation.Parameters[alias]
+		require.Equal(t, rawHasValue, hasAlias, name)
+	}
+}